@@ -466,7 +466,7 @@ func New(
 	)
 
 	// register slashing module StakingHooks to the consumer keeper
-	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
+	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(ccvtypes.NewValidatorBondedConsumerHooks(app.SlashingKeeper.Hooks()))
 	consumerModule := consumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(consumertypes.ModuleName))
 
 	// IBC v10: Updated TransferKeeper initialization
@@ -372,7 +372,7 @@ func New(
 	)
 
 	// register slashing module Slashing hooks to the consumer keeper
-	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
+	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(ccvtypes.NewValidatorBondedConsumerHooks(app.SlashingKeeper.Hooks()))
 	consumerModule := ibcconsumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(ibcconsumertypes.ModuleName))
 
 	// IBC v10: Updated TransferKeeper initialization
@@ -307,6 +307,51 @@ func TransformConsumerGenesis(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// RelaunchConsumerGenesis builds the consumer genesis content of a relaunched consumer chain
+// from the consumer genesis content exported by the stopped chain and the consumer genesis
+// content issued by the provider for the relaunch.
+//
+// Result will be written to defined output.
+func RelaunchConsumerGenesis(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	exportedRaw, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return err
+	}
+	exported := consumerTypes.GenesisState{}
+	if err := clientCtx.Codec.UnmarshalJSON(exportedRaw, &exported); err != nil {
+		return fmt.Errorf("reading exported consumer genesis data failed: %s", err)
+	}
+
+	providerRaw, err := os.ReadFile(filepath.Clean(args[1]))
+	if err != nil {
+		return err
+	}
+	providerGen := types.ConsumerGenesisState{}
+	if err := clientCtx.Codec.UnmarshalJSON(providerRaw, &providerGen); err != nil {
+		return fmt.Errorf("reading provider consumer genesis data failed: %s", err)
+	}
+
+	relaunchGenesis := consumerTypes.NewRelaunchGenesisState(exported, providerGen)
+	if err := relaunchGenesis.Validate(); err != nil {
+		return fmt.Errorf("invalid relaunch consumer genesis: %s", err)
+	}
+
+	bz, err := clientCtx.Codec.MarshalJSON(relaunchGenesis)
+	if err != nil {
+		return fmt.Errorf("failed exporting relaunch consumer genesis to JSON: %s", err)
+	}
+
+	sortedBz, err := sdk.SortJSON(bz)
+	if err != nil {
+		return fmt.Errorf("failed sorting relaunch consumer genesis JSON: %s", err)
+	}
+
+	cmd.Println(string(sortedBz))
+	return nil
+}
+
 // NewDefaultGenesisState generates the default state for the application.
 func NewDefaultGenesisState(cdc codec.JSONCodec) GenesisState {
 	return ModuleBasics.DefaultGenesis(cdc)
@@ -338,3 +383,32 @@ $ %s --to v2.x transform /path/to/ccv_consumer_genesis.json
 			maps.Keys(TransformationVersions)))
 	return cmd
 }
+
+// GetConsumerGenesisRelaunchCmd builds the consumer genesis content of a stopped consumer chain
+// that is relaunched by governance, from its exported state and the provider consumer genesis.
+func GetConsumerGenesisRelaunchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relaunch exported-genesis-file provider-genesis-file",
+		Short: "Build the CCV consumer genesis data of a relaunched consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Build the consumer genesis data of a consumer chain that is relaunched after it was stopped.
+The first file holds the consumer genesis data exported by the stopped chain, i.e., the ccvconsumer
+module state of its genesis export. The second file holds the consumer genesis data issued by the
+provider chain for the relaunch, once the spawn time of the relaunch has passed.
+The consumer reward denoms and the last transmission block height are kept from the exported data.
+The result is printed to STDOUT.
+
+Note: The consumer keys assigned on the provider chain are deleted when a consumer chain is stopped.
+Validators that want to validate the relaunched chain with their previous consumer keys must assign
+them again before the spawn time of the relaunch, otherwise their provider consensus keys are used.
+
+Example:
+$ %s relaunch /path/to/exported_ccv_consumer_genesis.json /path/to/ccv_consumer_genesis.json
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: RelaunchConsumerGenesis,
+	}
+	return cmd
+}
@@ -534,3 +534,53 @@ func TestConsumerGenesisTransformationV4ToV33(t *testing.T) {
 	require.Equal(t, "", resultGenesis.ProviderChannelId)
 	require.Equal(t, srcGenesis.Provider.InitialValSet, resultGenesis.Provider.InitialValSet)
 }
+
+// Check that the relaunch genesis keeps the reward denoms and the last transmission
+// block height of the exported consumer genesis
+func TestConsumerGenesisRelaunch(t *testing.T) {
+	ctx := getClientCtx()
+
+	providerFilePath := createConsumerDataGenesisFile(t, V4x)
+	defer os.Remove(providerFilePath)
+	var providerGen ccvtypes.ConsumerGenesisState
+	err := ctx.Codec.UnmarshalJSON([]byte(consumerGenesisStates[V4x]), &providerGen)
+	require.NoError(t, err)
+
+	exported := consumerTypes.DefaultGenesisState()
+	exported.Params.RewardDenoms = []string{"ibc/reward"}
+	exported.Params.ProviderRewardDenoms = []string{"stake"}
+	exported.LastTransmissionBlockHeight = consumerTypes.LastTransmissionBlockHeight{Height: 1234}
+	exportedBz, err := ctx.Codec.MarshalJSON(exported)
+	require.NoError(t, err)
+	exportedFilePath := filepath.Join(t.TempDir(), "exported_genesis.json")
+	err = os.WriteFile(exportedFilePath, exportedBz, fs.FileMode(0o644))
+	require.NoError(t, err)
+
+	cmd := app.GetConsumerGenesisRelaunchCmd()
+	clientCtx := getClientCtx()
+	cmd.SetContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx))
+	require.NoError(t, client.SetCmdClientContext(cmd, clientCtx))
+	cmd.SetArgs([]string{exportedFilePath, providerFilePath})
+	result := new(bytes.Buffer)
+	cmd.SetOutput(result)
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+
+	resultGenesis := consumerTypes.GenesisState{}
+	err = ctx.Codec.UnmarshalJSON(result.Bytes(), &resultGenesis)
+	require.NoError(t, err)
+
+	require.True(t, resultGenesis.NewChain)
+	require.True(t, resultGenesis.Relaunch)
+	require.Equal(t, exported.Params.RewardDenoms, resultGenesis.Params.RewardDenoms)
+	require.Equal(t, exported.Params.ProviderRewardDenoms, resultGenesis.Params.ProviderRewardDenoms)
+	require.Equal(t, exported.LastTransmissionBlockHeight, resultGenesis.LastTransmissionBlockHeight)
+	require.Equal(t, providerGen.Params.UnbondingPeriod, resultGenesis.Params.UnbondingPeriod)
+	require.Equal(t, providerGen.Provider.InitialValSet, resultGenesis.Provider.InitialValSet)
+	require.Equal(t, providerGen.Provider.ClientState, resultGenesis.Provider.ClientState)
+
+	// a missing provider consumer genesis fails
+	cmd.SetArgs([]string{exportedFilePath, filepath.Join(t.TempDir(), "missing.json")})
+	_, err = cmd.ExecuteC()
+	require.Error(t, err)
+}
@@ -3,7 +3,17 @@ package app
 import (
 	"encoding/json"
 
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/allinbits/interchain-security/app/common"
+	ibcproviderkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
 )
 
 // The genesis state of the blockchain is represented here as a map of raw json
@@ -19,3 +29,11 @@ type GenesisState map[string]json.RawMessage
 func NewDefaultGenesisState(cdc codec.JSONCodec) GenesisState {
 	return ModuleBasics.DefaultGenesis(cdc)
 }
+
+// GetCheckInvariantsCmd returns the command that checks the provider invariants against an exported genesis
+func GetCheckInvariantsCmd() *cobra.Command {
+	return common.CheckInvariantsCmd(func(db dbm.DB) (common.GenesisApp, sdk.Invariant) {
+		app := New(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{})
+		return app, ibcproviderkeeper.AllInvariants(app.ProviderKeeper)
+	})
+}
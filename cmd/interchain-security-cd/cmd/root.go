@@ -224,7 +224,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appencoding.EncodingConf
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(encodingConfig, consumer.GetConsumerGenesisTransformCmd()),
+		genesisCommand(encodingConfig, consumer.GetConsumerGenesisTransformCmd(), consumer.GetConsumerGenesisRelaunchCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -228,7 +228,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appEncoding.EncodingConf
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(encodingConfig),
+		genesisCommand(encodingConfig, providerApp.GetCheckInvariantsCmd()),
 		txCommand(),
 		queryCommand(),
 		keys.Commands(),
@@ -148,6 +148,7 @@ require (
 	cosmossdk.io/x/tx v0.14.0
 	cosmossdk.io/x/upgrade v0.2.0
 	github.com/cosmos/cosmos-db v1.1.1
+	github.com/hashicorp/go-metrics v0.5.4
 	github.com/informalsystems/itf-go v0.0.1
 	github.com/spf13/viper v1.20.1
 	golang.org/x/mod v0.24.0
@@ -193,7 +194,6 @@ require (
 	github.com/google/flatbuffers v24.3.25+incompatible // indirect
 	github.com/google/s2a-go v0.1.9 // indirect
 	github.com/hashicorp/go-hclog v1.6.3 // indirect
-	github.com/hashicorp/go-metrics v0.5.4 // indirect
 	github.com/hashicorp/go-plugin v1.6.3 // indirect
 	github.com/hashicorp/golang-lru/v2 v2.0.7 // indirect
 	github.com/hashicorp/yamux v0.1.2 // indirect
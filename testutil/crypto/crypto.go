@@ -117,3 +117,13 @@ func (v *CryptoIdentity) ProviderConsAddress() providertypes.ProviderConsAddress
 func (v *CryptoIdentity) ConsumerConsAddress() providertypes.ConsumerConsAddress {
 	return providertypes.NewConsumerConsAddress(v.SDKValConsAddress())
 }
+
+// Returns the signature of the consumer key possession payload made with the consensus key of the crypto identity,
+// proving the possession of the key when it is assigned as a consumer key.
+func (v *CryptoIdentity) ConsumerKeyPossessionSignature(chainID, providerValAddr string, nonce uint64) []byte {
+	ret, err := v.consensus.Sign(providertypes.ConsumerKeyPossessionBytes(chainID, providerValAddr, nonce))
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
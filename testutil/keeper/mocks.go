@@ -12,6 +12,7 @@ import (
 	math "cosmossdk.io/math"
 	types "cosmossdk.io/store/types"
 	types0 "github.com/cometbft/cometbft/abci/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	types2 "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -991,6 +992,48 @@ func (m *MockConsumerHooks) EXPECT() *MockConsumerHooksMockRecorder {
 	return m.recorder
 }
 
+// AfterCCVChannelEstablished mocks base method.
+func (m *MockConsumerHooks) AfterCCVChannelEstablished(ctx context.Context, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterCCVChannelEstablished", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterCCVChannelEstablished indicates an expected call of AfterCCVChannelEstablished.
+func (mr *MockConsumerHooksMockRecorder) AfterCCVChannelEstablished(ctx, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterCCVChannelEstablished", reflect.TypeOf((*MockConsumerHooks)(nil).AfterCCVChannelEstablished), ctx, channelID)
+}
+
+// AfterSlashPacketAcknowledged mocks base method.
+func (m *MockConsumerHooks) AfterSlashPacketAcknowledged(ctx context.Context, validator types0.Validator, vscID uint64, infraction types3.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterSlashPacketAcknowledged", ctx, validator, vscID, infraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterSlashPacketAcknowledged indicates an expected call of AfterSlashPacketAcknowledged.
+func (mr *MockConsumerHooksMockRecorder) AfterSlashPacketAcknowledged(ctx, validator, vscID, infraction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterSlashPacketAcknowledged", reflect.TypeOf((*MockConsumerHooks)(nil).AfterSlashPacketAcknowledged), ctx, validator, vscID, infraction)
+}
+
+// AfterSlashPacketQueued mocks base method.
+func (m *MockConsumerHooks) AfterSlashPacketQueued(ctx context.Context, validator types0.Validator, vscID uint64, infraction types3.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterSlashPacketQueued", ctx, validator, vscID, infraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterSlashPacketQueued indicates an expected call of AfterSlashPacketQueued.
+func (mr *MockConsumerHooksMockRecorder) AfterSlashPacketQueued(ctx, validator, vscID, infraction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterSlashPacketQueued", reflect.TypeOf((*MockConsumerHooks)(nil).AfterSlashPacketQueued), ctx, validator, vscID, infraction)
+}
+
 // AfterValidatorBonded mocks base method.
 func (m *MockConsumerHooks) AfterValidatorBonded(ctx context.Context, consAddr types1.ConsAddress, valAddresses types1.ValAddress) error {
 	m.ctrl.T.Helper()
@@ -1005,6 +1048,155 @@ func (mr *MockConsumerHooksMockRecorder) AfterValidatorBonded(ctx, consAddr, val
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorBonded", reflect.TypeOf((*MockConsumerHooks)(nil).AfterValidatorBonded), ctx, consAddr, valAddresses)
 }
 
+// AfterValidatorPowerChanged mocks base method.
+func (m *MockConsumerHooks) AfterValidatorPowerChanged(ctx context.Context, consAddr types1.ConsAddress, oldPower, newPower int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorPowerChanged", ctx, consAddr, oldPower, newPower)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorPowerChanged indicates an expected call of AfterValidatorPowerChanged.
+func (mr *MockConsumerHooksMockRecorder) AfterValidatorPowerChanged(ctx, consAddr, oldPower, newPower interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorPowerChanged", reflect.TypeOf((*MockConsumerHooks)(nil).AfterValidatorPowerChanged), ctx, consAddr, oldPower, newPower)
+}
+
+// AfterValidatorRemoved mocks base method.
+func (m *MockConsumerHooks) AfterValidatorRemoved(ctx context.Context, consAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorRemoved", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorRemoved indicates an expected call of AfterValidatorRemoved.
+func (mr *MockConsumerHooksMockRecorder) AfterValidatorRemoved(ctx, consAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorRemoved", reflect.TypeOf((*MockConsumerHooks)(nil).AfterValidatorRemoved), ctx, consAddr)
+}
+
+// AfterValidatorSetChangesApplied mocks base method.
+func (m *MockConsumerHooks) AfterValidatorSetChangesApplied(ctx context.Context, valUpdates []types0.ValidatorUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorSetChangesApplied", ctx, valUpdates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorSetChangesApplied indicates an expected call of AfterValidatorSetChangesApplied.
+func (mr *MockConsumerHooksMockRecorder) AfterValidatorSetChangesApplied(ctx, valUpdates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorSetChangesApplied", reflect.TypeOf((*MockConsumerHooks)(nil).AfterValidatorSetChangesApplied), ctx, valUpdates)
+}
+
+// MockProviderHooks is a mock of ProviderHooks interface.
+type MockProviderHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderHooksMockRecorder
+}
+
+// MockProviderHooksMockRecorder is the mock recorder for MockProviderHooks.
+type MockProviderHooksMockRecorder struct {
+	mock *MockProviderHooks
+}
+
+// NewMockProviderHooks creates a new mock instance.
+func NewMockProviderHooks(ctrl *gomock.Controller) *MockProviderHooks {
+	mock := &MockProviderHooks{ctrl: ctrl}
+	mock.recorder = &MockProviderHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderHooks) EXPECT() *MockProviderHooksMockRecorder {
+	return m.recorder
+}
+
+// AfterConsumerKeyAssigned mocks base method.
+func (m *MockProviderHooks) AfterConsumerKeyAssigned(ctx context.Context, chainID string, providerAddr types1.ConsAddress, consumerKey crypto.PublicKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterConsumerKeyAssigned", ctx, chainID, providerAddr, consumerKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterConsumerKeyAssigned indicates an expected call of AfterConsumerKeyAssigned.
+func (mr *MockProviderHooksMockRecorder) AfterConsumerKeyAssigned(ctx, chainID, providerAddr, consumerKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterConsumerKeyAssigned", reflect.TypeOf((*MockProviderHooks)(nil).AfterConsumerKeyAssigned), ctx, chainID, providerAddr, consumerKey)
+}
+
+// AfterConsumerLaunched mocks base method.
+func (m *MockProviderHooks) AfterConsumerLaunched(ctx context.Context, chainID, clientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterConsumerLaunched", ctx, chainID, clientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterConsumerLaunched indicates an expected call of AfterConsumerLaunched.
+func (mr *MockProviderHooksMockRecorder) AfterConsumerLaunched(ctx, chainID, clientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterConsumerLaunched", reflect.TypeOf((*MockProviderHooks)(nil).AfterConsumerLaunched), ctx, chainID, clientID)
+}
+
+// AfterConsumerStopped mocks base method.
+func (m *MockProviderHooks) AfterConsumerStopped(ctx context.Context, chainID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterConsumerStopped", ctx, chainID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterConsumerStopped indicates an expected call of AfterConsumerStopped.
+func (mr *MockProviderHooksMockRecorder) AfterConsumerStopped(ctx, chainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterConsumerStopped", reflect.TypeOf((*MockProviderHooks)(nil).AfterConsumerStopped), ctx, chainID)
+}
+
+// AfterConsumerValidatorSetChanged mocks base method.
+func (m *MockProviderHooks) AfterConsumerValidatorSetChanged(ctx context.Context, chainID string, vscID uint64, valUpdates []types0.ValidatorUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterConsumerValidatorSetChanged", ctx, chainID, vscID, valUpdates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterConsumerValidatorSetChanged indicates an expected call of AfterConsumerValidatorSetChanged.
+func (mr *MockProviderHooksMockRecorder) AfterConsumerValidatorSetChanged(ctx, chainID, vscID, valUpdates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterConsumerValidatorSetChanged", reflect.TypeOf((*MockProviderHooks)(nil).AfterConsumerValidatorSetChanged), ctx, chainID, vscID, valUpdates)
+}
+
+// AfterValidatorOptedIn mocks base method.
+func (m *MockProviderHooks) AfterValidatorOptedIn(ctx context.Context, chainID string, providerAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorOptedIn", ctx, chainID, providerAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorOptedIn indicates an expected call of AfterValidatorOptedIn.
+func (mr *MockProviderHooksMockRecorder) AfterValidatorOptedIn(ctx, chainID, providerAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorOptedIn", reflect.TypeOf((*MockProviderHooks)(nil).AfterValidatorOptedIn), ctx, chainID, providerAddr)
+}
+
+// AfterValidatorOptedOut mocks base method.
+func (m *MockProviderHooks) AfterValidatorOptedOut(ctx context.Context, chainID string, providerAddr types1.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterValidatorOptedOut", ctx, chainID, providerAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterValidatorOptedOut indicates an expected call of AfterValidatorOptedOut.
+func (mr *MockProviderHooksMockRecorder) AfterValidatorOptedOut(ctx, chainID, providerAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterValidatorOptedOut", reflect.TypeOf((*MockProviderHooks)(nil).AfterValidatorOptedOut), ctx, chainID, providerAddr)
+}
+
 // MockBankKeeper is a mock of BankKeeper interface.
 type MockBankKeeper struct {
 	ctrl     *gomock.Controller
@@ -1070,6 +1262,20 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderMod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
 }
 
+// SpendableCoins mocks base method.
+func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types1.AccAddress) types1.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendableCoins", ctx, addr)
+	ret0, _ := ret[0].(types1.Coins)
+	return ret0
+}
+
+// SpendableCoins indicates an expected call of SpendableCoins.
+func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -1093,6 +1299,20 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetAccount mocks base method.
+func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types1.AccAddress) types1.AccountI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
+	ret0, _ := ret[0].(types1.AccountI)
+	return ret0
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), ctx, addr)
+}
+
 // GetModuleAccount mocks base method.
 func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types1.ModuleAccountI {
 	m.ctrl.T.Helper()
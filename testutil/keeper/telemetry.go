@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Metric is a gauge or a counter of the in-memory telemetry sink
+type Metric struct {
+	Name   string
+	Value  float64
+	Labels map[string]string
+}
+
+// NewInmemTelemetrySink enables the telemetry and returns the in-memory sink the metrics
+// are emitted to. The telemetry is disabled once the test completes.
+func NewInmemTelemetrySink(t *testing.T) *metrics.InmemSink {
+	t.Helper()
+	// enable the telemetry wrappers
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		require.NoError(t, err)
+	})
+
+	// use a single interval, so that all the metrics of the test are aggregated together
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(conf, sink)
+	require.NoError(t, err)
+	return sink
+}
+
+// GatherMetrics returns the gauges and the counters of the in-memory telemetry sink,
+// where the value of a counter is the sum of its increments
+func GatherMetrics(t *testing.T, sink *metrics.InmemSink) (gauges, counters []Metric) {
+	t.Helper()
+	resp, err := sink.DisplayMetrics(nil, nil)
+	require.NoError(t, err)
+	summary, ok := resp.(metrics.MetricsSummary)
+	require.True(t, ok)
+
+	for _, g := range summary.Gauges {
+		gauges = append(gauges, Metric{Name: g.Name, Value: float64(g.Value), Labels: g.DisplayLabels})
+	}
+	for _, c := range summary.Counters {
+		counters = append(counters, Metric{Name: c.Name, Value: c.Sum, Labels: c.DisplayLabels})
+	}
+	return gauges, counters
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"crypto/rand"
 	"encoding/binary"
+	"math"
 	"testing"
 	"time"
 
@@ -45,6 +46,8 @@ type InMemKeeperParams struct {
 	StoreKey       *storetypes.KVStoreKey
 	ParamsSubspace *paramstypes.Subspace
 	Ctx            sdk.Context
+	// ProviderHooks are the optional hooks of the provider keeper
+	ProviderHooks types.ProviderHooks
 }
 
 // NewInMemKeeperParams instantiates in-memory keeper params with default values
@@ -142,6 +145,7 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		address.NewBech32Codec("cosmosvaloper"),
 		address.NewBech32Codec("cosmosvalcons"),
 		authtypes.FeeCollectorName,
+		params.ProviderHooks,
 	)
 }
 
@@ -238,6 +242,8 @@ func SetupForStoppingConsumerChain(t *testing.T, ctx sdk.Context,
 	require.NoError(t, err)
 	err = providerKeeper.SetConsumerChain(ctx, "channelID")
 	require.NoError(t, err)
+	providerKeeper.SetConsumerMetadata(ctx, "chainID", providertypes.ConsumerMetadata{Name: "chain"})
+	providerKeeper.SetConsumerOwner(ctx, "chainID", sdk.AccAddress([]byte("owner")))
 }
 
 // TestProviderStateIsCleanedAfterConsumerChainIsStopped executes test assertions for the provider's state being cleaned
@@ -261,7 +267,17 @@ func TestProviderStateIsCleanedAfterConsumerChainIsStopped(t *testing.T, ctx sdk
 	require.Empty(t, providerKeeper.GetAllValidatorConsumerPubKeys(ctx, &expectedChainID))
 	require.Empty(t, providerKeeper.GetAllValidatorsByConsumerAddr(ctx, &expectedChainID))
 	require.Empty(t, providerKeeper.GetAllConsumerAddrsToPrune(ctx, expectedChainID))
+	require.NotContains(t, providerKeeper.GetConsumerChainsWithAddrsToPrune(ctx, time.Unix(0, math.MaxInt64)), expectedChainID)
 	require.Zero(t, providerKeeper.GetEquivocationEvidenceMinHeight(ctx, expectedChainID))
+
+	// test consumer validator set tracking state is cleaned
+	require.False(t, providerKeeper.IsConsumerValSetTracked(ctx, expectedChainID))
+	require.Empty(t, providerKeeper.GetAllDirtyConsumerValidators(ctx, expectedChainID))
+
+	_, found = providerKeeper.GetConsumerMetadata(ctx, expectedChainID)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerOwner(ctx, expectedChainID)
+	require.False(t, found)
 }
 
 func GetTestConsumerAdditionProp() *providertypes.ConsumerAdditionProposal {
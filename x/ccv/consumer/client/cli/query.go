@@ -95,7 +95,12 @@ func CmdThrottleState() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryThrottleStateRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryThrottleStateRequest{Pagination: pageReq}
 			res, err := queryClient.QueryThrottleState(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -106,6 +111,7 @@ func CmdThrottleState() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "throttle-state")
 
 	return cmd
 }
@@ -160,6 +160,7 @@ func (k Keeper) SendRewardsToProvider(ctx sdk.Context) error {
 		}
 	}
 
+	incrRewardTransmissionCounters(ctx, sentCoins)
 	k.Logger(ctx).Info("sent block rewards to provider",
 		"total fee pool", allBalances.String(),
 		"sent", sentCoins.String(),
@@ -176,6 +177,13 @@ func (k Keeper) SendRewardsToProvider(ctx sdk.Context) error {
 			sdk.NewAttribute(types.AttributeDistributionToProvider, sentCoins.String()),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventRewardsSentToProvider{
+		Height:                 currentHeight,
+		NextDistributionHeight: currentHeight + k.GetBlocksPerDistributionTransmission(ctx),
+		RedistributionFraction: k.GetConsumerRedistributionFrac(ctx),
+		Total:                  allBalances,
+		Sent:                   sentCoins,
+	})
 
 	return nil
 }
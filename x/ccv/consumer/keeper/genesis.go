@@ -14,7 +14,8 @@ import (
 // InitGenesis initializes the CCV consumer state and binds to PortID.
 // The three states in which a consumer chain can start/restart:
 //
-//  1. A client to the provider was never created, i.e. a new consumer chain is started for the first time.
+//  1. A client to the provider was never created, i.e. a new consumer chain is started for the first time,
+//     or a stopped consumer chain is relaunched from its exported state with a new client.
 //  2. A consumer chain restarts after a client to the provider was created, but the CCV channel handshake is still in progress
 //  3. A consumer chain restarts after the CCV channel handshake was completed.
 func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.ValidatorUpdate {
@@ -76,6 +77,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		// set default value for valset update ID
 		k.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight()), uint64(0))
 
+		// a relaunched chain keeps distributing rewards on the schedule of the stopped chain
+		if state.Relaunch {
+			k.SetLastTransmissionBlockHeight(ctx, state.LastTransmissionBlockHeight)
+		}
+
 	} else {
 		// chain restarts with the CCV channel established
 		if state.ProviderChannelId != "" {
@@ -92,6 +98,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 
 			// set last transmission block height
 			k.SetLastTransmissionBlockHeight(ctx, state.LastTransmissionBlockHeight)
+
+			// set the validator set changes received so far from a split VSC packet
+			if state.PartialChanges != nil {
+				k.SetPartialChanges(ctx, *state.PartialChanges)
+			}
 		}
 
 		// Set pending consumer packets, using the depreciated ConsumerPacketDataList type
@@ -152,6 +163,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *types.GenesisState) {
 			k.GetLastTransmissionBlockHeight(ctx),
 			params,
 		)
+
+		// export the parts of a split VSC packet received so far, as the remaining parts
+		// are received after the restart
+		if partialChanges, found := k.GetPartialChanges(ctx); found {
+			genesis.PartialChanges = partialChanges
+		}
 	} else {
 		clientID, ok := k.GetProviderClientID(ctx)
 		// if provider clientID and channelID don't exist on the consumer chain,
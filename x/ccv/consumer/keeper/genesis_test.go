@@ -128,6 +128,34 @@ func TestInitGenesis(t *testing.T) {
 				require.Equal(t, validator.Address.Bytes(), ck.GetAllCCValidator(ctx)[0].Address)
 				require.Equal(t, gs.Params, ck.GetConsumerParams(ctx))
 			},
+		}, {
+			"relaunch a stopped chain",
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				clientStateBytes, err := provClientState.Marshal()
+				require.NoError(t, err)
+				consStateBytes, err := provConsState.Marshal()
+				require.NoError(t, err)
+				gomock.InOrder(
+					testkeeper.ExpectCreateClientMock(ctx, mocks, provClientType, provClientID, clientStateBytes,
+						consStateBytes),
+				)
+			},
+			consumertypes.NewRelaunchGenesisState(
+				consumertypes.GenesisState{
+					Params:                      params,
+					LastTransmissionBlockHeight: consumertypes.LastTransmissionBlockHeight{Height: 100},
+				},
+				*ccv.NewInitialConsumerGenesisState(provClientState, provConsState, valset, params),
+			),
+			func(ctx sdk.Context, ck consumerkeeper.Keeper, gs *consumertypes.GenesisState) {
+				assertConsumerPortIsBound(t, ctx, &ck)
+
+				assertProviderClientID(t, ctx, &ck, provClientID)
+				assertHeightValsetUpdateIDs(t, ctx, &ck, defaultHeightValsetUpdateIDs)
+
+				require.Equal(t, validator.Address.Bytes(), ck.GetAllCCValidator(ctx)[0].Address)
+				require.Equal(t, gs.LastTransmissionBlockHeight, ck.GetLastTransmissionBlockHeight(ctx))
+			},
 		}, {
 			"restart a chain without an established CCV channel",
 			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
@@ -406,6 +434,45 @@ func TestExportGenesis(t *testing.T) {
 	}
 }
 
+// TestExportImportPartialChanges tests that the parts of a split VSC packet received so far are
+// exported and imported, so that the remaining parts can be received after a restart
+func TestExportImportPartialChanges(t *testing.T) {
+	params := ccv.DefaultParams()
+	params.Enabled = true
+	pubKey := ed25519.GenPrivKey().PubKey()
+	tmPK, err := cryptocodec.ToCmtPubKeyInterface(pubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(tmPK, 1)
+	partialChanges := ccv.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{tmtypes.TM2PB.ValidatorUpdate(validator)},
+		ValsetUpdateId:   5,
+		PartIndex:        1,
+		TotalParts:       3,
+	}
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.SetProviderClientID(ctx, "tendermint-07")
+	consumerKeeper.SetProviderChannel(ctx, "provChannelID")
+	cVal, err := consumertypes.NewCCValidator(validator.Address.Bytes(), 1, pubKey)
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, cVal)
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 0, 0)
+	consumerKeeper.SetPartialChanges(ctx, partialChanges)
+
+	gotGen := consumerKeeper.ExportGenesis(ctx)
+	require.Equal(t, &partialChanges, gotGen.PartialChanges)
+	require.NoError(t, gotGen.Validate())
+
+	restartedKeeper, restartedCtx, restartedCtrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer restartedCtrl.Finish()
+	restartedKeeper.InitGenesis(restartedCtx, gotGen)
+	gotChanges, found := restartedKeeper.GetPartialChanges(restartedCtx)
+	require.True(t, found)
+	require.Equal(t, partialChanges, *gotChanges)
+}
+
 // assert that the default CCV consumer port ID is stored and bounded
 func assertConsumerPortIsBound(t *testing.T, ctx sdk.Context, ck *consumerkeeper.Keeper) {
 	t.Helper()
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
 	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
@@ -68,9 +69,22 @@ func (k Keeper) QueryThrottleState(c context.Context,
 	}
 
 	resp.PacketDataQueue = make([]ccvtypes.ConsumerPacketData, 0)
-	pendingPackets := k.GetAllPendingPacketsWithIdx(ctx)
-	for _, packet := range pendingPackets {
-		resp.PacketDataQueue = append(resp.PacketDataQueue, packet.ConsumerPacketData)
+	if req == nil || req.Pagination == nil {
+		pendingPackets := k.GetAllPendingPacketsWithIdx(ctx)
+		for _, packet := range pendingPackets {
+			resp.PacketDataQueue = append(resp.PacketDataQueue, packet.ConsumerPacketData)
+		}
+		return &resp, nil
 	}
+
+	packets, pageRes, err := query.CollectionPaginate(ctx, k.pendingDataPackets, req.Pagination,
+		func(_ uint64, packet ccvtypes.ConsumerPacketData) (ccvtypes.ConsumerPacketData, error) {
+			return packet, nil
+		})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp.PacketDataQueue = append(resp.PacketDataQueue, packets...)
+	resp.Pagination = pageRes
 	return &resp, nil
 }
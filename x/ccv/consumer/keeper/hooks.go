@@ -4,6 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	ccv "github.com/allinbits/interchain-security/x/ccv/types"
 )
@@ -27,3 +30,57 @@ func (k Keeper) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddre
 	}
 	return nil
 }
+
+func (k Keeper) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorRemoved(ctx, consAddr)
+	}
+	return nil
+}
+
+func (k Keeper) AfterValidatorPowerChanged(ctx context.Context, consAddr sdk.ConsAddress, oldPower, newPower int64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorPowerChanged(ctx, consAddr, oldPower, newPower)
+	}
+	return nil
+}
+
+func (k Keeper) AfterValidatorSetChangesApplied(ctx context.Context, valUpdates []abci.ValidatorUpdate) error {
+	if k.hooks != nil {
+		return k.hooks.AfterValidatorSetChangesApplied(ctx, valUpdates)
+	}
+	return nil
+}
+
+func (k Keeper) AfterSlashPacketQueued(ctx context.Context, validator abci.Validator, vscID uint64, infraction stakingtypes.Infraction) error {
+	if k.hooks != nil {
+		return k.hooks.AfterSlashPacketQueued(ctx, validator, vscID, infraction)
+	}
+	return nil
+}
+
+func (k Keeper) AfterSlashPacketAcknowledged(ctx context.Context, validator abci.Validator, vscID uint64, infraction stakingtypes.Infraction) error {
+	if k.hooks != nil {
+		return k.hooks.AfterSlashPacketAcknowledged(ctx, validator, vscID, infraction)
+	}
+	return nil
+}
+
+func (k Keeper) AfterCCVChannelEstablished(ctx context.Context, channelID string) error {
+	if k.hooks != nil {
+		return k.hooks.AfterCCVChannelEstablished(ctx, channelID)
+	}
+	return nil
+}
+
+// callHookInCachedCtx calls hook in a cached context, whose state changes are only
+// written if the hook succeeds. An error is only logged, so that the hook cannot
+// disrupt the CCV packet flow.
+func (k Keeper) callHookInCachedCtx(ctx sdk.Context, hookName string, hook func(cachedCtx sdk.Context) error) {
+	cachedCtx, writeFn := ctx.CacheContext()
+	if err := hook(cachedCtx); err != nil {
+		k.Logger(ctx).Error("consumer hook failed", "hook", hookName, "error", err.Error())
+		return
+	}
+	writeFn()
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	ccv "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestConsumerHooksValidatorSetChanges(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	hooks := testkeeper.NewMockConsumerHooks(ctrl)
+	consumerKeeper.SetHooks(hooks)
+
+	validators := GenerateValidators(t)
+	SetCCValidators(t, consumerKeeper, ctx, validators[:2])
+	consAddr := func(i int) sdk.ConsAddress {
+		return sdk.ConsAddress(validators[i].Address)
+	}
+
+	changes := []abci.ValidatorUpdate{
+		// the power of validator 0 changes
+		{PubKey: tmtypes.TM2PB.ValidatorUpdate(validators[0]).PubKey, Power: validators[0].VotingPower + 1},
+		// validator 1 is removed
+		{PubKey: tmtypes.TM2PB.ValidatorUpdate(validators[1]).PubKey, Power: 0},
+		// validator 2 is added
+		tmtypes.TM2PB.ValidatorUpdate(validators[2]),
+		// validator 3 is not a cross-chain validator, so its removal is not applied
+		{PubKey: tmtypes.TM2PB.ValidatorUpdate(validators[3]).PubKey, Power: 0},
+	}
+
+	gomock.InOrder(
+		hooks.EXPECT().AfterValidatorPowerChanged(ctx, consAddr(0), validators[0].VotingPower, validators[0].VotingPower+1).Return(nil),
+		hooks.EXPECT().AfterValidatorRemoved(ctx, consAddr(1)).Return(nil),
+		hooks.EXPECT().AfterValidatorBonded(ctx, consAddr(2), nil).Return(nil),
+		hooks.EXPECT().AfterValidatorSetChangesApplied(ctx, changes[:3]).Return(nil),
+	)
+	require.Equal(t, changes[:3], consumerKeeper.ApplyCCValidatorChanges(ctx, changes))
+
+	// updates that do not change the power of a validator do not call AfterValidatorPowerChanged
+	sameChanges := []abci.ValidatorUpdate{changes[0]}
+	hooks.EXPECT().AfterValidatorSetChangesApplied(ctx, sameChanges).Return(nil)
+	consumerKeeper.ApplyCCValidatorChanges(ctx, sameChanges)
+
+	// an error of the validator set hooks panics
+	hooks.EXPECT().AfterValidatorRemoved(ctx, consAddr(0)).Return(errors.New("hook error"))
+	require.Panics(t, func() {
+		consumerKeeper.ApplyCCValidatorChanges(ctx, []abci.ValidatorUpdate{
+			{PubKey: changes[0].PubKey, Power: 0},
+		})
+	})
+}
+
+func TestConsumerHooksSlashPackets(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	hooks := testkeeper.NewMockConsumerHooks(ctrl)
+	consumerKeeper.SetHooks(hooks)
+
+	validator := abci.Validator{Address: GenerateValidators(t)[0].Address, Power: 1}
+	infraction := stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
+
+	// the hook is called once the slash packet is queued
+	hooks.EXPECT().AfterSlashPacketQueued(gomock.Any(), validator, uint64(5), infraction).Return(nil)
+	consumerKeeper.QueueSlashPacket(ctx, validator, 5, infraction)
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets, 1)
+	packet := channeltypes.Packet{Data: pendingPackets[0].GetBytes()}
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+
+	// a bounced slash packet does not call the hook
+	ack := channeltypes.NewResultAcknowledgement(ccv.SlashPacketBouncedResult)
+	require.NoError(t, consumerKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+
+	// the hook is called once the provider handled the slash packet;
+	// its error is only logged and does not fail the acknowledgement
+	hooks.EXPECT().AfterSlashPacketAcknowledged(gomock.Any(), validator, uint64(5), infraction).Return(errors.New("hook error"))
+	ack = channeltypes.NewResultAcknowledgement(ccv.SlashPacketHandledResult)
+	require.NoError(t, consumerKeeper.OnAcknowledgementPacket(ctx, packet, ack))
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+}
+
+func TestConsumerHooksEventsEmittedOnce(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	hooks := testkeeper.NewMockConsumerHooks(ctrl)
+	consumerKeeper.SetHooks(hooks)
+
+	validator := abci.Validator{Address: GenerateValidators(t)[0].Address, Power: 1}
+	infraction := stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
+
+	countHookEvents := func() int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "hook_event" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// an event emitted by a succeeding hook is emitted exactly once
+	hooks.EXPECT().AfterSlashPacketQueued(gomock.Any(), validator, uint64(5), infraction).DoAndReturn(
+		func(ctx context.Context, _ abci.Validator, _ uint64, _ stakingtypes.Infraction) error {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("hook_event"))
+			return nil
+		})
+	consumerKeeper.QueueSlashPacket(ctx, validator, 5, infraction)
+	require.Equal(t, 1, countHookEvents())
+
+	// an event emitted by a failing hook is discarded
+	hooks.EXPECT().AfterSlashPacketQueued(gomock.Any(), validator, uint64(6), infraction).DoAndReturn(
+		func(ctx context.Context, _ abci.Validator, _ uint64, _ stakingtypes.Infraction) error {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("hook_event"))
+			return errors.New("hook error")
+		})
+	consumerKeeper.QueueSlashPacket(ctx, validator, 6, infraction)
+	require.Equal(t, 1, countHookEvents())
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
+)
+
+// HistoricalInfoInvariant checks that the power of the cross-chain validators matches
+// the validator set recorded in the HistoricalInfo of the current height.
+//
+// Note that the HistoricalInfo is recorded in BeginBlock, while the validator updates
+// received from the provider are applied to the cross-chain validators in EndBlock.
+// Thus, this invariant only holds between BeginBlock and EndBlock. It is therefore
+// neither registered as a crisis route, which is asserted after EndBlock, nor part of
+// the offline genesis check, which is asserted after the genesis is imported.
+// If no HistoricalInfo was recorded for the current height, e.g., as HistoricalEntries
+// is zero, there is nothing to compare against and the invariant holds.
+func HistoricalInfoInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		hi, err := k.GetHistoricalInfo(ctx, ctx.BlockHeight())
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "historical-info",
+				fmt.Sprintf("\tno historical info at height %d\n", ctx.BlockHeight())), false
+		}
+
+		var (
+			broken bool
+			msg    string
+		)
+
+		histPowers := map[string]int64{}
+		for _, val := range hi.Valset {
+			consAddr, err := val.GetConsAddr()
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tinvalid consensus key of historical validator %s: %s\n", val.OperatorAddress, err)
+				continue
+			}
+			histPowers[sdk.ConsAddress(consAddr).String()] = val.ConsensusPower(sdk.DefaultPowerReduction)
+		}
+
+		for _, ccVal := range k.GetAllCCValidator(ctx) {
+			addr := sdk.ConsAddress(ccVal.Address).String()
+			histPower, found := histPowers[addr]
+			if !found {
+				broken = true
+				msg += fmt.Sprintf("\tcross-chain validator %s is missing from the historical info\n", addr)
+				continue
+			}
+			if histPower != ccVal.Power {
+				broken = true
+				msg += fmt.Sprintf("\tcross-chain validator %s has power %d, but %d in the historical info\n",
+					addr, ccVal.Power, histPower)
+			}
+			delete(histPowers, addr)
+		}
+
+		// report the remaining historical validators in a deterministic order
+		remaining := make([]string, 0, len(histPowers))
+		for addr := range histPowers {
+			remaining = append(remaining, addr)
+		}
+		sort.Strings(remaining)
+		for _, addr := range remaining {
+			broken = true
+			msg += fmt.Sprintf("\thistorical validator %s is not a cross-chain validator\n", addr)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "historical-info", msg), broken
+	}
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/consumer/keeper"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestHistoricalInfoInvariant(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(15)
+	consumerKeeper.SetParams(ctx, ccvtypes.DefaultParams())
+
+	validators := GenerateValidators(t)
+	SetCCValidators(t, consumerKeeper, ctx, validators)
+
+	// no historical info recorded at the current height
+	_, broken := keeper.HistoricalInfoInvariant(consumerKeeper)(ctx)
+	require.False(t, broken)
+
+	// the historical info is recorded from the cross-chain validators
+	require.NoError(t, consumerKeeper.TrackHistoricalInfo(ctx))
+	_, broken = keeper.HistoricalInfoInvariant(consumerKeeper)(ctx)
+	require.False(t, broken)
+
+	// the power of a cross-chain validator changes
+	ccVal, found := consumerKeeper.GetCCValidator(ctx, validators[0].Address)
+	require.True(t, found)
+	ccVal.Power++
+	consumerKeeper.SetCCValidator(ctx, ccVal)
+	_, broken = keeper.HistoricalInfoInvariant(consumerKeeper)(ctx)
+	require.True(t, broken)
+
+	// a cross-chain validator is removed
+	ccVal.Power--
+	consumerKeeper.SetCCValidator(ctx, ccVal)
+	_, broken = keeper.HistoricalInfoInvariant(consumerKeeper)(ctx)
+	require.False(t, broken)
+	consumerKeeper.DeleteCCValidator(ctx, validators[1].Address)
+	_, broken = keeper.HistoricalInfoInvariant(consumerKeeper)(ctx)
+	require.True(t, broken)
+}
@@ -1,12 +1,15 @@
 package keeper
 
 import (
-	"encoding/binary"
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
+	"cosmossdk.io/collections"
 	addresscodec "cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
+	"github.com/cosmos/gogoproto/proto"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -16,6 +19,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -54,6 +58,13 @@ type Keeper struct {
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+
+	Schema                collections.Schema
+	historicalInfo        collections.Map[uint64, stakingtypes.HistoricalInfo]
+	heightValsetUpdateIDs collections.Map[uint64, uint64]
+	outstandingDowntimes  collections.KeySet[sdk.ConsAddress]
+	pendingDataPackets    collections.Map[uint64, ccv.ConsumerPacketData]
+	ccValidators          collections.Map[[]byte, types.CrossChainValidator]
 }
 
 // NewKeeper creates a new Consumer Keeper instance
@@ -74,10 +85,12 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(ccv.ParamKeyTable())
 	}
 
+	storeService := runtime.NewKVStoreService(key.(*storetypes.KVStoreKey))
 	k := Keeper{
-		authority: authority,
-		storeKey:  key,
-		cdc:       cdc,
+		authority:    authority,
+		storeKey:     key,
+		storeService: storeService,
+		cdc:          cdc,
 		// IBC v10: scopedKeeper and portKeeper fields removed
 		channelKeeper:           channelKeeper,
 		connectionKeeper:        connectionKeeper,
@@ -93,6 +106,25 @@ func NewKeeper(
 		consensusAddressCodec:   consensusAddressCodec,
 	}
 
+	sb := collections.NewSchemaBuilder(storeService)
+	// Historical info is keyed by the big endian encoding of uint64(height),
+	// see HistoricalInfoKey, hence collections.Int64Key cannot be used.
+	k.historicalInfo = collections.NewMap(sb, types.HistoricalInfoPrefix, "historical_info",
+		collections.Uint64Key, codec.CollValue[stakingtypes.HistoricalInfo](cdc))
+	k.heightValsetUpdateIDs = collections.NewMap(sb, types.HeightValsetUpdateIDPrefix, "height_valset_update_ids",
+		collections.Uint64Key, collections.Uint64Value)
+	k.outstandingDowntimes = collections.NewKeySet(sb, types.OutstandingDowntimePrefix, "outstanding_downtimes",
+		sdk.ConsAddressKey)
+	k.pendingDataPackets = collections.NewMap(sb, types.PendingDataPacketsPrefix, "pending_data_packets",
+		collections.Uint64Key, codec.CollValue[ccv.ConsumerPacketData](cdc))
+	k.ccValidators = collections.NewMap(sb, types.CrossChainValidatorPrefix, "cross_chain_validators",
+		collections.BytesKey, codec.CollValue[types.CrossChainValidator](cdc))
+	schema, err := sb.Build()
+	if err != nil {
+		panic(fmt.Errorf("failed to build consumer collections schema: %w", err))
+	}
+	k.Schema = schema
+
 	k.mustValidateFields()
 	return k
 }
@@ -121,9 +153,9 @@ func (k *Keeper) SetStandaloneStakingKeeper(sk ccv.StakingKeeper) {
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	// We have 17 fields (ICS v7.0.1 has 16, but we added storeService)
-	if reflect.ValueOf(k).NumField() != 17 {
-		panic("number of fields in consumer keeper is not 17")
+	// We have 23 fields (ICS v7.0.1 has 16, but we added storeService and the collections)
+	if reflect.ValueOf(k).NumField() != 23 {
+		panic("number of fields in consumer keeper is not 23")
 	}
 
 	// Note 116 / 16 fields will be validated,
@@ -145,6 +177,22 @@ func (k Keeper) mustValidateFields() {
 	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 14
 	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 15
 	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 16
+	ccv.PanicIfZeroOrNil(k.storeService, "storeService")                   // 17
+	// the Schema and the collections are built by NewKeeper and hence not validated
+}
+
+// mustGet returns the value returned by get for key and whether it was found.
+// It panics on errors other than collections.ErrNotFound, since these
+// would indicate that the store is corrupted.
+func mustGet[K, V any](ctx context.Context, get func(context.Context, K) (V, error), key K) (V, bool) {
+	v, err := get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v, false
+	}
+	if err != nil {
+		panic(fmt.Errorf("failed to get %v: %w", key, err))
+	}
+	return v, true
 }
 
 // ValidatorAddressCodec returns the app validator address codec.
@@ -162,6 +210,14 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.SubModuleName+"-"+types.ModuleName)
 }
 
+// emitTypedEvent emits the given typed event next to the string events of the module
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		// the typed events are defined by this module, so this should never happen
+		k.Logger(ctx).Error("failed to emit typed event", "event", proto.MessageName(event), "error", err.Error())
+	}
+}
+
 func (k *Keeper) SetHooks(sh ccv.ConsumerHooks) *Keeper {
 	if k.hooks != nil {
 		// This should never happen as SetHooks is expected
@@ -270,6 +326,41 @@ func (k Keeper) DeletePendingChanges(ctx sdk.Context) {
 	store.Delete(types.PendingChangesKey())
 }
 
+// SetPartialChanges sets the validator set changes accumulated from the parts
+// of a split VSC packet that were received so far
+func (k Keeper) SetPartialChanges(ctx sdk.Context, changes ccv.ValidatorSetChangePacketData) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := changes.Marshal()
+	if err != nil {
+		// This should never happen
+		panic(fmt.Errorf("failed to marshal PartialChanges: %w", err))
+	}
+	store.Set(types.PartialChangesKey(), bz)
+}
+
+// GetPartialChanges gets the validator set changes accumulated from the parts
+// of a split VSC packet that were received so far
+func (k Keeper) GetPartialChanges(ctx sdk.Context) (*ccv.ValidatorSetChangePacketData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PartialChangesKey())
+	if bz == nil {
+		return nil, false
+	}
+	var data ccv.ValidatorSetChangePacketData
+	if err := data.Unmarshal(bz); err != nil {
+		// This should never happen as PartialChanges is expected
+		// to be correctly serialized in SetPartialChanges
+		panic(fmt.Errorf("failed to unmarshal PartialChanges: %w", err))
+	}
+	return &data, true
+}
+
+// DeletePartialChanges deletes the validator set changes accumulated from the parts of a split VSC packet
+func (k Keeper) DeletePartialChanges(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PartialChangesKey())
+}
+
 func (k Keeper) GetInitGenesisHeight(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.InitGenesisHeightKey())
@@ -355,26 +446,22 @@ func (k Keeper) VerifyProviderChain(ctx sdk.Context, connectionHops []string) er
 
 // SetHeightValsetUpdateID sets the valset update id for a given block height
 func (k Keeper) SetHeightValsetUpdateID(ctx sdk.Context, height, valsetUpdateId uint64) {
-	store := ctx.KVStore(k.storeKey)
-	valBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(valBytes, valsetUpdateId)
-	store.Set(types.HeightValsetUpdateIDKey(height), valBytes)
+	if err := k.heightValsetUpdateIDs.Set(ctx, height, valsetUpdateId); err != nil {
+		panic(fmt.Errorf("failed to set valset update id: %w", err))
+	}
 }
 
 // GetHeightValsetUpdateID gets the valset update id recorded for a given block height
 func (k Keeper) GetHeightValsetUpdateID(ctx sdk.Context, height uint64) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.HeightValsetUpdateIDKey(height))
-	if bz == nil {
-		return 0
-	}
-	return binary.BigEndian.Uint64(bz)
+	vscID, _ := mustGet(ctx, k.heightValsetUpdateIDs.Get, height)
+	return vscID
 }
 
 // DeleteHeightValsetUpdateID deletes the valset update id for a given block height
 func (k Keeper) DeleteHeightValsetUpdateID(ctx sdk.Context, height uint64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.HeightValsetUpdateIDKey(height))
+	if err := k.heightValsetUpdateIDs.Remove(ctx, height); err != nil {
+		panic(fmt.Errorf("failed to delete valset update id: %w", err))
+	}
 }
 
 // GetAllHeightToValsetUpdateIDs returns a list of all the block heights to valset update IDs in the store
@@ -383,18 +470,15 @@ func (k Keeper) DeleteHeightValsetUpdateID(ctx sdk.Context, height uint64) {
 // HeightValsetUpdateIDBytePrefix | height
 // Thus, the returned array is in ascending order of heights.
 func (k Keeper) GetAllHeightToValsetUpdateIDs(ctx sdk.Context) (heightToValsetUpdateIDs []types.HeightToValsetUpdateID) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.HeightValsetUpdateIDBytePrefix})
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		height := binary.BigEndian.Uint64(iterator.Key()[1:])
-		vscID := binary.BigEndian.Uint64(iterator.Value())
-
+	err := k.heightValsetUpdateIDs.Walk(ctx, nil, func(height, vscID uint64) (bool, error) {
 		heightToValsetUpdateIDs = append(heightToValsetUpdateIDs, types.HeightToValsetUpdateID{
 			Height:         height,
 			ValsetUpdateId: vscID,
 		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate valset update ids: %w", err))
 	}
 
 	return heightToValsetUpdateIDs
@@ -402,21 +486,25 @@ func (k Keeper) GetAllHeightToValsetUpdateIDs(ctx sdk.Context) (heightToValsetUp
 
 // OutstandingDowntime returns the outstanding downtime flag for a given validator
 func (k Keeper) OutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) bool {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.OutstandingDowntimeKey(address))
-	return bz != nil
+	found, err := k.outstandingDowntimes.Has(ctx, address)
+	if err != nil {
+		panic(fmt.Errorf("failed to check outstanding downtime: %w", err))
+	}
+	return found
 }
 
 // SetOutstandingDowntime sets the outstanding downtime flag for a given validator
 func (k Keeper) SetOutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.OutstandingDowntimeKey(address), []byte{})
+	if err := k.outstandingDowntimes.Set(ctx, address); err != nil {
+		panic(fmt.Errorf("failed to set outstanding downtime: %w", err))
+	}
 }
 
 // DeleteOutstandingDowntime deletes the outstanding downtime flag for the given validator consensus address
 func (k Keeper) DeleteOutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.OutstandingDowntimeKey(address))
+	if err := k.outstandingDowntimes.Remove(ctx, address); err != nil {
+		panic(fmt.Errorf("failed to delete outstanding downtime: %w", err))
+	}
 }
 
 // GetAllOutstandingDowntimes gets an array of the validator addresses of outstanding downtime flags
@@ -425,17 +513,14 @@ func (k Keeper) DeleteOutstandingDowntime(ctx sdk.Context, address sdk.ConsAddre
 // OutstandingDowntimeBytePrefix | consAddress
 // Thus, the returned array is in ascending order of consAddresses.
 func (k Keeper) GetAllOutstandingDowntimes(ctx sdk.Context) (downtimes []types.OutstandingDowntime) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.OutstandingDowntimeBytePrefix})
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		addrBytes := iterator.Key()[1:]
-		addr := sdk.ConsAddress(addrBytes).String()
-
+	err := k.outstandingDowntimes.Walk(ctx, nil, func(addr sdk.ConsAddress) (bool, error) {
 		downtimes = append(downtimes, types.OutstandingDowntime{
-			ValidatorConsensusAddress: addr,
+			ValidatorConsensusAddress: addr.String(),
 		})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate outstanding downtimes: %w", err))
 	}
 
 	return downtimes
@@ -443,29 +528,21 @@ func (k Keeper) GetAllOutstandingDowntimes(ctx sdk.Context) (downtimes []types.O
 
 // SetCCValidator sets a cross-chain validator under its validator address
 func (k Keeper) SetCCValidator(ctx sdk.Context, v types.CrossChainValidator) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&v)
-
-	store.Set(types.CrossChainValidatorKey(v.Address), bz)
+	if err := k.ccValidators.Set(ctx, v.Address, v); err != nil {
+		panic(fmt.Errorf("failed to set cross-chain validator: %w", err))
+	}
 }
 
 // GetCCValidator returns a cross-chain validator for a given address
 func (k Keeper) GetCCValidator(ctx sdk.Context, addr []byte) (validator types.CrossChainValidator, found bool) {
-	store := ctx.KVStore(k.storeKey)
-	v := store.Get(types.CrossChainValidatorKey(addr))
-	if v == nil {
-		return
-	}
-	k.cdc.MustUnmarshal(v, &validator)
-	found = true
-
-	return
+	return mustGet(ctx, k.ccValidators.Get, addr)
 }
 
 // DeleteCCValidator deletes a cross-chain validator for a given address
 func (k Keeper) DeleteCCValidator(ctx sdk.Context, addr []byte) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.CrossChainValidatorKey(addr))
+	if err := k.ccValidators.Remove(ctx, addr); err != nil {
+		panic(fmt.Errorf("failed to delete cross-chain validator: %w", err))
+	}
 }
 
 // GetAllCCValidator returns all cross-chain validators
@@ -474,14 +551,13 @@ func (k Keeper) DeleteCCValidator(ctx sdk.Context, addr []byte) {
 // CrossChainValidatorBytePrefix | address
 // Thus, the returned array is in ascending order of addresses.
 func (k Keeper) GetAllCCValidator(ctx sdk.Context) (validators []types.CrossChainValidator) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.CrossChainValidatorBytePrefix})
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		val := types.CrossChainValidator{}
-		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		validators = append(validators, val)
+	iterator, err := k.ccValidators.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate cross-chain validators: %w", err))
+	}
+	validators, err = iterator.Values()
+	if err != nil {
+		panic(fmt.Errorf("failed to unmarshal cross-chain validator: %w", err))
 	}
 
 	return validators
@@ -502,13 +578,20 @@ func (k Keeper) getAndIncrementPendingPacketsIdx(ctx sdk.Context) (toReturn uint
 
 // DeleteHeadOfPendingPackets deletes the head of the pending packets queue.
 func (k Keeper) DeleteHeadOfPendingPackets(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingDataPacketsBytePrefix})
-	defer iterator.Close()
+	iterator, err := k.pendingDataPackets.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate pending data packets: %w", err))
+	}
 	if !iterator.Valid() {
+		iterator.Close()
 		return
 	}
-	store.Delete(iterator.Key())
+	idx, err := iterator.Key()
+	iterator.Close()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse pending data packet index: %w", err))
+	}
+	k.DeletePendingDataPackets(ctx, idx)
 }
 
 // GetPendingPackets returns ALL the pending CCV packets from the store without indexes.
@@ -534,64 +617,45 @@ type ConsumerPacketDataWithIdx struct {
 // with indexes relevant to the pending packets queue.
 func (k Keeper) GetAllPendingPacketsWithIdx(ctx sdk.Context) []ConsumerPacketDataWithIdx {
 	packets := []ConsumerPacketDataWithIdx{}
-	store := ctx.KVStore(k.storeKey)
-	// Note: PendingDataPacketsBytePrefix is the correct prefix, NOT PendingDataPacketsByteKey.
-	// See consistency with PendingDataPacketsKey().
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingDataPacketsBytePrefix})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var packet ccv.ConsumerPacketData
-		bz := iterator.Value()
-		err := packet.Unmarshal(bz)
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			panic(fmt.Errorf("failed to unmarshal pending data packet: %w", err))
-		}
-		packetWithIdx := ConsumerPacketDataWithIdx{
+	// Note: the pending data packets are stored under PendingDataPacketsBytePrefix,
+	// NOT PendingDataPacketsByteKey. See consistency with PendingDataPacketsKey().
+	err := k.pendingDataPackets.Walk(ctx, nil, func(idx uint64, packet ccv.ConsumerPacketData) (bool, error) {
+		packets = append(packets, ConsumerPacketDataWithIdx{
 			ConsumerPacketData: packet,
-			// index stored in key after prefix, see PendingDataPacketsKey()
-			Idx: sdk.BigEndianToUint64(iterator.Key()[1:]),
-		}
-		packets = append(packets, packetWithIdx)
+			Idx:                idx,
+		})
+		return false, nil
+	})
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		panic(fmt.Errorf("failed to unmarshal pending data packet: %w", err))
 	}
 	return packets
 }
 
 // DeletePendingDataPackets deletes pending data packets with given indexes
 func (k Keeper) DeletePendingDataPackets(ctx sdk.Context, idxs ...uint64) {
-	store := ctx.KVStore(k.storeKey)
 	for _, idx := range idxs {
-		store.Delete(types.PendingDataPacketsKey(idx))
+		if err := k.pendingDataPackets.Remove(ctx, idx); err != nil {
+			panic(fmt.Errorf("failed to delete pending data packet: %w", err))
+		}
 	}
 }
 
 func (k Keeper) DeleteAllPendingDataPackets(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	// Note: PendingDataPacketsBytePrefix is the correct prefix, NOT PendingDataPacketsByteKey.
-	// See consistency with PendingDataPacketsKey().
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingDataPacketsBytePrefix})
-	keysToDel := [][]byte{}
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		keysToDel = append(keysToDel, iterator.Key())
-	}
-	for _, key := range keysToDel {
-		store.Delete(key)
+	if err := k.pendingDataPackets.Clear(ctx, nil); err != nil {
+		panic(fmt.Errorf("failed to delete pending data packets: %w", err))
 	}
 }
 
 // AppendPendingPacket enqueues the given data packet to the end of the pending data packets queue
 func (k Keeper) AppendPendingPacket(ctx sdk.Context, packetType ccv.ConsumerPacketDataType, data ccv.ExportedIsConsumerPacketData_Data) {
 	idx := k.getAndIncrementPendingPacketsIdx(ctx) // for FIFO queue
-	key := types.PendingDataPacketsKey(idx)
-	store := ctx.KVStore(k.storeKey)
 	cpd := ccv.NewConsumerPacketData(packetType, data)
-	bz, err := cpd.Marshal()
-	if err != nil {
+	if err := k.pendingDataPackets.Set(ctx, idx, cpd); err != nil {
 		// This should never happen
 		panic(fmt.Errorf("failed to marshal ConsumerPacketData: %w", err))
 	}
-	store.Set(key, bz)
 }
 
 func (k Keeper) MarkAsPrevStandaloneChain(ctx sdk.Context) {
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v5 "github.com/allinbits/interchain-security/x/ccv/consumer/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
-// Note: This is a complete fork, so no migrations are implemented.
 type Migrator struct {
 	keeper     Keeper
 	paramSpace paramtypes.Subspace
@@ -15,3 +17,10 @@ type Migrator struct {
 func NewMigrator(keeper Keeper, paramspace paramtypes.Subspace) Migrator {
 	return Migrator{keeper: keeper, paramSpace: paramspace}
 }
+
+// Migrate4to5 migrates x/ccvconsumer state from consensus version 4 to 5.
+// The migration consists of removing the deprecated state before the keeper
+// moves to collections.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateToCollections(ctx, m.keeper.storeKey)
+}
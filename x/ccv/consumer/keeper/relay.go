@@ -25,6 +25,8 @@ import (
 //
 // Note: CCV uses an ordered IBC channel, meaning VSC packet changes will be accumulated (and later
 // processed by ApplyCCValidatorChanges) s.t. more recent val power changes overwrite older ones.
+// The changes of a VSC packet that the provider split into several parts are accumulated only
+// once the last part is received.
 func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, newChanges ccv.ValidatorSetChangePacketData) error {
 	// validate packet data upon receiving
 	if err := newChanges.Validate(); err != nil {
@@ -54,24 +56,15 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 				sdk.NewAttribute(channeltypes.AttributeKeyPortID, packet.DestinationPort),
 			),
 		)
-	}
-	// Set pending changes by accumulating changes from this packet with all prior changes
-	currentValUpdates := []abci.ValidatorUpdate{}
-	currentChanges, exists := k.GetPendingChanges(ctx)
-	if exists {
-		currentValUpdates = currentChanges.ValidatorUpdates
-	}
-	pendingChanges := ccv.AccumulateChanges(currentValUpdates, newChanges.ValidatorUpdates)
-
-	k.SetPendingChanges(ctx, ccv.ValidatorSetChangePacketData{
-		ValidatorUpdates: pendingChanges,
-	})
-
-	// set height to VSC id mapping
-	blockHeight := uint64(ctx.BlockHeight()) + 1
-	k.SetHeightValsetUpdateID(ctx, blockHeight, newChanges.ValsetUpdateId)
-	k.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
+		k.emitTypedEvent(ctx, &types.EventCCVChannelEstablished{
+			PortId:    packet.DestinationPort,
+			ChannelId: packet.DestinationChannel,
+		})
 
+		k.callHookInCachedCtx(ctx, "AfterCCVChannelEstablished", func(cachedCtx sdk.Context) error {
+			return k.AfterCCVChannelEstablished(cachedCtx, packet.DestinationChannel)
+		})
+	}
 	// remove outstanding slashing flags of the validators
 	// for which the slashing was acknowledged by the provider chain
 	for _, ack := range newChanges.GetSlashAcks() {
@@ -89,14 +82,107 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 		k.DeleteOutstandingDowntime(ctx, consAddr)
 	}
 
+	// the validator updates of a split VSC packet are only applied once its last part is received
+	newValUpdates, complete, err := k.accumulatePartialChanges(ctx, newChanges)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		k.Logger(ctx).Info("received part of split VSCPacket",
+			"vscID", newChanges.ValsetUpdateId,
+			"part", newChanges.PartIndex,
+			"total parts", newChanges.TotalParts,
+		)
+		return nil
+	}
+
+	// Set pending changes by accumulating changes from this packet with all prior changes
+	currentValUpdates := []abci.ValidatorUpdate{}
+	currentChanges, exists := k.GetPendingChanges(ctx)
+	if exists {
+		currentValUpdates = currentChanges.ValidatorUpdates
+	}
+	pendingChanges := ccv.AccumulateChanges(currentValUpdates, newValUpdates)
+
+	k.SetPendingChanges(ctx, ccv.ValidatorSetChangePacketData{
+		ValidatorUpdates: pendingChanges,
+	})
+
+	// set height to VSC id mapping
+	blockHeight := uint64(ctx.BlockHeight()) + 1
+	k.SetHeightValsetUpdateID(ctx, blockHeight, newChanges.ValsetUpdateId)
+	k.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
+
 	k.Logger(ctx).Info("finished receiving/handling VSCPacket",
 		"vscID", newChanges.ValsetUpdateId,
-		"len updates", len(newChanges.ValidatorUpdates),
+		"len updates", len(newValUpdates),
 		"len slash acks", len(newChanges.SlashAcks),
 	)
+	k.emitTypedEvent(ctx, &types.EventVSCPacketReceived{
+		VscId:        newChanges.ValsetUpdateId,
+		NumUpdates:   uint64(len(newValUpdates)),
+		NumSlashAcks: uint64(len(newChanges.SlashAcks)),
+	})
 	return nil
 }
 
+// accumulatePartialChanges handles the parts of VSC packets that the provider split into
+// several packets with the same vscID. It returns the validator updates to be applied and
+// whether the validator set change is complete, i.e., whether the packet is unsplit or the
+// last part of a split validator set change. Until then, the validator updates received so
+// far are kept in the store.
+//
+// Note: CCV uses an ordered IBC channel, so the parts of a split VSC packet are received
+// consecutively and in order.
+func (k Keeper) accumulatePartialChanges(
+	ctx sdk.Context,
+	newChanges ccv.ValidatorSetChangePacketData,
+) ([]abci.ValidatorUpdate, bool, error) {
+	partialChanges, found := k.GetPartialChanges(ctx)
+	if !newChanges.IsSplit() {
+		if found {
+			return nil, false, errorsmod.Wrapf(ccv.ErrInvalidPacketData,
+				"received VSCPacket with vscID %d before the last part of VSCPacket with vscID %d",
+				newChanges.ValsetUpdateId, partialChanges.ValsetUpdateId)
+		}
+		return newChanges.ValidatorUpdates, true, nil
+	}
+
+	// check that the part is the one following the previously received part
+	expectedIndex := uint32(0)
+	if found {
+		if partialChanges.ValsetUpdateId != newChanges.ValsetUpdateId || partialChanges.TotalParts != newChanges.TotalParts {
+			return nil, false, errorsmod.Wrapf(ccv.ErrInvalidPacketData,
+				"received part of VSCPacket with vscID %d before the last part of VSCPacket with vscID %d",
+				newChanges.ValsetUpdateId, partialChanges.ValsetUpdateId)
+		}
+		expectedIndex = partialChanges.PartIndex + 1
+	}
+	if newChanges.PartIndex != expectedIndex {
+		return nil, false, errorsmod.Wrapf(ccv.ErrInvalidPacketData,
+			"unexpected part %d of VSCPacket with vscID %d; expected part %d",
+			newChanges.PartIndex, newChanges.ValsetUpdateId, expectedIndex)
+	}
+
+	valUpdates := newChanges.ValidatorUpdates
+	if found {
+		valUpdates = ccv.AccumulateChanges(partialChanges.ValidatorUpdates, newChanges.ValidatorUpdates)
+	}
+
+	if newChanges.IsLastPart() {
+		k.DeletePartialChanges(ctx)
+		return valUpdates, true, nil
+	}
+
+	k.SetPartialChanges(ctx, ccv.ValidatorSetChangePacketData{
+		ValidatorUpdates: valUpdates,
+		ValsetUpdateId:   newChanges.ValsetUpdateId,
+		PartIndex:        newChanges.PartIndex,
+		TotalParts:       newChanges.TotalParts,
+	})
+	return nil, false, nil
+}
+
 // QueueSlashPacket appends a slash packet containing the given validator data and slashing info to queue.
 func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, valsetUpdateID uint64, infraction stakingtypes.Infraction) {
 	consAddr := sdk.ConsAddress(validator.Address)
@@ -140,6 +226,15 @@ func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, vals
 			sdk.NewAttribute(ccv.AttributeInfractionType, infraction.String()),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventSlashPacketQueued{
+		ValidatorConsAddr: consAddr.String(),
+		VscId:             valsetUpdateID,
+		Infraction:        infraction,
+	})
+
+	k.callHookInCachedCtx(ctx, "AfterSlashPacketQueued", func(cachedCtx sdk.Context) error {
+		return k.AfterSlashPacketQueued(cachedCtx, validator, valsetUpdateID, infraction)
+	})
 }
 
 // SendPackets iterates queued packets and sends them in FIFO order.
@@ -151,6 +246,8 @@ func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, vals
 // operations that resulted in validator updates included in that VSC have matured on
 // the consumer chain.
 func (k Keeper) SendPackets(ctx sdk.Context) {
+	defer k.setPendingPacketsGauges(ctx)
+
 	channelID, ok := k.GetProviderChannel(ctx)
 	if !ok {
 		return
@@ -230,11 +327,21 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		case ccv.V1Result[0]:
 			k.ClearSlashRecord(ctx)           // Clears slash record state, unblocks sending of pending packets.
 			k.DeleteHeadOfPendingPackets(ctx) // Remove slash from head of queue. It's been handled.
+			k.afterSlashPacketAcknowledged(ctx, consumerPacket)
 		case ccv.SlashPacketHandledResult[0]:
 			k.ClearSlashRecord(ctx)           // Clears slash record state, unblocks sending of pending packets.
 			k.DeleteHeadOfPendingPackets(ctx) // Remove slash from head of queue. It's been handled.
+			k.afterSlashPacketAcknowledged(ctx, consumerPacket)
 		case ccv.SlashPacketBouncedResult[0]:
 			k.UpdateSlashRecordOnBounce(ctx)
+			if slashPacket := consumerPacket.GetSlashPacketData(); slashPacket != nil {
+				data := slashPacket.FromV1()
+				k.emitTypedEvent(ctx, &types.EventSlashPacketBounced{
+					ValidatorConsAddr: sdk.ConsAddress(data.Validator.Address).String(),
+					VscId:             data.ValsetUpdateId,
+					Infraction:        data.Infraction,
+				})
+			}
 			// Note slash is still at head of queue and will now be retried after appropriate delay period.
 		default:
 			return fmt.Errorf("unrecognized acknowledgement result: %c", res[0])
@@ -269,6 +376,24 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 	return nil
 }
 
+// afterSlashPacketAcknowledged emits the event and calls the AfterSlashPacketAcknowledged
+// hook for a slash packet that was handled by the provider chain
+func (k Keeper) afterSlashPacketAcknowledged(ctx sdk.Context, consumerPacket ccv.ConsumerPacketDataV1) {
+	slashPacketV1 := consumerPacket.GetSlashPacketData()
+	if slashPacketV1 == nil {
+		return
+	}
+	slashPacket := slashPacketV1.FromV1()
+	k.emitTypedEvent(ctx, &types.EventSlashPacketHandled{
+		ValidatorConsAddr: sdk.ConsAddress(slashPacket.Validator.Address).String(),
+		VscId:             slashPacket.ValsetUpdateId,
+		Infraction:        slashPacket.Infraction,
+	})
+	k.callHookInCachedCtx(ctx, "AfterSlashPacketAcknowledged", func(cachedCtx sdk.Context) error {
+		return k.AfterSlashPacketAcknowledged(cachedCtx, slashPacket.Validator, slashPacket.ValsetUpdateId, slashPacket.Infraction)
+	})
+}
+
 // IsChannelClosed returns a boolean whether a given channel is in the CLOSED state
 func (k Keeper) IsChannelClosed(ctx sdk.Context, channelID string) bool {
 	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ConsumerPortID, channelID)
@@ -235,6 +235,57 @@ func TestOnRecvVSCPacketDuplicateUpdates(t *testing.T) {
 	require.Equal(t, valUpdates[1], gotPendingChanges.ValidatorUpdates[0]) // Only latest update should be kept
 }
 
+// TestOnRecvSplitVSCPacket tests that the validator updates of a VSC packet split into
+// several parts are only added to the pending changes once the last part is received.
+func TestOnRecvSplitVSCPacket(t *testing.T) {
+	consumerCCVChannelID := "consumerCCVChannelID"
+	providerCCVChannelID := "providerCCVChannelID"
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, consumerCCVChannelID)
+
+	valUpdates := []abci.ValidatorUpdate{}
+	for i := 0; i < 5; i++ {
+		valUpdates = append(valUpdates, abci.ValidatorUpdate{
+			PubKey: crypto.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey(),
+			Power:  int64(i + 1),
+		})
+	}
+	parts := types.SplitValidatorSetChangePacketData(valUpdates, 5, nil, 2)
+	require.Len(t, parts, 3)
+
+	recv := func(seq uint64, data types.ValidatorSetChangePacketData) error {
+		packet := channeltypes.NewPacket(data.GetBytes(), seq, types.ProviderPortID, providerCCVChannelID,
+			types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
+		var newChanges types.ValidatorSetChangePacketData
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(packet.GetData(), &newChanges))
+		return consumerKeeper.OnRecvVSCPacket(ctx, packet, newChanges)
+	}
+
+	// parts must be received in order
+	require.Error(t, recv(1, parts[1]))
+
+	// no changes are pending before the last part is received
+	for i, part := range parts[:2] {
+		require.NoError(t, recv(uint64(i+1), part))
+		_, found := consumerKeeper.GetPendingChanges(ctx)
+		require.False(t, found)
+		require.Zero(t, consumerKeeper.GetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight())+1))
+	}
+
+	// an unsplit packet cannot interleave with the parts of a split packet
+	require.Error(t, recv(3, types.NewValidatorSetChangePacketData(valUpdates[:1], 6, nil)))
+
+	require.NoError(t, recv(3, parts[2]))
+	pendingChanges, found := consumerKeeper.GetPendingChanges(ctx)
+	require.True(t, found)
+	require.ElementsMatch(t, valUpdates, pendingChanges.ValidatorUpdates)
+	require.Equal(t, uint64(5), consumerKeeper.GetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight())+1))
+	_, found = consumerKeeper.GetPartialChanges(ctx)
+	require.False(t, found)
+}
+
 // TestSendPackets tests the SendPackets method failing
 func TestSendPacketsFailure(t *testing.T) {
 	// Keeper setup
@@ -518,3 +569,48 @@ func TestSendPacketsDeletion(t *testing.T) {
 	// Expect the slash packet to remain
 	require.Equal(t, types.SlashPacket, consumerKeeper.GetPendingPackets(ctx)[0].Type)
 }
+
+// TestSlashPacketTypedEvents tests the typed events emitted while queuing a slash packet
+// and once the provider handles or bounces it
+func TestSlashPacketTypedEvents(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	validator := abci.Validator{Address: consAddr, Power: 1}
+	infraction := stakingtypes.Infraction_INFRACTION_DOWNTIME
+
+	typedEvents := func(ctx sdk.Context) []string {
+		names := []string{}
+		for _, ev := range ctx.EventManager().Events() {
+			if msg, err := sdk.ParseTypedEvent(abci.Event(ev)); err == nil {
+				names = append(names, fmt.Sprintf("%T", msg))
+			}
+		}
+		return names
+	}
+
+	consumerKeeper.QueueSlashPacket(ctx, validator, 7, infraction)
+	require.Equal(t, []string{"*types.EventSlashPacketQueued"}, typedEvents(ctx))
+
+	packet := channeltypes.Packet{Data: consumerKeeper.GetPendingPackets(ctx)[0].GetBytes()}
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+
+	bounceCtx := ctx.WithEventManager(sdk.NewEventManager())
+	ack := channeltypes.NewResultAcknowledgement(types.SlashPacketBouncedResult)
+	require.NoError(t, consumerKeeper.OnAcknowledgementPacket(bounceCtx, packet, ack))
+	require.Equal(t, []string{"*types.EventSlashPacketBounced"}, typedEvents(bounceCtx))
+
+	handledCtx := ctx.WithEventManager(sdk.NewEventManager())
+	ack = channeltypes.NewResultAcknowledgement(types.SlashPacketHandledResult)
+	require.NoError(t, consumerKeeper.OnAcknowledgementPacket(handledCtx, packet, ack))
+	events := handledCtx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &consumertypes.EventSlashPacketHandled{
+		ValidatorConsAddr: consAddr.String(),
+		VscId:             7,
+		Infraction:        infraction,
+	}, msg)
+}
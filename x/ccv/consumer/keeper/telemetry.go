@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
+	ccv "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// setPendingPacketsGauges sets the gauges of the packets pending to be sent to the provider
+// chain and of the slash record, which are labelled by the consumer chain ID
+func (k Keeper) setPendingPacketsGauges(ctx sdk.Context) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	labels := ccv.ChainIDMetricLabels(ctx.ChainID())
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, types.MetricKeyPendingPackets},
+		float32(len(k.GetPendingPackets(ctx))),
+		labels,
+	)
+
+	waitingOnReply := float32(0)
+	if record, found := k.GetSlashRecord(ctx); found && record.WaitingOnReply {
+		waitingOnReply = 1
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, types.MetricKeySlashRecordWaitingOnReply},
+		waitingOnReply,
+		labels,
+	)
+}
+
+// incrRewardTransmissionCounters increments the counters of the reward transmissions
+// to the provider chain and of the per-denom rewards sent
+func incrRewardTransmissionCounters(ctx sdk.Context, sent sdk.Coins) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyRewardTransmissions},
+		1,
+		ccv.ChainIDMetricLabels(ctx.ChainID()),
+	)
+	for _, coin := range sent {
+		amount, err := coin.Amount.ToLegacyDec().Float64()
+		if err != nil {
+			continue
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.MetricKeyRewardsSent},
+			float32(amount),
+			ccv.DenomMetricLabels(ctx.ChainID(), coin.Denom),
+		)
+	}
+}
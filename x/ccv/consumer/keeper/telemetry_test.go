@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
+	ccv "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestConsumerTelemetry(t *testing.T) {
+	sink := testkeeper.NewInmemTelemetrySink(t)
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithChainID("consumer")
+
+	// queue a slash packet that waits on the reply of the provider
+	validator := abci.Validator{Address: sdk.ConsAddress(GenerateValidators(t)[0].Address), Power: 1}
+	consumerKeeper.QueueSlashPacket(ctx, validator, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+
+	// the CCV channel is not established, so the packets remain pending
+	consumerKeeper.SendPackets(ctx)
+
+	gauges, _ := testkeeper.GatherMetrics(t, sink)
+	labels := map[string]string{ccv.MetricLabelChainID: "consumer"}
+	require.ElementsMatch(t, []testkeeper.Metric{
+		{Name: types.ModuleName + "." + types.MetricKeyPendingPackets, Value: 1, Labels: labels},
+		{Name: types.ModuleName + "." + types.MetricKeySlashRecordWaitingOnReply, Value: 1, Labels: labels},
+	}, gauges)
+}
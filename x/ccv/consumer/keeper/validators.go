@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -38,11 +40,21 @@ func (k Keeper) ApplyCCValidatorChanges(ctx sdk.Context, changes []abci.Validato
 
 		if found {
 			// update or delete an existing validator
+			consAddr := sdk.ConsAddress(addr)
 			if change.Power < 1 {
 				k.DeleteCCValidator(ctx, addr)
+				if err := k.AfterValidatorRemoved(ctx, consAddr); err != nil {
+					panic(fmt.Errorf("failed to call AfterValidatorRemoved hook: %w", err))
+				}
 			} else {
+				oldPower := val.Power
 				val.Power = change.Power
 				k.SetCCValidator(ctx, val)
+				if oldPower != change.Power {
+					if err := k.AfterValidatorPowerChanged(ctx, consAddr, oldPower, change.Power); err != nil {
+						panic(fmt.Errorf("failed to call AfterValidatorPowerChanged hook: %w", err))
+					}
+				}
 			}
 		} else if 0 < change.Power {
 			// create a new validator
@@ -74,6 +86,9 @@ func (k Keeper) ApplyCCValidatorChanges(ctx sdk.Context, changes []abci.Validato
 
 		ret = append(ret, change)
 	}
+	if err := k.AfterValidatorSetChangesApplied(ctx, ret); err != nil {
+		panic(fmt.Errorf("failed to call AfterValidatorSetChangesApplied hook: %w", err))
+	}
 	return ret
 }
 
@@ -199,39 +214,24 @@ func (k Keeper) UnbondingTime(goCtx context.Context) (time.Duration, error) {
 
 // GetHistoricalInfo gets the historical info at a given height
 func (k Keeper) GetHistoricalInfo(goCtx context.Context, height int64) (stakingtypes.HistoricalInfo, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	store := ctx.KVStore(k.storeKey)
-	key := types.HistoricalInfoKey(height)
-
-	value := store.Get(key)
-	if value == nil {
+	hi, err := k.historicalInfo.Get(goCtx, uint64(height))
+	if errors.Is(err, collections.ErrNotFound) {
 		return stakingtypes.HistoricalInfo{}, stakingtypes.ErrNoHistoricalInfo
 	}
 
-	return stakingtypes.UnmarshalHistoricalInfo(k.cdc, value)
+	return hi, err
 }
 
 // SetHistoricalInfo sets the historical info at a given height
 func (k Keeper) SetHistoricalInfo(goCtx context.Context, height int64, hi *stakingtypes.HistoricalInfo) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	store := ctx.KVStore(k.storeKey)
-	key := types.HistoricalInfoKey(height)
-	value := k.cdc.MustMarshal(hi)
-
-	store.Set(key, value)
+	if err := k.historicalInfo.Set(goCtx, uint64(height), *hi); err != nil {
+		panic(fmt.Errorf("failed to set historical info: %w", err))
+	}
 }
 
 // DeleteHistoricalInfo deletes the historical info at a given height
 func (k Keeper) DeleteHistoricalInfo(goCtx context.Context, height int64) error {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	store := ctx.KVStore(k.storeKey)
-	key := types.HistoricalInfoKey(height)
-
-	store.Delete(key)
-	return nil
+	return k.historicalInfo.Remove(goCtx, uint64(height))
 }
 
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
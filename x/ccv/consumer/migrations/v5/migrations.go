@@ -0,0 +1,40 @@
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumertypes "github.com/allinbits/interchain-security/x/ccv/consumer/types"
+)
+
+// deprecatedPrefixes are the byte prefixes of the consumer store that are no longer written.
+var deprecatedPrefixes = []byte{
+	consumertypes.PendingDataPacketsByteKey,
+	consumertypes.LastStandaloneHeightByteKey,
+	consumertypes.DeprecatedSmallestNonOptOutPowerByteKey,
+	consumertypes.DeprecatedPacketMaturityTimeBytePrefix,
+}
+
+// MigrateToCollections prepares the consumer store for the collections based keeper.
+// The layout of the stored data does not change; the data stored under the
+// deprecated keys and prefixes is removed.
+func MigrateToCollections(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	var keys [][]byte
+	for _, prefix := range deprecatedPrefixes {
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	ctx.Logger().Info("deprecated consumer state removed", "entries", len(keys))
+	return nil
+}
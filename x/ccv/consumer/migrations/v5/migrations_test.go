@@ -0,0 +1,52 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	v5 "github.com/allinbits/interchain-security/x/ccv/consumer/migrations/v5"
+	consumertypes "github.com/allinbits/interchain-security/x/ccv/consumer/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+var deprecatedPrefixes = []byte{
+	consumertypes.PendingDataPacketsByteKey,
+	consumertypes.LastStandaloneHeightByteKey,
+	consumertypes.DeprecatedSmallestNonOptOutPowerByteKey,
+	consumertypes.DeprecatedPacketMaturityTimeBytePrefix,
+}
+
+func TestMigrateToCollections(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+	k, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	store := ctx.KVStore(inMemParams.StoreKey)
+	for _, prefix := range deprecatedPrefixes {
+		store.Set([]byte{prefix}, []byte("deprecated"))
+		store.Set([]byte{prefix, 0x01}, []byte("deprecated"))
+	}
+	k.AppendPendingPacket(ctx, ccvtypes.SlashPacket, &ccvtypes.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: ccvtypes.NewSlashPacketData(abci.Validator{}, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME),
+	})
+	k.SetHeightValsetUpdateID(ctx, 10, 2)
+
+	err := v5.MigrateToCollections(ctx, inMemParams.StoreKey)
+	require.NoError(t, err)
+
+	for _, prefix := range deprecatedPrefixes {
+		require.False(t, store.Has([]byte{prefix}))
+		require.False(t, store.Has([]byte{prefix, 0x01}))
+	}
+
+	// the state stored in collections is untouched
+	require.Len(t, k.GetPendingPackets(ctx), 1)
+	require.Equal(t, uint64(2), k.GetHeightValsetUpdateID(ctx, 10))
+	require.True(t, store.Has(consumertypes.HeightValsetUpdateIDKey(10)))
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/allinbits/interchain-security/x/ccv/consumer/client/cli"
 	"github.com/allinbits/interchain-security/x/ccv/consumer/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/consumer/simulation"
 	consumertypes "github.com/allinbits/interchain-security/x/ccv/consumer/types"
 	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
 )
@@ -114,17 +115,19 @@ func NewAppModule(k keeper.Keeper, paramSpace paramtypes.Subspace) AppModule {
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO
-}
+//
+// HistoricalInfoInvariant is not registered, since it only holds before EndBlock.
+func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	consumertypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
 	consumertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-	// No migrations needed for this fork
-	_ = keeper.NewMigrator(am.keeper, am.paramSpace)
+	migrator := keeper.NewMigrator(am.keeper, am.paramSpace)
+	if err := cfg.RegisterMigration(consumertypes.ModuleName, 4, migrator.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s", consumertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the consumer module. It returns
@@ -144,7 +147,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 {
-	return 4
+	return 5
 }
 
 // BeginBlock implements the AppModule interface
@@ -206,14 +209,14 @@ func (am AppModule) EndBlock(goCtx context.Context) ([]abci.ValidatorUpdate, err
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the transfer module.
-// TODO
+// GenerateGenesisState creates a randomized GenState of the consumer module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
 // RegisterStoreDecoder registers a decoder for consumer module's types
-// TODO
 func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[consumertypes.StoreKey] = simulation.NewDecodeStore()
 }
 
 // WeightedOperations returns the all the consumer module operations with their respective weights.
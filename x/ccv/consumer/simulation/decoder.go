@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding consumer type. The values of the key-only indexes
+// and of the deprecated prefixes are printed as raw bytes.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case types.ParametersByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &ccvtypes.ConsumerParams{}, &ccvtypes.ConsumerParams{})
+
+		case types.PortByteKey,
+			types.ProviderClientByteKey,
+			types.ProviderChannelByteKey,
+			types.StandaloneTransferChannelIDByteKey:
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case types.PreCCVByteKey,
+			types.InitGenesisHeightByteKey,
+			types.PendingPacketsIndexByteKey,
+			types.HeightValsetUpdateIDBytePrefix:
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case types.LastDistributionTransmissionByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &types.LastTransmissionBlockHeight{}, &types.LastTransmissionBlockHeight{})
+
+		case types.PendingChangesByteKey,
+			types.PartialChangesByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &ccvtypes.ValidatorSetChangePacketData{}, &ccvtypes.ValidatorSetChangePacketData{})
+
+		case types.InitialValSetByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &types.GenesisState{}, &types.GenesisState{})
+
+		case types.HistoricalInfoBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &stakingtypes.HistoricalInfo{}, &stakingtypes.HistoricalInfo{})
+
+		case types.PendingDataPacketsBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &ccvtypes.ConsumerPacketData{}, &ccvtypes.ConsumerPacketData{})
+
+		case types.CrossChainValidatorBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.CrossChainValidator{}, &types.CrossChainValidator{})
+
+		case types.SlashRecordByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &types.SlashRecord{}, &types.SlashRecord{})
+
+		default:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
+}
+
+// decodeProto unmarshals bzA into a and bzB into b, and prints them
+func decodeProto(bzA, bzB []byte, a, b proto.Message) string {
+	mustUnmarshal(proto.Unmarshal(bzA, a))
+	mustUnmarshal(proto.Unmarshal(bzB, b))
+	return fmt.Sprintf("%v\n%v", a, b)
+}
+
+func mustUnmarshal(err error) {
+	if err != nil {
+		panic(fmt.Errorf("failed to unmarshal consumer store value: %w", err))
+	}
+}
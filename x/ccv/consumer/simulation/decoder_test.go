@@ -0,0 +1,60 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/allinbits/interchain-security/x/ccv/consumer/simulation"
+	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestDecodeConsumerStore(t *testing.T) {
+	dec := simulation.NewDecodeStore()
+
+	params := ccvtypes.DefaultParams()
+	pendingChanges := ccvtypes.NewValidatorSetChangePacketData(nil, 3, nil)
+	slashRecord := types.SlashRecord{WaitingOnReply: true}
+
+	mustMarshal := func(msg proto.Message) []byte {
+		bz, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		return bz
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParametersKey(), Value: mustMarshal(&params)},
+			{Key: types.ProviderChannelKey(), Value: []byte("channel-0")},
+			{Key: types.HeightValsetUpdateIDKey(10), Value: sdk.Uint64ToBigEndian(5)},
+			{Key: types.PendingChangesKey(), Value: mustMarshal(&pendingChanges)},
+			{Key: types.SlashRecordKey(), Value: mustMarshal(&slashRecord)},
+			{Key: []byte{0xff}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", &params, &params)},
+		{"ProviderChannel", "channel-0\nchannel-0"},
+		{"HeightValsetUpdateID", "5\n5"},
+		{"PendingChanges", fmt.Sprintf("%v\n%v", &pendingChanges, &pendingChanges)},
+		{"SlashRecord", fmt.Sprintf("%v\n%v", &slashRecord, &slashRecord)},
+		{"other", "99\n99"},
+	}
+	require.Len(t, tests, len(kvPairs.Pairs))
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/allinbits/interchain-security/x/ccv/consumer/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// Simulation parameter constants
+const (
+	BlocksPerDistributionTransmission = "blocks_per_distribution_transmission"
+	CcvTimeoutPeriod                  = "ccv_timeout_period"
+	TransferTimeoutPeriod             = "transfer_timeout_period"
+	ConsumerRedistributionFraction    = "consumer_redistribution_fraction"
+	HistoricalEntries                 = "historical_entries"
+	UnbondingPeriod                   = "unbonding_period"
+	RetryDelayPeriod                  = "retry_delay_period"
+)
+
+// GenBlocksPerDistributionTransmission returns a randomized BlocksPerDistributionTransmission
+func GenBlocksPerDistributionTransmission(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 1001))
+}
+
+// GenCcvTimeoutPeriod returns a randomized CcvTimeoutPeriod between one and four weeks
+func GenCcvTimeoutPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 7, 29)) * 24 * time.Hour
+}
+
+// GenTransferTimeoutPeriod returns a randomized TransferTimeoutPeriod between one hour and one day
+func GenTransferTimeoutPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 25)) * time.Hour
+}
+
+// GenConsumerRedistributionFraction returns a randomized ConsumerRedistributionFraction
+func GenConsumerRedistributionFraction(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2).String()
+}
+
+// GenHistoricalEntries returns a randomized HistoricalEntries
+func GenHistoricalEntries(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 10001))
+}
+
+// GenUnbondingPeriod returns a randomized UnbondingPeriod between one and three weeks
+func GenUnbondingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 7, 22)) * 24 * time.Hour
+}
+
+// GenRetryDelayPeriod returns a randomized RetryDelayPeriod between one minute and two hours
+func GenRetryDelayPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 121)) * time.Minute
+}
+
+// RandomizedGenState generates a random GenesisState for the consumer module.
+// Note that the consumer module is disabled in the generated genesis state, as
+// the consumer chain cannot be simulated without its provider chain.
+func RandomizedGenState(simState *module.SimulationState) {
+	var blocksPerDistributionTransmission int64
+	simState.AppParams.GetOrGenerate(BlocksPerDistributionTransmission, &blocksPerDistributionTransmission, simState.Rand,
+		func(r *rand.Rand) { blocksPerDistributionTransmission = GenBlocksPerDistributionTransmission(r) })
+
+	var ccvTimeoutPeriod time.Duration
+	simState.AppParams.GetOrGenerate(CcvTimeoutPeriod, &ccvTimeoutPeriod, simState.Rand,
+		func(r *rand.Rand) { ccvTimeoutPeriod = GenCcvTimeoutPeriod(r) })
+
+	var transferTimeoutPeriod time.Duration
+	simState.AppParams.GetOrGenerate(TransferTimeoutPeriod, &transferTimeoutPeriod, simState.Rand,
+		func(r *rand.Rand) { transferTimeoutPeriod = GenTransferTimeoutPeriod(r) })
+
+	var consumerRedistributionFraction string
+	simState.AppParams.GetOrGenerate(ConsumerRedistributionFraction, &consumerRedistributionFraction, simState.Rand,
+		func(r *rand.Rand) { consumerRedistributionFraction = GenConsumerRedistributionFraction(r) })
+
+	var historicalEntries int64
+	simState.AppParams.GetOrGenerate(HistoricalEntries, &historicalEntries, simState.Rand,
+		func(r *rand.Rand) { historicalEntries = GenHistoricalEntries(r) })
+
+	var unbondingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(UnbondingPeriod, &unbondingPeriod, simState.Rand,
+		func(r *rand.Rand) { unbondingPeriod = GenUnbondingPeriod(r) })
+
+	var retryDelayPeriod time.Duration
+	simState.AppParams.GetOrGenerate(RetryDelayPeriod, &retryDelayPeriod, simState.Rand,
+		func(r *rand.Rand) { retryDelayPeriod = GenRetryDelayPeriod(r) })
+
+	params := ccvtypes.DefaultParams()
+	params.BlocksPerDistributionTransmission = blocksPerDistributionTransmission
+	params.CcvTimeoutPeriod = ccvTimeoutPeriod
+	params.TransferTimeoutPeriod = transferTimeoutPeriod
+	params.ConsumerRedistributionFraction = consumerRedistributionFraction
+	params.HistoricalEntries = historicalEntries
+	params.UnbondingPeriod = unbondingPeriod
+	params.RetryDelayPeriod = retryDelayPeriod
+
+	consumerGenesis := types.DefaultGenesisState()
+	consumerGenesis.Params = params
+
+	bz, err := json.MarshalIndent(&consumerGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated consumer parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(consumerGenesis)
+}
@@ -0,0 +1,14 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// Collection prefixes of the consumer store. They reuse the byte prefixes
+// defined above so that the data written before the module moved to
+// collections is read without any change of layout.
+var (
+	HistoricalInfoPrefix       = collections.NewPrefix(int(HistoricalInfoBytePrefix))
+	HeightValsetUpdateIDPrefix = collections.NewPrefix(int(HeightValsetUpdateIDBytePrefix))
+	OutstandingDowntimePrefix  = collections.NewPrefix(int(OutstandingDowntimeBytePrefix))
+	PendingDataPacketsPrefix   = collections.NewPrefix(int(PendingDataPacketsBytePrefix))
+	CrossChainValidatorPrefix  = collections.NewPrefix(int(CrossChainValidatorBytePrefix))
+)
@@ -0,0 +1,1717 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: interchain_security/ccv/consumer/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventCCVChannelEstablished is emitted once the first VSC packet is received
+// on the CCV channel to the provider chain.
+type EventCCVChannelEstablished struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *EventCCVChannelEstablished) Reset()         { *m = EventCCVChannelEstablished{} }
+func (m *EventCCVChannelEstablished) String() string { return proto.CompactTextString(m) }
+func (*EventCCVChannelEstablished) ProtoMessage()    {}
+func (*EventCCVChannelEstablished) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3441649e6dbedc2f, []int{0}
+}
+func (m *EventCCVChannelEstablished) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCVChannelEstablished) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCVChannelEstablished.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCVChannelEstablished) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCVChannelEstablished.Merge(m, src)
+}
+func (m *EventCCVChannelEstablished) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCVChannelEstablished) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCVChannelEstablished.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCVChannelEstablished proto.InternalMessageInfo
+
+func (m *EventCCVChannelEstablished) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *EventCCVChannelEstablished) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// EventVSCPacketReceived is emitted once the validator set changes of a VSC packet
+// are received and queued to be applied. The parts of a split VSC packet emit a
+// single event once the last part is received.
+type EventVSCPacketReceived struct {
+	VscId        uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	NumUpdates   uint64 `protobuf:"varint,2,opt,name=num_updates,json=numUpdates,proto3" json:"num_updates,omitempty"`
+	NumSlashAcks uint64 `protobuf:"varint,3,opt,name=num_slash_acks,json=numSlashAcks,proto3" json:"num_slash_acks,omitempty"`
+}
+
+func (m *EventVSCPacketReceived) Reset()         { *m = EventVSCPacketReceived{} }
+func (m *EventVSCPacketReceived) String() string { return proto.CompactTextString(m) }
+func (*EventVSCPacketReceived) ProtoMessage()    {}
+func (*EventVSCPacketReceived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3441649e6dbedc2f, []int{1}
+}
+func (m *EventVSCPacketReceived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVSCPacketReceived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVSCPacketReceived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVSCPacketReceived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVSCPacketReceived.Merge(m, src)
+}
+func (m *EventVSCPacketReceived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVSCPacketReceived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVSCPacketReceived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVSCPacketReceived proto.InternalMessageInfo
+
+func (m *EventVSCPacketReceived) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
+}
+
+func (m *EventVSCPacketReceived) GetNumUpdates() uint64 {
+	if m != nil {
+		return m.NumUpdates
+	}
+	return 0
+}
+
+func (m *EventVSCPacketReceived) GetNumSlashAcks() uint64 {
+	if m != nil {
+		return m.NumSlashAcks
+	}
+	return 0
+}
+
+// EventSlashPacketQueued is emitted once a slash packet is queued to be sent to the provider chain.
+type EventSlashPacketQueued struct {
+	ValidatorConsAddr string           `protobuf:"bytes,1,opt,name=validator_cons_addr,json=validatorConsAddr,proto3" json:"validator_cons_addr,omitempty"`
+	VscId             uint64           `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	Infraction        types.Infraction `protobuf:"varint,3,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+}
+
+func (m *EventSlashPacketQueued) Reset()         { *m = EventSlashPacketQueued{} }
+func (m *EventSlashPacketQueued) String() string { return proto.CompactTextString(m) }
+func (*EventSlashPacketQueued) ProtoMessage()    {}
+func (*EventSlashPacketQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3441649e6dbedc2f, []int{2}
+}
+func (m *EventSlashPacketQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashPacketQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashPacketQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSlashPacketQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashPacketQueued.Merge(m, src)
+}
+func (m *EventSlashPacketQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashPacketQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashPacketQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSlashPacketQueued proto.InternalMessageInfo
+
+func (m *EventSlashPacketQueued) GetValidatorConsAddr() string {
+	if m != nil {
+		return m.ValidatorConsAddr
+	}
+	return ""
+}
+
+func (m *EventSlashPacketQueued) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
+}
+
+func (m *EventSlashPacketQueued) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+// EventSlashPacketHandled is emitted once the provider chain acknowledges that it handled a slash packet.
+type EventSlashPacketHandled struct {
+	ValidatorConsAddr string           `protobuf:"bytes,1,opt,name=validator_cons_addr,json=validatorConsAddr,proto3" json:"validator_cons_addr,omitempty"`
+	VscId             uint64           `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	Infraction        types.Infraction `protobuf:"varint,3,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+}
+
+func (m *EventSlashPacketHandled) Reset()         { *m = EventSlashPacketHandled{} }
+func (m *EventSlashPacketHandled) String() string { return proto.CompactTextString(m) }
+func (*EventSlashPacketHandled) ProtoMessage()    {}
+func (*EventSlashPacketHandled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3441649e6dbedc2f, []int{3}
+}
+func (m *EventSlashPacketHandled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashPacketHandled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashPacketHandled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSlashPacketHandled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashPacketHandled.Merge(m, src)
+}
+func (m *EventSlashPacketHandled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashPacketHandled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashPacketHandled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSlashPacketHandled proto.InternalMessageInfo
+
+func (m *EventSlashPacketHandled) GetValidatorConsAddr() string {
+	if m != nil {
+		return m.ValidatorConsAddr
+	}
+	return ""
+}
+
+func (m *EventSlashPacketHandled) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
+}
+
+func (m *EventSlashPacketHandled) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+// EventSlashPacketBounced is emitted once the provider chain bounces a slash packet,
+// which is retried later.
+type EventSlashPacketBounced struct {
+	ValidatorConsAddr string           `protobuf:"bytes,1,opt,name=validator_cons_addr,json=validatorConsAddr,proto3" json:"validator_cons_addr,omitempty"`
+	VscId             uint64           `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	Infraction        types.Infraction `protobuf:"varint,3,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+}
+
+func (m *EventSlashPacketBounced) Reset()         { *m = EventSlashPacketBounced{} }
+func (m *EventSlashPacketBounced) String() string { return proto.CompactTextString(m) }
+func (*EventSlashPacketBounced) ProtoMessage()    {}
+func (*EventSlashPacketBounced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3441649e6dbedc2f, []int{4}
+}
+func (m *EventSlashPacketBounced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSlashPacketBounced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSlashPacketBounced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSlashPacketBounced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSlashPacketBounced.Merge(m, src)
+}
+func (m *EventSlashPacketBounced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSlashPacketBounced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSlashPacketBounced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSlashPacketBounced proto.InternalMessageInfo
+
+func (m *EventSlashPacketBounced) GetValidatorConsAddr() string {
+	if m != nil {
+		return m.ValidatorConsAddr
+	}
+	return ""
+}
+
+func (m *EventSlashPacketBounced) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
+}
+
+func (m *EventSlashPacketBounced) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+// EventRewardsSentToProvider is emitted once the consumer rewards are sent to the provider chain.
+type EventRewardsSentToProvider struct {
+	Height                 int64                                    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	NextDistributionHeight int64                                    `protobuf:"varint,2,opt,name=next_distribution_height,json=nextDistributionHeight,proto3" json:"next_distribution_height,omitempty"`
+	RedistributionFraction string                                   `protobuf:"bytes,3,opt,name=redistribution_fraction,json=redistributionFraction,proto3" json:"redistribution_fraction,omitempty"`
+	Total                  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	Sent                   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=sent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sent"`
+}
+
+func (m *EventRewardsSentToProvider) Reset()         { *m = EventRewardsSentToProvider{} }
+func (m *EventRewardsSentToProvider) String() string { return proto.CompactTextString(m) }
+func (*EventRewardsSentToProvider) ProtoMessage()    {}
+func (*EventRewardsSentToProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3441649e6dbedc2f, []int{5}
+}
+func (m *EventRewardsSentToProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardsSentToProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardsSentToProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardsSentToProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardsSentToProvider.Merge(m, src)
+}
+func (m *EventRewardsSentToProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardsSentToProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardsSentToProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardsSentToProvider proto.InternalMessageInfo
+
+func (m *EventRewardsSentToProvider) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventRewardsSentToProvider) GetNextDistributionHeight() int64 {
+	if m != nil {
+		return m.NextDistributionHeight
+	}
+	return 0
+}
+
+func (m *EventRewardsSentToProvider) GetRedistributionFraction() string {
+	if m != nil {
+		return m.RedistributionFraction
+	}
+	return ""
+}
+
+func (m *EventRewardsSentToProvider) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *EventRewardsSentToProvider) GetSent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Sent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventCCVChannelEstablished)(nil), "interchain_security.ccv.consumer.v1.EventCCVChannelEstablished")
+	proto.RegisterType((*EventVSCPacketReceived)(nil), "interchain_security.ccv.consumer.v1.EventVSCPacketReceived")
+	proto.RegisterType((*EventSlashPacketQueued)(nil), "interchain_security.ccv.consumer.v1.EventSlashPacketQueued")
+	proto.RegisterType((*EventSlashPacketHandled)(nil), "interchain_security.ccv.consumer.v1.EventSlashPacketHandled")
+	proto.RegisterType((*EventSlashPacketBounced)(nil), "interchain_security.ccv.consumer.v1.EventSlashPacketBounced")
+	proto.RegisterType((*EventRewardsSentToProvider)(nil), "interchain_security.ccv.consumer.v1.EventRewardsSentToProvider")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/consumer/v1/events.proto", fileDescriptor_3441649e6dbedc2f)
+}
+
+var fileDescriptor_3441649e6dbedc2f = []byte{
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8f, 0xd3, 0x34, 0x9f, 0xba, 0xfd, 0x54, 0x09, 0x03, 0x69, 0xa8, 0x84, 0x5b, 0x85, 0x1e,
+	0x7a, 0xa9, 0xdd, 0x94, 0x03, 0x5c, 0x38, 0x34, 0xa1, 0xa8, 0xb9, 0x15, 0xb7, 0x14, 0x89, 0x8b,
+	0xb5, 0xde, 0x1d, 0xe2, 0x55, 0x9c, 0xdd, 0x68, 0x67, 0x6d, 0xda, 0xb7, 0xe0, 0x1d, 0xe0, 0xc4,
+	0x93, 0xf4, 0xd8, 0x23, 0x27, 0x40, 0xcd, 0x91, 0x97, 0x40, 0x5e, 0x3b, 0x21, 0x41, 0x1c, 0x39,
+	0xf4, 0x94, 0xec, 0xfe, 0xfe, 0xcc, 0x6f, 0x46, 0xde, 0x21, 0x07, 0x42, 0x1a, 0xd0, 0x2c, 0xa1,
+	0x42, 0x46, 0x08, 0x2c, 0xd3, 0xc2, 0x5c, 0x05, 0x8c, 0xe5, 0x01, 0x53, 0x12, 0xb3, 0x31, 0xe8,
+	0x20, 0xef, 0x06, 0x90, 0x83, 0x34, 0xe8, 0x4f, 0xb4, 0x32, 0xca, 0x7d, 0xf2, 0x17, 0x85, 0xcf,
+	0x58, 0xee, 0xcf, 0x14, 0x7e, 0xde, 0xdd, 0x7a, 0x30, 0x54, 0x43, 0x65, 0xf9, 0x41, 0xf1, 0xaf,
+	0x94, 0x6e, 0x79, 0x4c, 0xe1, 0x58, 0x61, 0x10, 0x53, 0x84, 0x20, 0xef, 0xc6, 0x60, 0x68, 0x37,
+	0x60, 0x4a, 0xc8, 0x0a, 0xdf, 0xad, 0x70, 0x34, 0x74, 0x24, 0xe4, 0x70, 0x4e, 0xa9, 0xce, 0x25,
+	0xab, 0x73, 0x4e, 0xb6, 0x8e, 0x8b, 0x40, 0xfd, 0xfe, 0x45, 0x3f, 0xa1, 0x52, 0x42, 0x7a, 0x8c,
+	0x86, 0xc6, 0xa9, 0xc0, 0x04, 0xb8, 0xbb, 0x49, 0xfe, 0x9b, 0x28, 0x6d, 0x22, 0xc1, 0xdb, 0xce,
+	0x8e, 0xb3, 0xb7, 0x16, 0x36, 0x8b, 0xe3, 0x80, 0xbb, 0x8f, 0x09, 0x61, 0x25, 0xbd, 0xc0, 0xea,
+	0x16, 0x5b, 0xab, 0x6e, 0x06, 0xbc, 0x93, 0x93, 0x96, 0x75, 0xbd, 0x38, 0xeb, 0x9f, 0x52, 0x36,
+	0x02, 0x13, 0x02, 0x03, 0x91, 0x03, 0x77, 0x1f, 0x92, 0x66, 0x8e, 0x6c, 0x66, 0xd8, 0x08, 0x57,
+	0x73, 0x64, 0x03, 0xee, 0x6e, 0x93, 0x75, 0x99, 0x8d, 0xa3, 0x6c, 0xc2, 0xa9, 0x01, 0xb4, 0x86,
+	0x8d, 0x90, 0xc8, 0x6c, 0xfc, 0xa6, 0xbc, 0x71, 0x77, 0xc9, 0x46, 0x41, 0xc0, 0x94, 0x62, 0x12,
+	0x51, 0x36, 0xc2, 0xf6, 0x8a, 0xe5, 0xfc, 0x2f, 0xb3, 0xf1, 0x59, 0x71, 0x79, 0xc4, 0x46, 0xd8,
+	0xf9, 0xe4, 0x54, 0x85, 0xed, 0x55, 0x59, 0xfa, 0x75, 0x06, 0x19, 0x70, 0xd7, 0x27, 0xf7, 0x73,
+	0x9a, 0x0a, 0x4e, 0x8d, 0xd2, 0x51, 0x31, 0xdd, 0x88, 0x72, 0xae, 0xab, 0xb6, 0xee, 0xcd, 0xa1,
+	0xbe, 0x92, 0x78, 0xc4, 0xb9, 0x5e, 0x08, 0x5a, 0x5f, 0x0c, 0xda, 0x23, 0x44, 0xc8, 0xf7, 0x9a,
+	0x32, 0x23, 0x94, 0xb4, 0x19, 0x36, 0x0e, 0x3b, 0x7e, 0x39, 0x6a, 0x7f, 0x36, 0xda, 0x6a, 0xd4,
+	0xfe, 0x60, 0xce, 0x0c, 0x17, 0x54, 0x9d, 0xcf, 0x0e, 0xd9, 0xfc, 0x33, 0xe5, 0x09, 0x95, 0x3c,
+	0xbd, 0xfb, 0x31, 0x7b, 0x2a, 0x93, 0xec, 0x6e, 0xc5, 0xfc, 0x59, 0xaf, 0x3e, 0xe1, 0x10, 0x3e,
+	0x50, 0xcd, 0xf1, 0x0c, 0xa4, 0x39, 0x57, 0xa7, 0x5a, 0xe5, 0x82, 0x83, 0x76, 0x5b, 0xa4, 0x99,
+	0x80, 0x18, 0x26, 0xc6, 0x86, 0x5b, 0x09, 0xab, 0x93, 0xfb, 0x9c, 0xb4, 0x25, 0x5c, 0x9a, 0x88,
+	0x0b, 0x34, 0x5a, 0xc4, 0x59, 0xe1, 0x15, 0x55, 0xcc, 0xba, 0x65, 0xb6, 0x0a, 0xfc, 0xe5, 0x02,
+	0x7c, 0x52, 0x2a, 0x9f, 0x91, 0x4d, 0x0d, 0x4b, 0xb2, 0xa5, 0x0e, 0xd6, 0xc2, 0xd6, 0x32, 0xfc,
+	0xaa, 0x42, 0x5d, 0x4a, 0x56, 0x8d, 0x32, 0x34, 0x6d, 0x37, 0x76, 0x56, 0xf6, 0xd6, 0x0f, 0x1f,
+	0xcd, 0x1a, 0x2d, 0x5e, 0xf0, 0xbc, 0xcb, 0xbe, 0x12, 0xb2, 0x77, 0x70, 0xfd, 0x6d, 0xbb, 0xf6,
+	0xe5, 0xfb, 0xf6, 0xde, 0x50, 0x98, 0x24, 0x8b, 0x7d, 0xa6, 0xc6, 0x41, 0xf5, 0x9c, 0xcb, 0x9f,
+	0x7d, 0xe4, 0xa3, 0xc0, 0x5c, 0x4d, 0x00, 0xad, 0x00, 0xc3, 0xd2, 0xd9, 0x8d, 0x48, 0x03, 0x41,
+	0x9a, 0xf6, 0xea, 0xbf, 0xaf, 0x60, 0x8d, 0x7b, 0x6f, 0xaf, 0x6f, 0x3d, 0xe7, 0xe6, 0xd6, 0x73,
+	0x7e, 0xdc, 0x7a, 0xce, 0xc7, 0xa9, 0x57, 0xbb, 0x99, 0x7a, 0xb5, 0xaf, 0x53, 0xaf, 0xf6, 0xee,
+	0xc5, 0x82, 0x13, 0x4d, 0x53, 0x21, 0x63, 0x61, 0x30, 0xf8, 0xbd, 0xdf, 0xf6, 0xe7, 0x1b, 0xf1,
+	0x72, 0x79, 0x27, 0xda, 0x22, 0x71, 0xd3, 0xee, 0xa3, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0x11, 0x37, 0x8a, 0xca, 0x44, 0x05, 0x00, 0x00,
+}
+
+func (m *EventCCVChannelEstablished) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCCVChannelEstablished) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCCVChannelEstablished) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVSCPacketReceived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVSCPacketReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVSCPacketReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSlashAcks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumSlashAcks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumUpdates != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NumUpdates))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VscId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashPacketQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashPacketQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashPacketQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VscId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorConsAddr) > 0 {
+		i -= len(m.ValidatorConsAddr)
+		copy(dAtA[i:], m.ValidatorConsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashPacketHandled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashPacketHandled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashPacketHandled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VscId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorConsAddr) > 0 {
+		i -= len(m.ValidatorConsAddr)
+		copy(dAtA[i:], m.ValidatorConsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashPacketBounced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashPacketBounced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashPacketBounced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VscId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorConsAddr) > 0 {
+		i -= len(m.ValidatorConsAddr)
+		copy(dAtA[i:], m.ValidatorConsAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValidatorConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardsSentToProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardsSentToProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardsSentToProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sent) > 0 {
+		for iNdEx := len(m.Sent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RedistributionFraction) > 0 {
+		i -= len(m.RedistributionFraction)
+		copy(dAtA[i:], m.RedistributionFraction)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RedistributionFraction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextDistributionHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NextDistributionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCCVChannelEstablished) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVSCPacketReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovEvents(uint64(m.VscId))
+	}
+	if m.NumUpdates != 0 {
+		n += 1 + sovEvents(uint64(m.NumUpdates))
+	}
+	if m.NumSlashAcks != 0 {
+		n += 1 + sovEvents(uint64(m.NumSlashAcks))
+	}
+	return n
+}
+
+func (m *EventSlashPacketQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorConsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VscId != 0 {
+		n += 1 + sovEvents(uint64(m.VscId))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovEvents(uint64(m.Infraction))
+	}
+	return n
+}
+
+func (m *EventSlashPacketHandled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorConsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VscId != 0 {
+		n += 1 + sovEvents(uint64(m.VscId))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovEvents(uint64(m.Infraction))
+	}
+	return n
+}
+
+func (m *EventSlashPacketBounced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorConsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.VscId != 0 {
+		n += 1 + sovEvents(uint64(m.VscId))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovEvents(uint64(m.Infraction))
+	}
+	return n
+}
+
+func (m *EventRewardsSentToProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.NextDistributionHeight != 0 {
+		n += 1 + sovEvents(uint64(m.NextDistributionHeight))
+	}
+	l = len(m.RedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Sent) > 0 {
+		for _, e := range m.Sent {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCCVChannelEstablished) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCVChannelEstablished: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCVChannelEstablished: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVSCPacketReceived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVSCPacketReceived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVSCPacketReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUpdates", wireType)
+			}
+			m.NumUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSlashAcks", wireType)
+			}
+			m.NumSlashAcks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSlashAcks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashPacketQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashPacketQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashPacketQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashPacketHandled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashPacketHandled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashPacketHandled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashPacketBounced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashPacketBounced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashPacketBounced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorConsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRewardsSentToProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRewardsSentToProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRewardsSentToProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDistributionHeight", wireType)
+			}
+			m.NextDistributionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextDistributionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types1.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sent = append(m.Sent, types1.Coin{})
+			if err := m.Sent[len(m.Sent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
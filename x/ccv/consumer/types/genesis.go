@@ -58,6 +58,27 @@ func NewInitialGenesisState(cs *ibctmtypes.ClientState, consState *ibctmtypes.Co
 	}
 }
 
+// NewRelaunchGenesisState returns the GenesisState of a stopped consumer chain that is
+// relaunched from its exported state, given the exported consumer genesis and the new
+// consumer genesis issued by the provider. The relaunched chain starts as a new chain,
+// while the consumer reward denoms and the last transmission block height are kept.
+// The initial validator set is taken from the provider consumer genesis. Since the consumer
+// key assignments are deleted when a chain is stopped, the validators that did not assign
+// their consumer keys again before the relaunch use their provider consensus keys.
+func NewRelaunchGenesisState(exported GenesisState, providerGen ccv.ConsumerGenesisState) *GenesisState {
+	params := providerGen.Params
+	params.RewardDenoms = exported.Params.RewardDenoms
+	params.ProviderRewardDenoms = exported.Params.ProviderRewardDenoms
+
+	return &GenesisState{
+		NewChain:                    true,
+		Params:                      params,
+		Provider:                    providerGen.Provider,
+		LastTransmissionBlockHeight: exported.LastTransmissionBlockHeight,
+		Relaunch:                    true,
+	}
+}
+
 // Validate performs basic genesis state validation returning an error upon any failure.
 //
 // The three cases where a consumer chain starts/restarts
@@ -65,6 +86,7 @@ func NewInitialGenesisState(cs *ibctmtypes.ClientState, consState *ibctmtypes.Co
 //
 // 1. New chain starts:
 //   - Params, InitialValset, provider client state, provider consensus state // mandatory
+//   - LastTransmissionBlockHeight // optional, only if a stopped chain is relaunched
 //
 // 2. Chain restarts with CCV handshake still in progress:
 //   - Params, InitialValset, ProviderID, HeightToValidatorSetUpdateID // mandatory
@@ -72,7 +94,7 @@ func NewInitialGenesisState(cs *ibctmtypes.ClientState, consState *ibctmtypes.Co
 //
 // 3. Chain restarts with CCV handshake completed:
 //   - Params, InitialValset, ProviderID, channelID, HeightToValidatorSetUpdateID // mandatory
-//   - MaturingVSCPackets, OutstandingDowntime, PendingConsumerPacket, LastTransmissionBlockHeight // optional
+//   - MaturingVSCPackets, OutstandingDowntime, PendingConsumerPacket, LastTransmissionBlockHeight, PartialChanges // optional
 //
 
 func (gs GenesisState) Validate() error {
@@ -85,6 +107,9 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := ccv.ValidateValidatorKeyTypes(gs.Provider.InitialValSet, gs.Params.AllowedKeyTypes); err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "initial validator set: %s", err)
+	}
 
 	if gs.NewChain {
 		if gs.Provider.ClientState == nil {
@@ -108,10 +133,16 @@ func (gs GenesisState) Validate() error {
 		if len(gs.PendingConsumerPackets.List) != 0 {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "pending consumer packets must be empty for new chain")
 		}
-		if gs.LastTransmissionBlockHeight.Height != 0 {
+		if gs.LastTransmissionBlockHeight.Height != 0 && !gs.Relaunch {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "last transmission block height must be empty for new chain")
 		}
+		if gs.PartialChanges != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "partial changes must be empty for new chain")
+		}
 	} else {
+		if gs.Relaunch {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "a relaunched consumer chain must start as a new chain")
+		}
 		// NOTE: For restart genesis, we will verify initial validator set in InitGenesis.
 		if gs.ProviderClientId == "" {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "provider client id must be set for a restarting consumer genesis state")
@@ -127,6 +158,10 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrap(
 					ccv.ErrInvalidGenesis, "last transmission block height must be zero when handshake isn't completed")
 			}
+			if gs.PartialChanges != nil {
+				return errorsmod.Wrap(
+					ccv.ErrInvalidGenesis, "partial changes must be empty when handshake isn't completed")
+			}
 			if len(gs.PendingConsumerPackets.List) != 0 {
 				for _, packet := range gs.PendingConsumerPackets.List {
 					if packet.Type == ccv.VscMaturedPacket {
@@ -138,6 +173,14 @@ func (gs GenesisState) Validate() error {
 		if gs.Provider.ClientState != nil || gs.Provider.ConsensusState != nil {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "provider client state and consensus state must be nil for a restarting genesis state")
 		}
+		if gs.PartialChanges != nil {
+			if err := gs.PartialChanges.Validate(); err != nil {
+				return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "partial changes: %s", err)
+			}
+			if !gs.PartialChanges.IsSplit() || gs.PartialChanges.IsLastPart() {
+				return errorsmod.Wrap(ccv.ErrInvalidGenesis, "partial changes must be a part of a split VSC packet other than the last one")
+			}
+		}
 	}
 	return nil
 }
@@ -53,6 +53,14 @@ type GenesisState struct {
 	Provider types.ProviderInfo `protobuf:"bytes,14,opt,name=provider,proto3" json:"provider"`
 	// The ID of the connection end on the consumer chain on top of which the CCV channel will be established
 	ConnectionId string `protobuf:"bytes,15,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// true if a stopped consumer chain is relaunched from its exported state.
+	// A relaunch genesis is a new chain genesis that keeps the exported last
+	// transmission block height.
+	Relaunch bool `protobuf:"varint,16,opt,name=relaunch,proto3" json:"relaunch,omitempty"`
+	// The validator set changes received so far from the parts of a split VSC
+	// packet, i.e., nil unless the chain is exported while a split VSC packet
+	// is only partially received.
+	PartialChanges *types.ValidatorSetChangePacketData `protobuf:"bytes,17,opt,name=partial_changes,json=partialChanges,proto3" json:"partial_changes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -165,6 +173,20 @@ func (m *GenesisState) GetConnectionId() string {
 	return ""
 }
 
+func (m *GenesisState) GetRelaunch() bool {
+	if m != nil {
+		return m.Relaunch
+	}
+	return false
+}
+
+func (m *GenesisState) GetPartialChanges() *types.ValidatorSetChangePacketData {
+	if m != nil {
+		return m.PartialChanges
+	}
+	return nil
+}
+
 // HeightValsetUpdateID represents a mapping internal to the consumer CCV module
 // which links a block height to each recv valset update id.
 type HeightToValsetUpdateID struct {
@@ -374,55 +396,58 @@ func init() {
 }
 
 var fileDescriptor_2db73a6057a27482 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6b, 0xdb, 0x48,
-	0x14, 0xb6, 0x12, 0xad, 0x23, 0x4f, 0x7e, 0xac, 0x77, 0xb2, 0x18, 0x6d, 0xcc, 0x3a, 0xc6, 0x61,
-	0xc1, 0x2c, 0xbb, 0x52, 0x9d, 0x52, 0x28, 0x94, 0x96, 0x36, 0x0e, 0x34, 0x36, 0x81, 0x06, 0xe7,
-	0x47, 0x21, 0x17, 0x31, 0x92, 0x26, 0xd2, 0x10, 0x79, 0x46, 0x68, 0xc6, 0x72, 0x43, 0xe9, 0xa5,
-	0x3d, 0xf6, 0xd2, 0x3f, 0x2b, 0xc7, 0x1c, 0x7b, 0x2a, 0x25, 0xf9, 0x47, 0x8a, 0x46, 0x23, 0x3b,
-	0x21, 0x4e, 0xf0, 0xcd, 0x4f, 0xef, 0x7b, 0xdf, 0x7b, 0xf3, 0xbd, 0x6f, 0xc6, 0xa0, 0x43, 0xa8,
-	0xc0, 0x89, 0x17, 0x22, 0x42, 0x1d, 0x8e, 0xbd, 0x51, 0x42, 0xc4, 0x85, 0xed, 0x79, 0xa9, 0xed,
-	0x31, 0xca, 0x47, 0x43, 0x9c, 0xd8, 0x69, 0xc7, 0x0e, 0x30, 0xc5, 0x9c, 0x70, 0x2b, 0x4e, 0x98,
-	0x60, 0x70, 0x6b, 0x46, 0x89, 0xe5, 0x79, 0xa9, 0x55, 0x94, 0x58, 0x69, 0x67, 0xe3, 0xc9, 0x43,
-	0xbc, 0x69, 0xc7, 0xe6, 0x21, 0x4a, 0xb0, 0xef, 0x4c, 0xe0, 0x92, 0x76, 0xc3, 0x26, 0xae, 0x67,
-	0x47, 0x24, 0x08, 0x85, 0x17, 0x11, 0x4c, 0x05, 0xb7, 0x05, 0xa6, 0x3e, 0x4e, 0x86, 0x84, 0x8a,
-	0xac, 0x6a, 0x1a, 0xa9, 0x82, 0x3f, 0x03, 0x16, 0x30, 0xf9, 0xd3, 0xce, 0x7e, 0xa9, 0xaf, 0xff,
-	0x3c, 0xd2, 0x78, 0x4c, 0x12, 0xac, 0x60, 0x9b, 0x01, 0x63, 0x41, 0x84, 0x6d, 0x19, 0xb9, 0xa3,
-	0x33, 0x5b, 0x90, 0x21, 0xe6, 0x02, 0x0d, 0x63, 0x05, 0xa8, 0xdf, 0xea, 0x8e, 0x5c, 0x8f, 0xd8,
-	0xe2, 0x22, 0xc6, 0x4a, 0x82, 0xd6, 0x97, 0x25, 0xb0, 0xf2, 0x36, 0x17, 0xe5, 0x50, 0x20, 0x81,
-	0xe1, 0x1e, 0x28, 0xc7, 0x28, 0x41, 0x43, 0x6e, 0x6a, 0x4d, 0xad, 0xbd, 0xbc, 0xfd, 0xaf, 0xf5,
-	0x90, 0x48, 0x69, 0xc7, 0xea, 0xaa, 0x83, 0x1f, 0xc8, 0x8a, 0x1d, 0xfd, 0xf2, 0xc7, 0x66, 0x69,
-	0xa0, 0xea, 0xe1, 0x7f, 0x00, 0xc6, 0x09, 0x4b, 0x89, 0x8f, 0x13, 0x27, 0x17, 0xc2, 0x21, 0xbe,
-	0xb9, 0xd0, 0xd4, 0xda, 0x95, 0x41, 0xb5, 0xc8, 0x74, 0x65, 0xa2, 0xe7, 0x43, 0x0b, 0xac, 0x4f,
-	0xd1, 0x21, 0xa2, 0x14, 0x47, 0x19, 0x7c, 0x51, 0xc2, 0xff, 0x98, 0xc0, 0xf3, 0x4c, 0xcf, 0x87,
-	0x75, 0x50, 0xa1, 0x78, 0xec, 0xc8, 0xb9, 0x4c, 0xbd, 0xa9, 0xb5, 0x8d, 0x81, 0x41, 0xf1, 0xb8,
-	0x9b, 0xc5, 0xf0, 0x13, 0xd8, 0x08, 0x71, 0xb6, 0x00, 0x47, 0x30, 0x27, 0x45, 0x11, 0xc7, 0xc2,
-	0x19, 0xc5, 0x3e, 0x12, 0x38, 0xe3, 0xac, 0x34, 0x17, 0xdb, 0xcb, 0xdb, 0x2f, 0xac, 0x39, 0xb6,
-	0x6f, 0xed, 0x49, 0x9a, 0x23, 0x76, 0x22, 0x49, 0x8e, 0x25, 0x47, 0x6f, 0x57, 0x9d, 0xb4, 0x16,
-	0xce, 0xca, 0xfa, 0xf0, 0xb3, 0x06, 0xfe, 0x66, 0x23, 0xc1, 0x05, 0xa2, 0x3e, 0xa1, 0x81, 0xe3,
-	0xb3, 0x31, 0xcd, 0xb6, 0xe2, 0xf0, 0x08, 0xf1, 0x90, 0xd0, 0xc0, 0x04, 0x72, 0x84, 0xe7, 0x73,
-	0x8d, 0xf0, 0x6e, 0xca, 0xb4, 0xab, 0x88, 0x54, 0xff, 0x3a, 0xbb, 0x9f, 0x3a, 0x54, 0x2d, 0xe0,
-	0x47, 0x60, 0xc6, 0x38, 0xef, 0x5f, 0xb0, 0x39, 0x31, 0xf2, 0xce, 0xb1, 0xe0, 0xe6, 0xb2, 0x5c,
-	0xed, 0x7c, 0x0a, 0x4c, 0x77, 0x9c, 0xd5, 0xee, 0x22, 0x81, 0xf6, 0x09, 0x17, 0x85, 0x02, 0xaa,
-	0xc5, 0x5d, 0x10, 0x87, 0x5f, 0x35, 0xd0, 0x88, 0x10, 0x17, 0x8e, 0x48, 0x10, 0xe5, 0x43, 0xc2,
-	0x39, 0x61, 0xd4, 0x71, 0x23, 0xe6, 0x9d, 0x3b, 0xb9, 0x68, 0xe6, 0x8a, 0x9c, 0xe1, 0xf5, 0x5c,
-	0x33, 0xec, 0x23, 0x2e, 0x8e, 0x6e, 0x31, 0xed, 0x64, 0x44, 0xf9, 0x6a, 0x0a, 0x29, 0xa2, 0x87,
-	0x21, 0xb0, 0x06, 0xca, 0x71, 0x82, 0xbb, 0xdd, 0x13, 0x73, 0x55, 0x1a, 0x45, 0x45, 0xb0, 0x0f,
-	0x8c, 0xc2, 0x58, 0xe6, 0x9a, 0x1c, 0xa7, 0xfd, 0x98, 0xdb, 0x0f, 0x14, 0xb6, 0x47, 0xcf, 0x98,
-	0x6a, 0x3b, 0xa9, 0x87, 0x5b, 0x60, 0xd5, 0x63, 0x94, 0x62, 0x4f, 0x64, 0x27, 0x25, 0xbe, 0xf9,
-	0xbb, 0x74, 0xee, 0xca, 0xf4, 0x63, 0xcf, 0xef, 0xeb, 0xc6, 0x6f, 0xd5, 0x72, 0x5f, 0x37, 0xca,
-	0xd5, 0xa5, 0xbe, 0x6e, 0x2c, 0x55, 0x8d, 0xbe, 0x6e, 0x18, 0xd5, 0x4a, 0xeb, 0x14, 0xd4, 0x66,
-	0x1b, 0x2d, 0x1b, 0x5d, 0xe9, 0x95, 0x5d, 0x47, 0x7d, 0xa0, 0x22, 0xd8, 0x06, 0xd5, 0x7b, 0xbe,
-	0x5e, 0x90, 0x88, 0xb5, 0xf4, 0x8e, 0x19, 0x5b, 0xc7, 0x60, 0x7d, 0x86, 0x83, 0xe0, 0x2b, 0x50,
-	0x4f, 0x51, 0x44, 0x7c, 0x24, 0x58, 0x22, 0x0d, 0x82, 0x29, 0x1f, 0x71, 0x07, 0xf9, 0x7e, 0x82,
-	0x79, 0x7e, 0xf9, 0x2b, 0x83, 0xbf, 0x26, 0x90, 0x6e, 0x81, 0x78, 0x93, 0x03, 0x5a, 0xcf, 0x40,
-	0x7d, 0xff, 0x71, 0xc9, 0x6f, 0xcd, 0xbd, 0x58, 0xcc, 0xdd, 0x72, 0x41, 0x6d, 0xb6, 0xa1, 0xe0,
-	0x1e, 0xd0, 0x23, 0xc2, 0x33, 0x7c, 0x76, 0x35, 0xac, 0xf9, 0x9e, 0x9d, 0x82, 0x41, 0xad, 0x43,
-	0x32, 0xec, 0xbc, 0xbf, 0xbc, 0x6e, 0x68, 0x57, 0xd7, 0x0d, 0xed, 0xe7, 0x75, 0x43, 0xfb, 0x76,
-	0xd3, 0x28, 0x5d, 0xdd, 0x34, 0x4a, 0xdf, 0x6f, 0x1a, 0xa5, 0xd3, 0x97, 0x01, 0x11, 0xe1, 0xc8,
-	0xb5, 0x3c, 0x36, 0xb4, 0x51, 0x14, 0x11, 0xea, 0x12, 0xc1, 0xed, 0x69, 0xa7, 0xff, 0x27, 0xef,
-	0xec, 0x87, 0xbb, 0x7f, 0x1d, 0xf2, 0xc9, 0x74, 0xcb, 0xf2, 0xcd, 0x7c, 0xfa, 0x2b, 0x00, 0x00,
-	0xff, 0xff, 0xd8, 0xd0, 0x89, 0x48, 0x6b, 0x06, 0x00, 0x00,
+	// 812 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x41, 0x8b, 0xdb, 0x46,
+	0x14, 0xb6, 0xb2, 0xaa, 0x23, 0xcf, 0x6e, 0x36, 0xce, 0xa4, 0x18, 0xd5, 0xa6, 0x8e, 0x71, 0x28,
+	0x98, 0xd2, 0x4a, 0x75, 0x4a, 0x21, 0x50, 0x5a, 0xda, 0xf5, 0x42, 0xd7, 0x66, 0xa1, 0xc1, 0x9b,
+	0x6c, 0x21, 0x17, 0x31, 0x1a, 0x4d, 0xa4, 0x21, 0xf2, 0x8c, 0x98, 0x19, 0xc9, 0x0d, 0xa5, 0x97,
+	0x5c, 0x7b, 0xe9, 0xcf, 0xda, 0xe3, 0x1e, 0x7b, 0x2a, 0x65, 0xf7, 0x8f, 0x14, 0x8d, 0x46, 0xf6,
+	0x2e, 0xeb, 0x35, 0xbe, 0xf9, 0xe9, 0x7d, 0xef, 0x7b, 0x9f, 0xde, 0xfb, 0xf4, 0x0c, 0xc6, 0x94,
+	0x29, 0x22, 0x70, 0x82, 0x28, 0x0b, 0x24, 0xc1, 0xb9, 0xa0, 0xea, 0x83, 0x8f, 0x71, 0xe1, 0x63,
+	0xce, 0x64, 0xbe, 0x20, 0xc2, 0x2f, 0xc6, 0x7e, 0x4c, 0x18, 0x91, 0x54, 0x7a, 0x99, 0xe0, 0x8a,
+	0xc3, 0xe7, 0x1b, 0x4a, 0x3c, 0x8c, 0x0b, 0xaf, 0x2e, 0xf1, 0x8a, 0x71, 0xf7, 0x9b, 0xfb, 0x78,
+	0x8b, 0xb1, 0x2f, 0x13, 0x24, 0x48, 0x14, 0xac, 0xe0, 0x9a, 0xb6, 0xeb, 0xd3, 0x10, 0xfb, 0x29,
+	0x8d, 0x13, 0x85, 0x53, 0x4a, 0x98, 0x92, 0xbe, 0x22, 0x2c, 0x22, 0x62, 0x41, 0x99, 0x2a, 0xab,
+	0xd6, 0x91, 0x29, 0xf8, 0x34, 0xe6, 0x31, 0xd7, 0x3f, 0xfd, 0xf2, 0x97, 0x79, 0xfa, 0xc5, 0x96,
+	0xc6, 0x4b, 0x2a, 0x88, 0x81, 0x3d, 0x8b, 0x39, 0x8f, 0x53, 0xe2, 0xeb, 0x28, 0xcc, 0xdf, 0xf9,
+	0x8a, 0x2e, 0x88, 0x54, 0x68, 0x91, 0x19, 0x40, 0xef, 0x46, 0x77, 0x14, 0x62, 0xea, 0xab, 0x0f,
+	0x19, 0x31, 0x23, 0x18, 0x7e, 0x74, 0xc0, 0xc1, 0x2f, 0xd5, 0x50, 0xce, 0x14, 0x52, 0x04, 0x9e,
+	0x80, 0x66, 0x86, 0x04, 0x5a, 0x48, 0xd7, 0x1a, 0x58, 0xa3, 0xfd, 0x17, 0x5f, 0x7a, 0xf7, 0x0d,
+	0xa9, 0x18, 0x7b, 0x13, 0xf3, 0xe2, 0xaf, 0x74, 0xc5, 0x91, 0x7d, 0xf1, 0xef, 0xb3, 0xc6, 0xdc,
+	0xd4, 0xc3, 0xaf, 0x00, 0xcc, 0x04, 0x2f, 0x68, 0x44, 0x44, 0x50, 0x0d, 0x22, 0xa0, 0x91, 0xfb,
+	0x60, 0x60, 0x8d, 0x5a, 0xf3, 0x76, 0x9d, 0x99, 0xe8, 0xc4, 0x34, 0x82, 0x1e, 0x78, 0xba, 0x46,
+	0x27, 0x88, 0x31, 0x92, 0x96, 0xf0, 0x3d, 0x0d, 0x7f, 0xb2, 0x82, 0x57, 0x99, 0x69, 0x04, 0x7b,
+	0xa0, 0xc5, 0xc8, 0x32, 0xd0, 0xba, 0x5c, 0x7b, 0x60, 0x8d, 0x9c, 0xb9, 0xc3, 0xc8, 0x72, 0x52,
+	0xc6, 0xf0, 0x4f, 0xd0, 0x4d, 0x48, 0xb9, 0x80, 0x40, 0xf1, 0xa0, 0x40, 0xa9, 0x24, 0x2a, 0xc8,
+	0xb3, 0x08, 0x29, 0x52, 0x72, 0xb6, 0x06, 0x7b, 0xa3, 0xfd, 0x17, 0xdf, 0x7b, 0x3b, 0x6c, 0xdf,
+	0x3b, 0xd1, 0x34, 0xaf, 0xf9, 0xb9, 0x26, 0x79, 0xa3, 0x39, 0xa6, 0xc7, 0xe6, 0x4d, 0x3b, 0xc9,
+	0xa6, 0x6c, 0x04, 0x3f, 0x5a, 0xe0, 0x73, 0x9e, 0x2b, 0xa9, 0x10, 0x8b, 0x28, 0x8b, 0x83, 0x88,
+	0x2f, 0x59, 0xb9, 0x95, 0x40, 0xa6, 0x48, 0x26, 0x94, 0xc5, 0x2e, 0xd0, 0x12, 0x5e, 0xee, 0x24,
+	0xe1, 0xd7, 0x35, 0xd3, 0xb1, 0x21, 0x32, 0xfd, 0x7b, 0xfc, 0x6e, 0xea, 0xcc, 0xb4, 0x80, 0x7f,
+	0x00, 0x37, 0x23, 0x55, 0xff, 0x9a, 0x2d, 0xc8, 0x10, 0x7e, 0x4f, 0x94, 0x74, 0xf7, 0xf5, 0x6a,
+	0x77, 0x9b, 0xc0, 0x7a, 0xc7, 0x65, 0xed, 0x31, 0x52, 0xe8, 0x94, 0x4a, 0x55, 0x4f, 0xc0, 0xb4,
+	0xb8, 0x0d, 0x92, 0xf0, 0x2f, 0x0b, 0xf4, 0x53, 0x24, 0x55, 0xa0, 0x04, 0x62, 0x72, 0x41, 0xa5,
+	0xa4, 0x9c, 0x05, 0x61, 0xca, 0xf1, 0xfb, 0xa0, 0x1a, 0x9a, 0x7b, 0xa0, 0x35, 0xfc, 0xb4, 0x93,
+	0x86, 0x53, 0x24, 0xd5, 0xeb, 0x1b, 0x4c, 0x47, 0x25, 0x51, 0xb5, 0x9a, 0x7a, 0x14, 0xe9, 0xfd,
+	0x10, 0xd8, 0x01, 0xcd, 0x4c, 0x90, 0xc9, 0xe4, 0xdc, 0x7d, 0xa4, 0x8d, 0x62, 0x22, 0x38, 0x03,
+	0x4e, 0x6d, 0x2c, 0xf7, 0x50, 0xcb, 0x19, 0x6d, 0x73, 0xfb, 0x2b, 0x83, 0x9d, 0xb2, 0x77, 0xdc,
+	0xb4, 0x5d, 0xd5, 0xc3, 0xe7, 0xe0, 0x11, 0xe6, 0x8c, 0x11, 0xac, 0xca, 0x37, 0xa5, 0x91, 0xfb,
+	0x58, 0x3b, 0xf7, 0x60, 0xfd, 0x70, 0x1a, 0xc1, 0x2e, 0x70, 0x04, 0x49, 0x51, 0xce, 0x70, 0xe2,
+	0xb6, 0x2b, 0xcf, 0xd6, 0x31, 0x44, 0xe0, 0x71, 0x86, 0x84, 0xa2, 0x28, 0xd5, 0xfe, 0x8f, 0x89,
+	0x74, 0x9f, 0x68, 0x4d, 0x2f, 0xb7, 0x69, 0x3a, 0x47, 0x29, 0x8d, 0x90, 0xe2, 0xe2, 0x8c, 0xa8,
+	0x89, 0x2e, 0x5b, 0xef, 0x69, 0x7e, 0x68, 0x08, 0xab, 0x84, 0x9c, 0xd9, 0xce, 0x27, 0xed, 0xe6,
+	0xcc, 0x76, 0x9a, 0xed, 0x87, 0x33, 0xdb, 0x79, 0xd8, 0x76, 0x66, 0xb6, 0xe3, 0xb4, 0x5b, 0xc3,
+	0xb7, 0xa0, 0xb3, 0xd9, 0xe7, 0xe5, 0xe4, 0xcc, 0xba, 0xca, 0x6b, 0x60, 0xcf, 0x4d, 0x04, 0x47,
+	0xa0, 0x7d, 0xe7, 0xb3, 0x7a, 0xa0, 0x11, 0x87, 0xc5, 0xad, 0x6f, 0x61, 0xf8, 0x06, 0x3c, 0xdd,
+	0x60, 0x60, 0xf8, 0x23, 0xe8, 0x15, 0xb5, 0x74, 0xed, 0x4f, 0xc2, 0x64, 0x2e, 0x03, 0x14, 0x45,
+	0x82, 0xc8, 0xea, 0xf6, 0xb4, 0xe6, 0x9f, 0xad, 0x20, 0x93, 0x1a, 0xf1, 0x73, 0x05, 0x18, 0x7e,
+	0x07, 0x7a, 0xa7, 0xdb, 0x37, 0x7e, 0x43, 0xf7, 0x5e, 0xad, 0x7b, 0x18, 0x82, 0xce, 0x66, 0x3f,
+	0xc3, 0x13, 0x60, 0xa7, 0x54, 0x96, 0xf8, 0xf2, 0xcb, 0xf4, 0x76, 0xbb, 0x7a, 0x35, 0x83, 0x71,
+	0x83, 0x66, 0x38, 0xfa, 0xed, 0xe2, 0xaa, 0x6f, 0x5d, 0x5e, 0xf5, 0xad, 0xff, 0xae, 0xfa, 0xd6,
+	0xdf, 0xd7, 0xfd, 0xc6, 0xe5, 0x75, 0xbf, 0xf1, 0xcf, 0x75, 0xbf, 0xf1, 0xf6, 0x87, 0x98, 0xaa,
+	0x24, 0x0f, 0x3d, 0xcc, 0x17, 0x3e, 0x4a, 0x53, 0xca, 0x42, 0xaa, 0xa4, 0xbf, 0xee, 0xf4, 0xf5,
+	0xea, 0xcc, 0xff, 0x7e, 0xfb, 0x9f, 0x4b, 0x5f, 0xec, 0xb0, 0xa9, 0x4f, 0xf6, 0xb7, 0xff, 0x07,
+	0x00, 0x00, 0xff, 0xff, 0x30, 0x54, 0x99, 0x94, 0xea, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -445,6 +470,32 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PartialChanges != nil {
+		{
+			size, err := m.PartialChanges.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Relaunch {
+		i--
+		if m.Relaunch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -740,6 +791,13 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Relaunch {
+		n += 3
+	}
+	if m.PartialChanges != nil {
+		l = m.PartialChanges.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1169,6 +1227,62 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relaunch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Relaunch = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartialChanges == nil {
+				m.PartialChanges = &types.ValidatorSetChangePacketData{}
+			}
+			if err := m.PartialChanges.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,9 @@ func TestValidateInitialGenesisState(t *testing.T) {
 
 	params := ccv.DefaultParams()
 	params.Enabled = true
+	// params of a consumer chain that only allows secp256k1 consensus keys
+	secp256k1Params := params
+	secp256k1Params.AllowedKeyTypes = []string{"secp256k1"}
 
 	cases := []struct {
 		name     string
@@ -142,6 +145,27 @@ func TestValidateInitialGenesisState(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid relaunch consumer genesis state: non-empty last transmission packet",
+			types.NewRelaunchGenesisState(
+				types.GenesisState{Params: params, LastTransmissionBlockHeight: types.LastTransmissionBlockHeight{Height: 1}},
+				*ccv.NewInitialConsumerGenesisState(cs, consensusState, valUpdates, params),
+			),
+			false,
+		},
+		{
+			"invalid relaunch consumer genesis state: restarting chain",
+			&types.GenesisState{
+				Params:           params,
+				ProviderClientId: "ccvclient",
+				NewChain:         false,
+				Provider: ccv.ProviderInfo{
+					InitialValSet: valUpdates,
+				},
+				Relaunch: true,
+			},
+			true,
+		},
 		{
 			"invalid new consumer genesis state: non-empty pending consumer packets",
 			&types.GenesisState{
@@ -229,6 +253,11 @@ func TestValidateInitialGenesisState(t *testing.T) {
 				)),
 			true,
 		},
+		{
+			"invalid new consumer genesis state: initial validator key type not allowed",
+			types.NewInitialGenesisState(cs, consensusState, valUpdates, secp256k1Params),
+			true,
+		},
 	}
 
 	for _, c := range cases {
@@ -342,6 +371,36 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 				valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{Height: int64(1)}, params),
 			true,
 		},
+		{
+			"valid restart consumer genesis state: split VSC packet partially received",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{}, params)
+				gs.PartialChanges = &ccv.ValidatorSetChangePacketData{ValidatorUpdates: valUpdates, ValsetUpdateId: 2, PartIndex: 0, TotalParts: 2}
+				return gs
+			}(),
+			false,
+		},
+		{
+			"invalid restart consumer genesis state: partial changes of the last part of a split VSC packet",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{}, params)
+				gs.PartialChanges = &ccv.ValidatorSetChangePacketData{ValidatorUpdates: valUpdates, ValsetUpdateId: 2, PartIndex: 1, TotalParts: 2}
+				return gs
+			}(),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: partial changes when handshake is still in progress",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "", valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{}, params)
+				gs.PartialChanges = &ccv.ValidatorSetChangePacketData{ValidatorUpdates: valUpdates, ValsetUpdateId: 2, PartIndex: 0, TotalParts: 2}
+				return gs
+			}(),
+			true,
+		},
 		{
 			"invalid restart consumer genesis state: invalid params",
 			types.NewRestartGenesisState("ccvclient", "ccvchannel", valUpdates, nil, types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{},
@@ -48,7 +48,8 @@ const (
 	// received over CCV channel but not yet flushed over ABCI
 	PendingChangesByteKey
 
-	// NOTE: This prefix is deprecated, but left in place to avoid consumer state migrations
+	// NOTE: This prefix is deprecated, but left in place to keep the other prefixes;
+	// its state is removed by the migration to consensus version 5
 	// [DEPRECATED]
 	PendingDataPacketsByteKey
 
@@ -58,11 +59,13 @@ const (
 	// InitialValSetByteKey is the byte to store the initial validator set for a consumer
 	InitialValSetByteKey
 
-	// NOTE: This prefix is deprecated, but left in place to avoid consumer state migrations
+	// NOTE: This prefix is deprecated, but left in place to keep the other prefixes;
+	// its state is removed by the migration to consensus version 5
 	// [DEPRECATED]
 	LastStandaloneHeightByteKey
 
-	// NOTE: This key is deprecated, but left in place to avoid consumer state migrations
+	// NOTE: This key is deprecated, but left in place to keep the other prefixes;
+	// its state is removed by the migration to consensus version 5
 	// [DEPRECATED]
 	DeprecatedSmallestNonOptOutPowerByteKey
 
@@ -70,7 +73,8 @@ const (
 	HistoricalInfoBytePrefix
 
 	// PacketMaturityTimePrefix is the byte prefix that will store maturity time for each received VSC packet
-	// NOTE: This prefix is deprecated, but left in place to avoid state migrations
+	// NOTE: This prefix is deprecated, but left in place to keep the other prefixes;
+	// its state is removed by the migration to consensus version 5
 	DeprecatedPacketMaturityTimeBytePrefix
 
 	// HeightValsetUpdateIDPrefix is the byte prefix that will store the mapping from block height to valset update ID
@@ -108,6 +112,10 @@ const (
 	// ParametersKey is the single byte key for storing consumer's parameters.
 	ParametersByteKey
 
+	// PartialChangesByteKey is the single byte key storing the validator set changes
+	// received so far from a VSC packet that the provider split into several parts
+	PartialChangesByteKey
+
 	// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO getAllKeyPrefixes() IN keys_test.go
 )
 
@@ -217,6 +225,12 @@ func SlashRecordKey() []byte {
 	return []byte{SlashRecordByteKey}
 }
 
+// PartialChangesKey returns the key storing the validator set changes received so far
+// from a VSC packet that the provider split into several parts
+func PartialChangesKey() []byte {
+	return []byte{PartialChangesByteKey}
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
@@ -42,6 +42,7 @@ func getAllKeyPrefixes() []byte {
 		PendingPacketsIndexByteKey,
 		SlashRecordByteKey,
 		ParametersByteKey,
+		PartialChangesByteKey,
 	}
 }
 
@@ -78,5 +79,6 @@ func getAllFullyDefinedKeys() [][]byte {
 		PrevStandaloneChainKey(),
 		PendingPacketsIndexKey(),
 		SlashRecordKey(),
+		PartialChangesKey(),
 	}
 }
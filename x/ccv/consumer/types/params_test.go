@@ -73,6 +73,22 @@ func TestValidateParams(t *testing.T) {
 			"custom invalid params, retry delay period is zero",
 			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0), false,
 		},
+		{
+			"custom valid params, secp256k1 keys allowed",
+			withAllowedKeyTypes(ccvtypes.DefaultParams(), "ed25519", "secp256k1"), true,
+		},
+		{
+			"custom invalid params, unsupported key type",
+			withAllowedKeyTypes(ccvtypes.DefaultParams(), "sr25519"), false,
+		},
+		{
+			"custom invalid params, bls12_381 keys are not supported",
+			withAllowedKeyTypes(ccvtypes.DefaultParams(), "ed25519", "bls12_381"), false,
+		},
+		{
+			"custom invalid params, duplicate key type",
+			withAllowedKeyTypes(ccvtypes.DefaultParams(), "secp256k1", "secp256k1"), false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -84,3 +100,8 @@ func TestValidateParams(t *testing.T) {
 		}
 	}
 }
+
+func withAllowedKeyTypes(params ccvtypes.ConsumerParams, keyTypes ...string) ccvtypes.ConsumerParams {
+	params.AllowedKeyTypes = keyTypes
+	return params
+}
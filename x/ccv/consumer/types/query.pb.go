@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/allinbits/interchain-security/x/ccv/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -385,6 +386,9 @@ func (m *QueryProviderInfoResponse) GetProvider() ChainInfo {
 }
 
 type QueryThrottleStateRequest struct {
+	// pagination defines an optional pagination for the packet data queue;
+	// if it is not set, all the pending packets are returned
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryThrottleStateRequest) Reset()         { *m = QueryThrottleStateRequest{} }
@@ -420,9 +424,19 @@ func (m *QueryThrottleStateRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryThrottleStateRequest proto.InternalMessageInfo
 
+func (m *QueryThrottleStateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryThrottleStateResponse struct {
 	SlashRecord     *SlashRecord               `protobuf:"bytes,1,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
 	PacketDataQueue []types.ConsumerPacketData `protobuf:"bytes,2,rep,name=packet_data_queue,json=packetDataQueue,proto3" json:"packet_data_queue"`
+	// pagination defines the pagination of the packet data queue;
+	// it is not set if the request had no pagination
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryThrottleStateResponse) Reset()         { *m = QueryThrottleStateResponse{} }
@@ -472,6 +486,13 @@ func (m *QueryThrottleStateResponse) GetPacketDataQueue() []types.ConsumerPacket
 	return nil
 }
 
+func (m *QueryThrottleStateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type ChainInfo struct {
 	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	ClientID     string `protobuf:"bytes,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
@@ -558,59 +579,63 @@ func init() {
 }
 
 var fileDescriptor_f627751d3cc10225 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x3a, 0x89, 0x5b, 0x4f, 0x8a, 0x50, 0x07, 0x23, 0x2d, 0xdb, 0xca, 0x44, 0x0b, 0x08,
-	0x53, 0xc9, 0xbb, 0xb6, 0x7b, 0x48, 0x39, 0x94, 0xa2, 0xd6, 0x54, 0xb5, 0x04, 0x28, 0xdd, 0x56,
-	0x42, 0x70, 0x59, 0xc6, 0xeb, 0x89, 0x3d, 0x62, 0x3d, 0xb3, 0x99, 0x99, 0x35, 0xc9, 0x0d, 0xc1,
-	0x1d, 0x21, 0xf1, 0x4d, 0xf8, 0x02, 0x5c, 0x2b, 0x71, 0xa0, 0x12, 0x07, 0xe0, 0x82, 0x50, 0xc2,
-	0x87, 0xe0, 0x88, 0x66, 0x76, 0xd6, 0x59, 0x37, 0x8e, 0xbd, 0x09, 0xbd, 0xed, 0xbc, 0x3f, 0xbf,
-	0xf7, 0xfb, 0xbd, 0x99, 0xf7, 0x6c, 0xe0, 0x13, 0x2a, 0x31, 0x8f, 0x26, 0x88, 0xd0, 0x50, 0xe0,
-	0x28, 0xe5, 0x44, 0x1e, 0xf9, 0x51, 0x34, 0xf3, 0x23, 0x46, 0x45, 0x3a, 0xc5, 0xdc, 0x9f, 0x75,
-	0xfd, 0x83, 0x14, 0xf3, 0x23, 0x2f, 0xe1, 0x4c, 0x32, 0xf8, 0xd6, 0x92, 0x04, 0x2f, 0x8a, 0x66,
-	0x5e, 0x9e, 0xe0, 0xcd, 0xba, 0x4e, 0xe7, 0x3c, 0xd4, 0x59, 0xd7, 0x17, 0x13, 0xc4, 0xf1, 0x28,
-	0x9c, 0x87, 0x6b, 0x58, 0xa7, 0x31, 0x66, 0x63, 0xa6, 0x3f, 0x7d, 0xf5, 0x65, 0xac, 0x37, 0xc7,
-	0x8c, 0x8d, 0x63, 0xec, 0xa3, 0x84, 0xf8, 0x88, 0x52, 0x26, 0x91, 0x24, 0x8c, 0x0a, 0xe3, 0xed,
-	0x95, 0xe1, 0xfe, 0x42, 0x9d, 0x77, 0x56, 0x30, 0xfb, 0x9a, 0x70, 0x9c, 0x85, 0xb9, 0xdf, 0x57,
-	0xc1, 0x8d, 0x4f, 0xf1, 0xa1, 0x7c, 0x88, 0x71, 0x9f, 0x08, 0xc9, 0xc9, 0x30, 0x55, 0x95, 0x3f,
-	0x12, 0x92, 0x4c, 0x91, 0xc4, 0xf0, 0x6d, 0xf0, 0x4a, 0x94, 0x72, 0x8e, 0xa9, 0x7c, 0x84, 0xc9,
-	0x78, 0x22, 0x6d, 0x6b, 0xc7, 0x6a, 0x6d, 0x04, 0x8b, 0x46, 0xd8, 0x04, 0x20, 0x46, 0x22, 0x0f,
-	0xa9, 0xea, 0x90, 0x82, 0x45, 0xf9, 0x29, 0x3e, 0xcc, 0xfd, 0x1b, 0x99, 0xff, 0xd4, 0x02, 0x6f,
-	0x83, 0xd7, 0x47, 0x85, 0xea, 0xe1, 0x3e, 0x47, 0x91, 0xfa, 0xb0, 0x37, 0x77, 0xac, 0x56, 0x3d,
-	0x68, 0x14, 0x9d, 0x0f, 0x8d, 0x0f, 0x36, 0xc0, 0x96, 0x64, 0x12, 0xc5, 0xf6, 0x96, 0x0e, 0xca,
-	0x0e, 0xaa, 0x94, 0x64, 0x7b, 0x9c, 0xcd, 0xc8, 0x08, 0x73, 0xbb, 0xa6, 0x5d, 0x05, 0x4b, 0xe6,
-	0x7f, 0x60, 0x7a, 0x65, 0x5f, 0xc9, 0xfd, 0xb9, 0xc5, 0x7d, 0x0f, 0xbc, 0xfb, 0x58, 0xbd, 0x82,
-	0x15, 0x4d, 0x09, 0xf0, 0x41, 0x8a, 0x85, 0x74, 0xbf, 0xb1, 0x40, 0x6b, 0x7d, 0xac, 0x48, 0x18,
-	0x15, 0x18, 0x3e, 0x05, 0x9b, 0x23, 0x24, 0x91, 0xee, 0xdf, 0x76, 0xef, 0x43, 0xaf, 0xc4, 0xeb,
-	0xf2, 0x56, 0xe1, 0x6a, 0x34, 0xb7, 0x01, 0xa0, 0x66, 0xb0, 0x87, 0x38, 0x9a, 0x8a, 0x9c, 0x58,
-	0x08, 0x5e, 0x5b, 0xb0, 0x1a, 0x0a, 0x8f, 0x40, 0x2d, 0xd1, 0x16, 0x43, 0xe2, 0xd6, 0xb9, 0x24,
-	0x66, 0x5d, 0x2f, 0x6f, 0x48, 0x86, 0x71, 0x7f, 0xf3, 0xd9, 0x5f, 0x6f, 0x56, 0x02, 0x93, 0xef,
-	0x3a, 0xc0, 0xce, 0x0a, 0x98, 0xae, 0x0e, 0xe8, 0x3e, 0xcb, 0x8b, 0xff, 0x6c, 0x81, 0x37, 0x96,
-	0x38, 0x0d, 0x87, 0x3d, 0x70, 0x35, 0x57, 0x68, 0x58, 0x78, 0xa5, 0x5a, 0xf1, 0x40, 0xb9, 0x15,
-	0x92, 0x61, 0x32, 0x47, 0x51, 0x88, 0x49, 0x7e, 0xdd, 0xd5, 0xff, 0x83, 0x98, 0xa3, 0xb8, 0x37,
-	0x8c, 0x80, 0xa7, 0x13, 0xce, 0xa4, 0x8c, 0xf1, 0x13, 0x59, 0xb8, 0xf4, 0x3f, 0x2d, 0xe0, 0x2c,
-	0xf3, 0x1a, 0x7d, 0x9f, 0x83, 0x6b, 0x22, 0x46, 0x62, 0x12, 0x72, 0x1c, 0x31, 0x3e, 0x32, 0x1a,
-	0x3b, 0xa5, 0x18, 0x3d, 0x51, 0x89, 0x81, 0xce, 0xd3, 0x9c, 0xac, 0x60, 0x5b, 0x9c, 0x9a, 0xe0,
-	0x97, 0xe0, 0x7a, 0x82, 0xa2, 0xaf, 0xb0, 0x0c, 0xd5, 0xd5, 0x87, 0x07, 0x29, 0x4e, 0xb1, 0x5d,
-	0xdd, 0xd9, 0x58, 0xa9, 0x78, 0xe1, 0x26, 0x55, 0x72, 0x1f, 0x49, 0x64, 0x14, 0xbf, 0x9a, 0xcc,
-	0x2d, 0x8f, 0x15, 0x98, 0xfb, 0x9d, 0x05, 0xea, 0xf3, 0xb6, 0x40, 0x1b, 0x5c, 0xd1, 0x80, 0x83,
-	0xbe, 0x56, 0x51, 0x0f, 0xf2, 0x23, 0x74, 0xc0, 0xd5, 0x28, 0x26, 0x98, 0xca, 0x41, 0x5f, 0xb7,
-	0xbc, 0x1e, 0xcc, 0xcf, 0xd0, 0x05, 0xd7, 0x22, 0x46, 0x29, 0xd6, 0x33, 0x3a, 0xe8, 0xeb, 0x61,
-	0xaf, 0x07, 0x0b, 0x36, 0x78, 0x13, 0xd4, 0xa3, 0x09, 0xa2, 0x14, 0xc7, 0x83, 0xbe, 0x19, 0xf1,
-	0x53, 0x43, 0xef, 0xf7, 0x1a, 0xd8, 0xd2, 0x1d, 0x86, 0xff, 0x5a, 0xe6, 0x9d, 0x2d, 0x19, 0x04,
-	0xf8, 0x71, 0xa9, 0x9e, 0x96, 0x9c, 0x65, 0xe7, 0x93, 0x97, 0x84, 0x96, 0x3d, 0x03, 0xf7, 0xde,
-	0xb7, 0xbf, 0xfd, 0xf3, 0x63, 0xf5, 0x7d, 0xb8, 0xbb, 0xfe, 0x67, 0x47, 0xad, 0xc1, 0xf6, 0x3e,
-	0xc6, 0xed, 0xe2, 0x92, 0x83, 0x3f, 0x59, 0x60, 0xbb, 0x30, 0xc3, 0x70, 0xb7, 0x3c, 0xbf, 0x85,
-	0x5d, 0xe0, 0xdc, 0xb9, 0x78, 0xa2, 0xd1, 0xd0, 0xd1, 0x1a, 0x6e, 0xc1, 0xd6, 0x7a, 0x0d, 0xd9,
-	0x5a, 0x80, 0xbf, 0x58, 0xe0, 0xfa, 0x99, 0xd1, 0x87, 0x77, 0x2f, 0xc0, 0xe0, 0xec, 0x3e, 0x71,
-	0x3e, 0xb8, 0x6c, 0xba, 0x91, 0xb1, 0xab, 0x65, 0x74, 0xa1, 0x5f, 0x42, 0x86, 0xc9, 0x6f, 0x13,
-	0xc5, 0xfb, 0x57, 0xcb, 0x2c, 0xd7, 0x85, 0x49, 0x87, 0x17, 0xe0, 0xb3, 0x6c, 0x81, 0x38, 0xf7,
-	0x2e, 0x9d, 0x6f, 0x04, 0xdd, 0xd1, 0x82, 0x7a, 0xb0, 0xb3, 0x5e, 0x90, 0x34, 0x00, 0xa1, 0x50,
-	0x08, 0xf7, 0x3f, 0x7b, 0x76, 0xdc, 0xb4, 0x9e, 0x1f, 0x37, 0xad, 0xbf, 0x8f, 0x9b, 0xd6, 0x0f,
-	0x27, 0xcd, 0xca, 0xf3, 0x93, 0x66, 0xe5, 0x8f, 0x93, 0x66, 0xe5, 0x8b, 0xbb, 0x63, 0x22, 0x27,
-	0xe9, 0xd0, 0x8b, 0xd8, 0xd4, 0x47, 0x71, 0x4c, 0xe8, 0x90, 0x48, 0x51, 0xc0, 0x6f, 0xcf, 0xf1,
-	0x0f, 0x5f, 0xa8, 0x70, 0x94, 0x60, 0x31, 0xac, 0xe9, 0x3f, 0x13, 0xb7, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0x5c, 0x8c, 0x0c, 0x65, 0x09, 0x00, 0x00,
+	// 896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x3a, 0x1f, 0xad, 0x27, 0x45, 0xa8, 0x43, 0x90, 0xcc, 0x52, 0x99, 0x68, 0xf9, 0x68,
+	0x88, 0x94, 0xdd, 0x38, 0x3d, 0xa4, 0x1c, 0x4a, 0x51, 0x6b, 0x42, 0x2d, 0x01, 0x4a, 0xb7, 0x95,
+	0x10, 0x5c, 0xcc, 0x78, 0x3c, 0x59, 0x8f, 0x58, 0xcf, 0x6c, 0x66, 0x66, 0x97, 0xe4, 0x86, 0xe0,
+	0x8e, 0x90, 0xf8, 0x07, 0xfc, 0x04, 0xfe, 0x00, 0xd7, 0x4a, 0x1c, 0xa8, 0xc4, 0x01, 0x4e, 0x08,
+	0x25, 0xfc, 0x08, 0x8e, 0x68, 0x66, 0x67, 0xed, 0xdd, 0xd6, 0xb5, 0x37, 0xa5, 0xb7, 0x9d, 0xf7,
+	0x9d, 0xf7, 0x79, 0x9f, 0xe7, 0x99, 0x99, 0xd7, 0x06, 0x01, 0x65, 0x8a, 0x08, 0x3c, 0x42, 0x94,
+	0xf5, 0x25, 0xc1, 0xa9, 0xa0, 0xea, 0x34, 0xc0, 0x38, 0x0b, 0x30, 0x67, 0x32, 0x1d, 0x13, 0x11,
+	0x64, 0x9d, 0xe0, 0x38, 0x25, 0xe2, 0xd4, 0x4f, 0x04, 0x57, 0x1c, 0xbe, 0x39, 0xa3, 0xc0, 0xc7,
+	0x38, 0xf3, 0x8b, 0x02, 0x3f, 0xeb, 0xb8, 0xbb, 0xcf, 0x42, 0xcd, 0x3a, 0x81, 0x1c, 0x21, 0x41,
+	0x86, 0xfd, 0xc9, 0x76, 0x03, 0xeb, 0x6e, 0x44, 0x3c, 0xe2, 0xe6, 0x33, 0xd0, 0x5f, 0x36, 0x7a,
+	0x2d, 0xe2, 0x3c, 0x8a, 0x49, 0x80, 0x12, 0x1a, 0x20, 0xc6, 0xb8, 0x42, 0x8a, 0x72, 0x26, 0x6d,
+	0x76, 0xaf, 0x0e, 0xf7, 0x27, 0xfa, 0xbc, 0x3d, 0x87, 0xd9, 0xd7, 0x54, 0x10, 0xbb, 0x6d, 0x1b,
+	0x73, 0x39, 0xe6, 0x32, 0x18, 0x20, 0x49, 0x72, 0xf9, 0x41, 0xd6, 0x19, 0x10, 0x85, 0x3a, 0x41,
+	0x82, 0x22, 0xca, 0x0c, 0x8f, 0x7c, 0xaf, 0xf7, 0x7d, 0x03, 0xbc, 0xfe, 0x29, 0x39, 0x51, 0x07,
+	0x84, 0x74, 0xa9, 0x54, 0x82, 0x0e, 0x52, 0x9d, 0xfd, 0x50, 0x2a, 0x3a, 0x46, 0x8a, 0xc0, 0xb7,
+	0xc0, 0x4b, 0x38, 0x15, 0x82, 0x30, 0x75, 0x8f, 0xd0, 0x68, 0xa4, 0x5a, 0xce, 0xa6, 0xb3, 0xb5,
+	0x1c, 0x56, 0x83, 0xb0, 0x0d, 0x40, 0x8c, 0x64, 0xb1, 0xa5, 0x61, 0xb6, 0x94, 0x22, 0x3a, 0xcf,
+	0xc8, 0x49, 0x91, 0x5f, 0xce, 0xf3, 0xd3, 0x08, 0xbc, 0x01, 0x5e, 0x1d, 0x96, 0xba, 0xf7, 0x8f,
+	0x04, 0xc2, 0xfa, 0xa3, 0xb5, 0xb2, 0xe9, 0x6c, 0x35, 0xc3, 0x8d, 0x72, 0xf2, 0xc0, 0xe6, 0xe0,
+	0x06, 0x58, 0x55, 0x5c, 0xa1, 0xb8, 0xb5, 0x6a, 0x36, 0xe5, 0x0b, 0xdd, 0x4a, 0xf1, 0x43, 0xc1,
+	0x33, 0x3a, 0x24, 0xa2, 0xb5, 0x66, 0x52, 0xa5, 0x48, 0x9e, 0xbf, 0x6b, 0x7d, 0x6d, 0x5d, 0x2a,
+	0xf2, 0x45, 0xc4, 0x7b, 0x17, 0x5c, 0xbf, 0xaf, 0x2d, 0x9b, 0x63, 0x4a, 0x48, 0x8e, 0x53, 0x22,
+	0x95, 0xf7, 0x8d, 0x03, 0xb6, 0x16, 0xef, 0x95, 0x09, 0x67, 0x92, 0xc0, 0x87, 0x60, 0x65, 0x88,
+	0x14, 0x32, 0xfe, 0xad, 0xef, 0x7d, 0xe0, 0xd7, 0xb8, 0x89, 0xfe, 0x3c, 0x5c, 0x83, 0xe6, 0x6d,
+	0x00, 0x68, 0x18, 0x1c, 0x22, 0x81, 0xc6, 0xb2, 0x20, 0xd6, 0x07, 0xaf, 0x54, 0xa2, 0x96, 0xc2,
+	0x3d, 0xb0, 0x96, 0x98, 0x88, 0x25, 0xb1, 0xfd, 0x4c, 0x12, 0x59, 0xc7, 0x2f, 0x0c, 0xc9, 0x31,
+	0xee, 0xac, 0x3c, 0xfa, 0xeb, 0x8d, 0xa5, 0xd0, 0xd6, 0x7b, 0x2e, 0x68, 0xe5, 0x0d, 0xac, 0xab,
+	0x3d, 0x76, 0xc4, 0x8b, 0xe6, 0xbf, 0x38, 0xe0, 0xb5, 0x19, 0x49, 0xcb, 0xe1, 0x10, 0x5c, 0x2e,
+	0x14, 0x5a, 0x16, 0x7e, 0x2d, 0x2b, 0xee, 0xea, 0xb4, 0x46, 0xb2, 0x4c, 0x26, 0x28, 0x1a, 0x31,
+	0x29, 0x8e, 0xbb, 0xf1, 0x7f, 0x10, 0x0b, 0x14, 0x0f, 0x5b, 0x01, 0x0f, 0x47, 0x82, 0x2b, 0x15,
+	0x93, 0x07, 0x6a, 0x7a, 0xe8, 0xf0, 0x00, 0x80, 0xe9, 0x23, 0xb2, 0x12, 0xde, 0xf1, 0xf3, 0x17,
+	0xe7, 0xeb, 0x17, 0xe7, 0xe7, 0x03, 0xc7, 0xbe, 0x38, 0xff, 0x10, 0x45, 0x45, 0x6d, 0x58, 0xaa,
+	0xf4, 0x7e, 0x6a, 0x00, 0x77, 0x56, 0x17, 0xeb, 0xd3, 0xe7, 0xe0, 0x8a, 0x8c, 0x91, 0x1c, 0xf5,
+	0x05, 0xc1, 0x5c, 0x0c, 0x6d, 0xa3, 0xdd, 0x5a, 0xca, 0x1e, 0xe8, 0xc2, 0xd0, 0xd4, 0x19, 0x6d,
+	0x4e, 0xb8, 0x2e, 0xa7, 0x21, 0xf8, 0x25, 0xb8, 0x9a, 0x20, 0xfc, 0x15, 0x51, 0x7d, 0x7d, 0x85,
+	0xfa, 0xc7, 0x29, 0x49, 0x49, 0xab, 0xb1, 0xb9, 0x3c, 0xd7, 0xb9, 0xca, 0x8d, 0xd0, 0xc5, 0x5d,
+	0xa4, 0x90, 0x75, 0xee, 0xe5, 0x64, 0x12, 0xb9, 0xaf, 0xc1, 0xe0, 0x47, 0x15, 0x8f, 0x96, 0x0d,
+	0xf5, 0xeb, 0x0b, 0x3d, 0xca, 0x95, 0x57, 0x4c, 0xfa, 0xce, 0x01, 0xcd, 0xc9, 0x39, 0xc1, 0x16,
+	0xb8, 0x64, 0x98, 0xf5, 0xba, 0xc6, 0x8e, 0x66, 0x58, 0x2c, 0xa1, 0x0b, 0x2e, 0xe3, 0x98, 0x12,
+	0xa6, 0x7a, 0x5d, 0x73, 0x07, 0x9a, 0xe1, 0x64, 0x0d, 0x3d, 0x70, 0x05, 0x73, 0xc6, 0x88, 0x19,
+	0x1a, 0xbd, 0xae, 0xa1, 0xd3, 0x0c, 0x2b, 0x31, 0x78, 0x0d, 0x34, 0xf1, 0x08, 0x31, 0x46, 0xe2,
+	0x5e, 0xd7, 0xce, 0x9c, 0x69, 0x60, 0xef, 0x8f, 0x35, 0xb0, 0x6a, 0x8e, 0x0a, 0xfe, 0xeb, 0xd8,
+	0x8b, 0x3f, 0xe3, 0x65, 0xc2, 0x8f, 0x6b, 0x1d, 0x4e, 0xcd, 0xe1, 0xe2, 0x7e, 0xf2, 0x82, 0xd0,
+	0x72, 0x57, 0xbd, 0xdb, 0xdf, 0xfe, 0xfe, 0xcf, 0x8f, 0x8d, 0xf7, 0xe0, 0xfe, 0xe2, 0xdf, 0x4c,
+	0x3d, 0x97, 0x77, 0x8e, 0x08, 0xd9, 0x29, 0x4f, 0x5d, 0xf8, 0xb3, 0x03, 0xd6, 0x4b, 0x43, 0x05,
+	0xee, 0xd7, 0xe7, 0x57, 0x19, 0x4e, 0xee, 0xcd, 0x8b, 0x17, 0x5a, 0x0d, 0xbb, 0x46, 0xc3, 0x36,
+	0xdc, 0x5a, 0xac, 0x21, 0x9f, 0x53, 0xf0, 0x57, 0x07, 0x5c, 0x7d, 0x6a, 0x16, 0xc1, 0x5b, 0x17,
+	0x60, 0xf0, 0xf4, 0x80, 0x73, 0xdf, 0x7f, 0xde, 0x72, 0x2b, 0x63, 0xdf, 0xc8, 0xe8, 0xc0, 0xa0,
+	0x86, 0x0c, 0x5b, 0xbf, 0x43, 0x35, 0xef, 0xdf, 0x1c, 0x3b, 0xed, 0x2b, 0x23, 0x03, 0x5e, 0x80,
+	0xcf, 0xac, 0x89, 0xe6, 0xde, 0x7e, 0xee, 0x7a, 0x2b, 0xe8, 0xa6, 0x11, 0xb4, 0x07, 0x77, 0x17,
+	0x0b, 0x52, 0x16, 0xa0, 0x2f, 0x35, 0xc2, 0x9d, 0xcf, 0x1e, 0x9d, 0xb5, 0x9d, 0xc7, 0x67, 0x6d,
+	0xe7, 0xef, 0xb3, 0xb6, 0xf3, 0xc3, 0x79, 0x7b, 0xe9, 0xf1, 0x79, 0x7b, 0xe9, 0xcf, 0xf3, 0xf6,
+	0xd2, 0x17, 0xb7, 0x22, 0xaa, 0x46, 0xe9, 0xc0, 0xc7, 0x7c, 0x1c, 0xa0, 0x38, 0xa6, 0x6c, 0x40,
+	0x95, 0x2c, 0xe1, 0xef, 0x4c, 0xf0, 0x4f, 0x9e, 0xe8, 0x70, 0x9a, 0x10, 0x39, 0x58, 0x33, 0xff,
+	0x6e, 0x6e, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x55, 0xea, 0x4e, 0x6a, 0x22, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1077,6 +1102,18 @@ func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1100,6 +1137,18 @@ func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PacketDataQueue) > 0 {
 		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1295,6 +1344,10 @@ func (m *QueryThrottleStateRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1314,6 +1367,10 @@ func (m *QueryThrottleStateResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2047,6 +2104,42 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2167,6 +2260,42 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,14 @@
+package types
+
+// Keys of the consumer telemetry metrics, which are prefixed by the module name
+// and labelled by the consumer chain ID
+const (
+	// counters
+	MetricKeyRewardTransmissions = "reward_transmissions"
+	MetricKeyRewardsSent         = "rewards_sent"
+
+	// gauges
+	MetricKeyPendingPackets = "pending_packets"
+	// 1 if a slash packet was sent and the consumer waits for the reply of the provider, 0 otherwise
+	MetricKeySlashRecordWaitingOnReply = "slash_record_waiting_on_reply"
+)
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -37,6 +38,14 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdProviderParameters())
 	cmd.AddCommand(CmdConsumerValidators())
 	cmd.AddCommand(CmdConsumerChainOptedInValidators())
+	cmd.AddCommand(CmdConsumerMetadata())
+	cmd.AddCommand(CmdConsumerKeyRotations())
+	cmd.AddCommand(CmdValidatorObligations())
+	cmd.AddCommand(CmdKeyAssignmentHistory())
+	cmd.AddCommand(CmdOptInCommitments())
+	cmd.AddCommand(CmdConsumerReadiness())
+	cmd.AddCommand(CmdConsumerEpochs())
+	cmd.AddCommand(CmdEpochInfo())
 	return cmd
 }
 
@@ -81,7 +90,12 @@ func CmdConsumerChains() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryConsumerChainsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChainsRequest{Pagination: pageReq}
 			res, err := queryClient.QueryConsumerChains(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -92,6 +106,7 @@ func CmdConsumerChains() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-consumer-chains")
 
 	return cmd
 }
@@ -108,7 +123,12 @@ func CmdProposedConsumerChains() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryProposedChainIDsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryProposedChainIDsRequest{Pagination: pageReq}
 			res, err := queryClient.QueryProposedConsumerChainIDs(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -119,6 +139,7 @@ func CmdProposedConsumerChains() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-proposed-consumer-chains")
 
 	return cmd
 }
@@ -139,7 +160,12 @@ func CmdConsumerStartProposals() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryConsumerChainStartProposalsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChainStartProposalsRequest{Pagination: pageReq}
 			res, err := queryClient.QueryConsumerChainStarts(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -150,6 +176,7 @@ func CmdConsumerStartProposals() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-start-proposals")
 
 	return cmd
 }
@@ -170,7 +197,12 @@ func CmdConsumerStopProposals() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryConsumerChainStopProposalsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChainStopProposalsRequest{Pagination: pageReq}
 			res, err := queryClient.QueryConsumerChainStops(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -181,6 +213,7 @@ func CmdConsumerStopProposals() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-stop-proposals")
 
 	return cmd
 }
@@ -336,7 +369,12 @@ $ %s query provider registered-consumer-reward-denoms
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryRegisteredConsumerRewardDenomsRequest{}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRegisteredConsumerRewardDenomsRequest{Pagination: pageReq}
 			res, err := queryClient.QueryRegisteredConsumerRewardDenoms(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -347,6 +385,7 @@ $ %s query provider registered-consumer-reward-denoms
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "registered-consumer-reward-denoms")
 
 	return cmd
 }
@@ -363,7 +402,12 @@ func CmdAllPairsValConAddrByConsumerChainID() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := types.QueryAllPairsValConAddrByConsumerChainIDRequest{ChainId: args[0]}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryAllPairsValConAddrByConsumerChainIDRequest{ChainId: args[0], Pagination: pageReq}
 			res, err := queryClient.QueryAllPairsValConAddrByConsumerChainID(cmd.Context(), &req)
 			if err != nil {
 				return err
@@ -374,6 +418,7 @@ func CmdAllPairsValConAddrByConsumerChainID() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-pairs-valconsensus-address")
 
 	return cmd
 }
@@ -432,8 +477,13 @@ $ %s consumer-validators foochain
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.QueryConsumerValidators(cmd.Context(),
-				&types.QueryConsumerValidatorsRequest{ChainId: args[0]})
+				&types.QueryConsumerValidatorsRequest{ChainId: args[0], Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -443,6 +493,7 @@ $ %s consumer-validators foochain
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer-validators")
 
 	return cmd
 }
@@ -466,8 +517,338 @@ $ %s consumer-opted-in-validators foochain
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.QueryConsumerChainOptedInValidators(cmd.Context(),
-				&types.QueryConsumerChainOptedInValidatorsRequest{ChainId: args[0]})
+				&types.QueryConsumerChainOptedInValidatorsRequest{ChainId: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer-opted-in-validators")
+
+	return cmd
+}
+
+// FlagChainRegistry is the flag to print the consumer metadata in the chain registry format
+const FlagChainRegistry = "chain-registry"
+
+// CmdConsumerMetadata queries the metadata of a given consumer chain
+func CmdConsumerMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-metadata [chainid]",
+		Short: "Query the metadata of a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the metadata of a given consumer chain, i.e., how to find and run it.
+With --%s, the metadata is printed as a chain.json file of the chain registry (https://github.com/cosmos/chain-registry).
+Example:
+$ %s query provider consumer-metadata foochain
+$ %s query provider consumer-metadata foochain --%s
+		`, FlagChainRegistry, version.AppName, version.AppName, FlagChainRegistry),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerMetadata(cmd.Context(),
+				&types.QueryConsumerMetadataRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			chainRegistry, err := cmd.Flags().GetBool(FlagChainRegistry)
+			if err != nil {
+				return err
+			}
+			if !chainRegistry {
+				return clientCtx.PrintProto(&res.Metadata)
+			}
+
+			bz, err := json.MarshalIndent(res.Metadata.ToChainRegistryEntry(args[0]), "", "  ")
+			if err != nil {
+				return err
+			}
+			// chain.json files are JSON regardless of the output format
+			return clientCtx.WithOutputFormat(flags.OutputFormatJSON).PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagChainRegistry, false, "print the metadata as a chain registry chain.json file")
+
+	return cmd
+}
+
+func CmdConsumerKeyRotations() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "consumer-key-rotations [chainid] [provider-validator-address]",
+		Short: "Query the consumer key rotations scheduled on a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer key rotations scheduled on a given consumer chain,
+optionally only the one scheduled by the validator with the given provider consensus address.
+Example:
+$ %s query provider consumer-key-rotations foochain
+$ %s query provider consumer-key-rotations foochain %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerKeyRotationsRequest{ChainId: args[0]}
+			if len(args) > 1 {
+				req.ProviderAddress = args[1]
+			}
+			res, err := queryClient.QueryConsumerKeyRotations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdValidatorObligations returns the command to query the obligations of a validator on every consumer chain
+func CmdValidatorObligations() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-obligations [provider-validator-address]",
+		Short: "Query the obligations of a validator on every consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the obligations of the validator with the given provider consensus address on every
+running consumer chain: whether it is forced to validate the chain as part of the top N, opted in,
+eligible to opt in or excluded, its consumer key, its changes at the end of the current epoch and
+whether it is eligible for consumer rewards.
+Example:
+$ %s query provider validator-obligations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorObligationsRequest{ProviderAddress: args[0]}
+			res, err := queryClient.QueryValidatorObligations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdKeyAssignmentHistory returns the command to query the consumer keys assigned by a validator on a consumer chain
+func CmdKeyAssignmentHistory() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "key-assignment-history [chainid] [provider-validator-address]",
+		Short: "Query the consumer keys assigned by a validator on a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer keys assigned by the validator with the given provider consensus address
+on a given consumer chain, from the oldest to the latest, with the heights and valset update ids at
+which they were assigned and replaced. Only the latest entries are kept, as set by the
+key_assignment_history_size param.
+Example:
+$ %s query provider key-assignment-history foochain %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryKeyAssignmentHistoryRequest{
+				ChainId:         args[0],
+				ProviderAddress: args[1],
+				Pagination:      pageReq,
+			}
+			res, err := queryClient.QueryKeyAssignmentHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "key-assignment-history")
+
+	return cmd
+}
+
+// CmdOptInCommitments returns the command to query the opt-in policy of a consumer chain
+// and the commitments and cooldowns of its validators
+func CmdOptInCommitments() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "opt-in-commitments [chainid] [provider-validator-address]",
+		Short: "Query the opt-in policy of a given consumer chain and the commitments of its validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the opt-in policy of a given consumer chain and, for the validators that opted
+in to or out of it, the height or, if the epochs are based on block time, the epoch from which they
+can opt out or opt in again, optionally only for the validator with the given provider consensus address.
+Example:
+$ %s query provider opt-in-commitments foochain
+$ %s query provider opt-in-commitments foochain %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOptInCommitmentsRequest{ChainId: args[0]}
+			if len(args) > 1 {
+				req.ProviderAddress = args[1]
+			}
+			res, err := queryClient.QueryOptInCommitments(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConsumerReadiness returns the command to query the readiness of the validators in the
+// expected initial validator set of a consumer chain that is waiting for its launch
+func CmdConsumerReadiness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-readiness [chainid]",
+		Short: "Query the readiness of the expected initial validators of a consumer chain that is not launched yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query which validators in the expected initial validator set of a consumer chain that is
+waiting for its launch signalled that they are ready to validate it, with the voting power they hold.
+Example:
+$ %s query provider consumer-readiness foochain
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerReadiness(cmd.Context(), &types.QueryConsumerReadinessRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdConsumerEpochs returns the command to query the epoch length of every consumer chain
+// and the height at which its current epoch ends
+func CmdConsumerEpochs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-epochs",
+		Short: "Query the epoch length and the next epoch boundary of every consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of blocks per epoch of every consumer chain, which is either its own
+or the one of the provider chain, and the height at the end of which its next validator updates are queued.
+Example:
+$ %s query provider consumer-epochs
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerEpochs(cmd.Context(), &types.QueryConsumerEpochsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdEpochInfo returns the command to query the current epoch of the provider chain
+func CmdEpochInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-info",
+		Short: "Query the current epoch of the provider chain and the time or the blocks left until its end",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of the current epoch of the provider chain, at the end of which the validator
+updates are queued, and the time left until its end if the epochs are based on block time, or else the blocks left.
+Example:
+$ %s query provider epoch-info
+		`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryEpochInfo(cmd.Context(), &types.QueryEpochInfoRequest{})
 			if err != nil {
 				return err
 			}
@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/spf13/cobra"
@@ -16,6 +19,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/allinbits/interchain-security/x/ccv/provider/types"
@@ -32,18 +37,50 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewAssignConsumerKeyCmd())
+	cmd.AddCommand(NewScheduleConsumerKeyRotationCmd())
+	cmd.AddCommand(NewCancelConsumerKeyRotationCmd())
+	cmd.AddCommand(NewSignalConsumerReadyCmd())
 	cmd.AddCommand(NewSubmitConsumerMisbehaviourCmd())
 	cmd.AddCommand(NewSubmitConsumerDoubleVotingCmd())
 	cmd.AddCommand(NewConsumerModificationCmd())
+	cmd.AddCommand(NewUpdateConsumerCmd())
+	cmd.AddCommand(NewEmergencyPauseConsumerCmd())
 
 	return cmd
 }
 
+const (
+	// FlagPrivValidatorKeyFile is the flag to sign the consumer key possession payload
+	// with the consumer private key of a priv_validator_key.json file
+	FlagPrivValidatorKeyFile = "priv-validator-key-file"
+	// FlagConsumerKeySignature is the flag to provide a base64 consumer key possession signature made offline
+	FlagConsumerKeySignature = "consumer-key-signature"
+	// FlagNonce is the flag to set the nonce of the consumer key possession payload
+	FlagNonce = "nonce"
+	// FlagApplyHeight is the flag to set the provider height at which a consumer key rotation is applied
+	FlagApplyHeight = "apply-height"
+	// FlagApplyEpoch is the flag to set the epoch at the end of which a consumer key rotation is applied
+	FlagApplyEpoch = "apply-epoch"
+)
+
 func NewAssignConsumerKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "assign-consensus-key [consumer-chain-id] [consumer-pubkey]",
 		Short: "assign a consensus public key to use for a consumer chain",
-		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Assign a consensus public key to use for a consumer chain. The validator must prove
+that it controls the consumer key with a signature by the consumer private key, either
+made from the priv_validator_key.json file of the consumer node with --%s,
+or made offline and given with --%s and --%s.
+The nonce must be greater than the last nonce used by the validator on the consumer chain.
+If it is not set, the current unix time is used.
+Both ed25519 and secp256k1 keys can be assigned, as long as the consumer chain allows their type.
+
+Example:
+  %s tx provider assign-consensus-key consumer-1 '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"<key>"}' \
+    --%s ~/.consumer/config/priv_validator_key.json --from <validator> --chain-id <CID>
+`, FlagPrivValidatorKeyFile, FlagConsumerKeySignature, FlagNonce, version.AppName, FlagPrivValidatorKeyFile)),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -59,10 +96,194 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 
 			providerValAddr := clientCtx.GetFromAddress()
 
-			msg, err := types.NewMsgAssignConsumerKey(args[0], sdk.ValAddress(providerValAddr), args[1], signer)
+			signature, nonce, err := consumerKeyPossessionFromFlags(cmd, args[0], sdk.ValAddress(providerValAddr).String(), args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgAssignConsumerKey(args[0], sdk.ValAddress(providerValAddr), args[1], signer, signature, nonce)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPrivValidatorKeyFile, "", "path to the priv_validator_key.json file of the consumer key")
+	cmd.Flags().String(FlagConsumerKeySignature, "", "base64 signature of the consumer key possession payload")
+	cmd.Flags().Uint64(FlagNonce, 0, "nonce of the consumer key possession payload (default: current unix time)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewScheduleConsumerKeyRotationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-consumer-key-rotation [consumer-chain-id] [consumer-pubkey]",
+		Short: "schedule the assignment of a consensus public key on a running consumer chain",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Schedule the assignment of a consensus public key on a running consumer chain at the end
+of a future epoch of the chain, given either by its number with --%s or by a provider height
+with --%s. A height that is not the last height of an epoch is rounded up to the end of its epoch.
+The validator keeps using its current consumer key until then, so that the node that uses
+the new key can be prepared in advance. A rotation that is already scheduled is replaced.
+The possession of the consumer key is proven as in assign-consensus-key.
+
+Example:
+  %s tx provider schedule-consumer-key-rotation consumer-1 '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"<key>"}' \
+    --%s 120000 --%s ~/.consumer/config/priv_validator_key.json --from <validator> --chain-id <CID>
+`, FlagApplyEpoch, FlagApplyHeight, version.AppName, FlagApplyHeight, FlagPrivValidatorKeyFile)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			providerValAddr := sdk.ValAddress(clientCtx.GetFromAddress()).String()
+
+			signature, nonce, err := consumerKeyPossessionFromFlags(cmd, args[0], providerValAddr, args[1])
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(FlagApplyHeight)
+			if err != nil {
+				return err
+			}
+			epoch, err := cmd.Flags().GetUint64(FlagApplyEpoch)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgScheduleConsumerKeyRotation{
+				ChainId:              args[0],
+				ProviderAddr:         providerValAddr,
+				ConsumerKey:          args[1],
+				Signer:               signer,
+				ConsumerKeySignature: signature,
+				Nonce:                nonce,
+				Height:               height,
+				Epoch:                epoch,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPrivValidatorKeyFile, "", "path to the priv_validator_key.json file of the consumer key")
+	cmd.Flags().String(FlagConsumerKeySignature, "", "base64 signature of the consumer key possession payload")
+	cmd.Flags().Uint64(FlagNonce, 0, "nonce of the consumer key possession payload (default: current unix time)")
+	cmd.Flags().Int64(FlagApplyHeight, 0, "provider height at which the consumer key is assigned")
+	cmd.Flags().Uint64(FlagApplyEpoch, 0, "epoch of the consumer chain at the end of which the consumer key is assigned")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	cmd.MarkFlagsOneRequired(FlagApplyHeight, FlagApplyEpoch)
+	cmd.MarkFlagsMutuallyExclusive(FlagApplyHeight, FlagApplyEpoch)
+
+	return cmd
+}
+
+func NewCancelConsumerKeyRotationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-consumer-key-rotation [consumer-chain-id]",
+		Short: "cancel the consumer key rotation scheduled on a consumer chain",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Cancel the consumer key rotation scheduled by the validator on a consumer chain.
+
+Example:
+  %s tx provider cancel-consumer-key-rotation consumer-1 --from <validator> --chain-id <CID>
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := &types.MsgCancelConsumerKeyRotation{
+				ChainId:      args[0],
+				ProviderAddr: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				Signer:       clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewSignalConsumerReadyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-consumer-ready [consumer-chain-id] [genesis-hash] [binary-hash]",
+		Short: "signal that the validator is ready to validate a consumer chain that is not launched yet",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Signal that the validator verified the genesis and binary hashes, given in hex, of a consumer chain
+that is waiting for its launch and that its node is ready to validate the chain.
+The validator must be in the expected initial validator set of the chain.
+
+Example:
+  %s tx provider signal-consumer-ready consumer-1 3f2a...e1 9b0c...7d --from <validator> --chain-id <CID>
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			genesisHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid genesis hash: %w", err)
+			}
+			binaryHash, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid binary hash: %w", err)
+			}
+
+			msg := &types.MsgSignalConsumerReady{
+				ChainId:      args[0],
+				ProviderAddr: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				GenesisHash:  genesisHash,
+				BinaryHash:   binaryHash,
+				Signer:       clientCtx.GetFromAddress().String(),
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -78,6 +299,72 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 	return cmd
 }
 
+// consumerKeyPossessionFromFlags returns the consumer key possession signature and nonce,
+// either by signing the payload with the priv_validator_key.json file of the consumer key,
+// or as given by the signature and nonce flags
+func consumerKeyPossessionFromFlags(cmd *cobra.Command, chainID, providerAddr, consumerKey string) ([]byte, uint64, error) {
+	keyFile, err := cmd.Flags().GetString(FlagPrivValidatorKeyFile)
+	if err != nil {
+		return nil, 0, err
+	}
+	sigStr, err := cmd.Flags().GetString(FlagConsumerKeySignature)
+	if err != nil {
+		return nil, 0, err
+	}
+	nonce, err := cmd.Flags().GetUint64(FlagNonce)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch {
+	case keyFile != "" && sigStr != "":
+		return nil, 0, fmt.Errorf("only one of --%s and --%s can be set", FlagPrivValidatorKeyFile, FlagConsumerKeySignature)
+	case sigStr != "":
+		if !cmd.Flags().Changed(FlagNonce) {
+			return nil, 0, fmt.Errorf("--%s must be set with --%s", FlagNonce, FlagConsumerKeySignature)
+		}
+		signature, err := base64.StdEncoding.DecodeString(sigStr)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid consumer key signature: %w", err)
+		}
+		return signature, nonce, nil
+	case keyFile != "":
+		if !cmd.Flags().Changed(FlagNonce) {
+			nonce = uint64(time.Now().Unix())
+		}
+		signature, err := SignConsumerKeyPossessionFromFile(keyFile, chainID, providerAddr, consumerKey, nonce)
+		if err != nil {
+			return nil, 0, err
+		}
+		return signature, nonce, nil
+	default:
+		return nil, 0, fmt.Errorf("one of --%s and --%s must be set", FlagPrivValidatorKeyFile, FlagConsumerKeySignature)
+	}
+}
+
+// SignConsumerKeyPossessionFromFile signs the consumer key possession payload with the private key of
+// a priv_validator_key.json file, after checking that it is the private key of the given consumer key
+func SignConsumerKeyPossessionFromFile(keyFile, chainID, providerAddr, consumerKey string, nonce uint64) ([]byte, error) {
+	bz, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	var pvKey privval.FilePVKey
+	if err := cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return nil, fmt.Errorf("reading %s failed: %w", keyFile, err)
+	}
+
+	_, keyStr, err := types.ParseConsumerKeyFromJson(consumerKey)
+	if err != nil {
+		return nil, err
+	}
+	if keyStr != base64.StdEncoding.EncodeToString(pvKey.PubKey.Bytes()) {
+		return nil, fmt.Errorf("the key of %s is not the consumer key %s", keyFile, consumerKey)
+	}
+
+	return types.SignConsumerKeyPossession(pvKey.PrivKey, chainID, providerAddr, nonce)
+}
+
 func NewSubmitConsumerMisbehaviourCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-consumer-misbehaviour [misbehaviour]",
@@ -227,7 +514,24 @@ mod.json schema (all fields optional except authority, chain_id):
   "allowlist": ["cosmosvalcons1..."],
   "denylist":  ["cosmosvalcons1..."],
   "authority": "cosmos1govacct...",   // governance authority address (required)
-  "new_chain_id": "consumer-mainnet"  // optional; ignored after launch
+  "new_chain_id": "consumer-mainnet", // optional; ignored after launch
+  "metadata": {                       // optional; replaces the stored metadata
+    "name": "Consumer",
+    "description": "A consumer chain",
+    "website": "https://consumer.zone",
+    "binary_repository": "https://github.com/consumer/consumer",
+    "genesis_url": "https://consumer.zone/genesis.json",
+    "binary_hash": "<hex-encoded sha256>",
+    "genesis_hash": "<hex-encoded sha256>"
+  },
+  "owner": "cosmos1...",              // optional; replaces the owner of the chain
+  "opt_in_policy": {                  // optional; replaces the opt-in policy of the chain
+    "min_commitment_epochs": 4,
+    "opt_in_cooldown_epochs": 2
+  },
+  "epoch_params": {                   // optional; replaces the epoch params of the chain
+    "blocks_per_epoch": 300           // 0 to use the blocks_per_epoch of the provider
+  }
 }
 `, version.AppName)),
 		Args: cobra.ExactArgs(1),
@@ -260,6 +564,11 @@ mod.json schema (all fields optional except authority, chain_id):
 				Denylist           []string `json:"denylist"`
 				Authority          string   `json:"authority"`
 				NewChainID         string   `json:"new_chain_id"`
+
+				Metadata    *types.ConsumerMetadata    `json:"metadata"`
+				Owner       string                     `json:"owner"`
+				OptInPolicy *types.OptInPolicy         `json:"opt_in_policy"`
+				EpochParams *types.ConsumerEpochParams `json:"epoch_params"`
 			}
 			if err := json.Unmarshal(raw, &in); err != nil {
 				return fmt.Errorf("modification data unmarshalling failed: %w", err)
@@ -284,8 +593,122 @@ mod.json schema (all fields optional except authority, chain_id):
 				Denylist:           in.Denylist,
 				Authority:          in.Authority,
 				NewChainId:         in.NewChainID, // <-- your new field
+				Metadata:           in.Metadata,
+				Owner:              in.Owner,
+				OptInPolicy:        in.OptInPolicy,
+				EpochParams:        in.EpochParams,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+func NewUpdateConsumerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-consumer [path/to/update.json]",
+		Short: "update a consumer chain as its owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Update a consumer chain as its owner, without going through governance.
+The signer (--from) must be the owner of the consumer chain. The Top N and
+the removal of the chain can only be changed by governance.
+
+Example:
+  %s tx provider update-consumer ./update.json --from <owner> --chain-id <CID>
+
+update.json schema (all fields optional except chain_id):
+{
+  "chain_id": "consumer-1",
+  "new_owner": "cosmos1...",                 // transfers the ownership
+  "spawn_time": "2026-01-02T15:04:05Z",      // only before launch
+  "new_chain_id": "consumer-mainnet",        // only before launch
+  "metadata": {                              // replaces the stored metadata
+    "name": "Consumer",
+    "website": "https://consumer.zone"
+  }
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			raw, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var in struct {
+				ChainID    string                  `json:"chain_id"`
+				NewOwner   string                  `json:"new_owner"`
+				SpawnTime  *time.Time              `json:"spawn_time"`
+				NewChainID string                  `json:"new_chain_id"`
+				Metadata   *types.ConsumerMetadata `json:"metadata"`
+			}
+			if err := json.Unmarshal(raw, &in); err != nil {
+				return fmt.Errorf("update data unmarshalling failed: %w", err)
+			}
+
+			msg := types.NewMsgUpdateConsumer(in.ChainID, clientCtx.GetFromAddress().String())
+			msg.NewOwner = in.NewOwner
+			msg.SpawnTime = in.SpawnTime
+			msg.NewChainId = in.NewChainID
+			msg.Metadata = in.Metadata
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+func NewEmergencyPauseConsumerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-pause-consumer [consumer-chain-id] [reason]",
+		Short: "pause a consumer chain as the emergency authority",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Pause a consumer chain immediately. The signer (--from) must be the emergency
+authority set in the provider params. While the chain is paused, the provider
+sends it no validator set changes, ignores its slash packets and does not
+allocate its rewards. Only governance can resume or remove a paused chain.
+
+Example:
+  %s tx provider emergency-pause-consumer consumer-1 "slash packet spam" --from <emergency-authority> --chain-id <CID>
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
 
+			msg := types.NewMsgEmergencyPauseConsumer(clientCtx.GetFromAddress().String(), args[0], args[1])
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -49,6 +49,8 @@ func TestAssignConsensusKeyMsgHandling(t *testing.T) {
 		setup    func(sdk.Context, keeper.Keeper, testkeeper.MockedKeepers)
 		expError bool
 		chainID  string
+		// the crypto identity proving the possession of the consumer key, defaults to the consumer one
+		signer *testcrypto.CryptoIdentity
 	}{
 		{
 			name: "success",
@@ -70,6 +72,25 @@ func TestAssignConsensusKeyMsgHandling(t *testing.T) {
 			expError: false,
 			chainID:  "chainid",
 		},
+		{
+			name: "fail: consumer key possession not proven",
+			setup: func(ctx sdk.Context,
+				k keeper.Keeper, mocks testkeeper.MockedKeepers,
+			) {
+				k.SetPendingConsumerAdditionProp(ctx, &providertypes.ConsumerAdditionProposal{
+					ChainId: "chainid",
+				})
+				gomock.InOrder(
+					mocks.MockStakingKeeper.EXPECT().GetValidator(
+						ctx, providerCryptoId.SDKValOpAddress(),
+					).Return(providerCryptoId.SDKStakingValidator(), nil).Times(1),
+				)
+			},
+			expError: true,
+			chainID:  "chainid",
+			// the provider validator does not control the consumer key
+			signer: providerCryptoId,
+		},
 		{
 			name: "fail: chain ID not registered",
 			setup: func(ctx sdk.Context,
@@ -184,9 +205,14 @@ func TestAssignConsensusKeyMsgHandling(t *testing.T) {
 
 			tc.setup(ctx, k, mocks)
 
+			signer := tc.signer
+			if signer == nil {
+				signer = consumerCryptoId
+			}
 			msg, err := providertypes.NewMsgAssignConsumerKey(tc.chainID,
 				providerCryptoId.SDKValOpAddress(), consumerKey,
 				providerCryptoId.SDKStakingValidator().OperatorAddress,
+				signer.ConsumerKeyPossessionSignature(tc.chainID, providerCryptoId.SDKValOpAddressString(), 1), 1,
 			)
 
 			require.NoError(t, err)
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetConsumerEpochParams sets the epoch params of the given consumer chain
+func (k Keeper) SetConsumerEpochParams(ctx sdk.Context, chainID string, params types.ConsumerEpochParams) {
+	if err := k.consumerEpochParams.Set(ctx, chainID, params); err != nil {
+		panic(fmt.Errorf("failed to set consumer epoch params: %w", err))
+	}
+}
+
+// GetConsumerEpochParams returns the epoch params of the given consumer chain, if they are set
+func (k Keeper) GetConsumerEpochParams(ctx sdk.Context, chainID string) (types.ConsumerEpochParams, bool) {
+	return mustGet(ctx, k.consumerEpochParams.Get, chainID)
+}
+
+// DeleteConsumerEpochParams deletes the epoch params of the given consumer chain
+func (k Keeper) DeleteConsumerEpochParams(ctx sdk.Context, chainID string) {
+	if err := k.consumerEpochParams.Remove(ctx, chainID); err != nil {
+		panic(fmt.Errorf("failed to delete consumer epoch params: %w", err))
+	}
+}
+
+// GetAllConsumerEpochParams returns the epoch params of all the consumer chains that have them set,
+// ordered by chain ID length and chain ID
+func (k Keeper) GetAllConsumerEpochParams(ctx sdk.Context) (params []types.ChainEpochParams) {
+	err := k.consumerEpochParams.Walk(ctx, nil, func(chainID string, p types.ConsumerEpochParams) (bool, error) {
+		params = append(params, types.ChainEpochParams{ChainId: chainID, Params: p})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer epoch params: %w", err))
+	}
+	return params
+}
+
+// GetConsumerBlocksPerEpoch returns the number of blocks that constitute an epoch of the given
+// consumer chain, i.e., the epoch length of the chain if it is set, or else the one of the provider
+func (k Keeper) GetConsumerBlocksPerEpoch(ctx sdk.Context, chainID string) int64 {
+	if params, found := k.GetConsumerEpochParams(ctx, chainID); found && params.BlocksPerEpoch > 0 {
+		return params.BlocksPerEpoch
+	}
+	return k.GetBlocksPerEpoch(ctx)
+}
+
+// GetEpochEndHeight returns the last height of the epoch of consumer chain `chainID` that contains
+// the given height, i.e., the height at the end of which the validator updates of the epoch are queued
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context, chainID string, height int64) int64 {
+	blocksPerEpoch := k.GetConsumerBlocksPerEpoch(ctx, chainID)
+	if height%blocksPerEpoch == 0 {
+		return height
+	}
+	return (height/blocksPerEpoch + 1) * blocksPerEpoch
+}
+
+// GetConsumerChainsAtEpochEnd returns the registered consumer chains whose current epoch ends at the current height
+func (k Keeper) GetConsumerChainsAtEpochEnd(ctx sdk.Context) (chainIDs []string) {
+	providerEpochEnd := k.isProviderEpochEnd(ctx)
+	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+		if k.IsTimeBasedEpoch(ctx, chainID) {
+			if providerEpochEnd {
+				chainIDs = append(chainIDs, chainID)
+			}
+		} else if ctx.BlockHeight()%k.GetConsumerBlocksPerEpoch(ctx, chainID) == 0 {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	return chainIDs
+}
+
+// IsTimeBasedEpoch returns whether the epochs of consumer chain `chainID` are the epochs of the
+// provider chain based on block time, i.e., whether the provider chain has an epoch duration and
+// the consumer chain has no epoch length of its own
+func (k Keeper) IsTimeBasedEpoch(ctx sdk.Context, chainID string) bool {
+	if k.GetEpochDuration(ctx) == 0 {
+		return false
+	}
+	params, found := k.GetConsumerEpochParams(ctx, chainID)
+	return !found || params.BlocksPerEpoch == 0
+}
+
+// SetEpochState sets the current epoch of the provider chain when the epochs are based on block time
+func (k Keeper) SetEpochState(ctx sdk.Context, state types.EpochState) {
+	if err := k.epochState.Set(ctx, state); err != nil {
+		panic(fmt.Errorf("failed to set epoch state: %w", err))
+	}
+}
+
+// GetEpochState returns the current epoch of the provider chain, if the epochs are based on block time
+// and the epoch state was already set
+func (k Keeper) GetEpochState(ctx sdk.Context) (types.EpochState, bool) {
+	state, err := k.epochState.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return state, false
+	} else if err != nil {
+		panic(fmt.Errorf("failed to get epoch state: %w", err))
+	}
+	return state, true
+}
+
+// DeleteEpochState deletes the current epoch of the provider chain
+func (k Keeper) DeleteEpochState(ctx sdk.Context) {
+	if err := k.epochState.Remove(ctx); err != nil {
+		panic(fmt.Errorf("failed to delete epoch state: %w", err))
+	}
+}
+
+// GetCurrentEpochState returns the current epoch of the provider chain when the epochs are based
+// on block time. If no epoch is stored yet, e.g., because the epoch duration was just set, the
+// first time-based epoch starts with the current block.
+func (k Keeper) GetCurrentEpochState(ctx sdk.Context) types.EpochState {
+	if state, found := k.GetEpochState(ctx); found {
+		return state
+	}
+	return types.EpochState{Number: 1, StartHeight: ctx.BlockHeight(), StartTime: ctx.BlockTime()}
+}
+
+// GetEpochEndTime returns the block time from which the current epoch of the provider chain ends,
+// when the epochs are based on block time
+func (k Keeper) GetEpochEndTime(ctx sdk.Context) time.Time {
+	return k.GetCurrentEpochState(ctx).StartTime.Add(k.GetEpochDuration(ctx))
+}
+
+// isProviderEpochEnd returns whether the current epoch of the provider chain ends at the current height,
+// i.e., whether the height is a multiple of the blocks per epoch or, if the epochs are based on block
+// time, whether the block time reached the end of the epoch
+func (k Keeper) isProviderEpochEnd(ctx sdk.Context) bool {
+	if k.GetEpochDuration(ctx) > 0 {
+		return !ctx.BlockTime().Before(k.GetEpochEndTime(ctx))
+	}
+	return ctx.BlockHeight()%k.GetBlocksPerEpoch(ctx) == 0
+}
+
+// updateEpochState starts a new epoch of the provider chain once the current one ended, when the epochs
+// are based on block time. The new epoch starts at the last multiple of the epoch duration reached by
+// the block time, so that the epochs do not drift with the block times; the epochs skipped, e.g., while
+// the chain was halted, are counted in the epoch number. The epoch state is deleted once the epochs are
+// based on block height again.
+func (k Keeper) updateEpochState(ctx sdk.Context) {
+	duration := k.GetEpochDuration(ctx)
+	state, found := k.GetEpochState(ctx)
+	if duration == 0 {
+		if found {
+			k.DeleteEpochState(ctx)
+		}
+		return
+	}
+	if !found {
+		k.SetEpochState(ctx, k.GetCurrentEpochState(ctx))
+		return
+	}
+	if elapsed := ctx.BlockTime().Sub(state.StartTime); elapsed >= duration {
+		epochs := elapsed / duration
+		state.Number += uint64(epochs)
+		state.StartHeight = ctx.BlockHeight() + 1
+		state.StartTime = state.StartTime.Add(epochs * duration)
+		k.SetEpochState(ctx, state)
+	}
+}
+
+// GetCurrentEpoch returns the number of the current epoch of consumer chain `chainID`. The latest
+// block has been committed, so the epoch of the next block is considered.
+func (k Keeper) GetCurrentEpoch(ctx sdk.Context, chainID string) uint64 {
+	if k.IsTimeBasedEpoch(ctx, chainID) {
+		return k.GetCurrentEpochState(ctx).Number
+	}
+	return uint64(k.GetEpochEndHeight(ctx, chainID, ctx.BlockHeight()+1) / k.GetConsumerBlocksPerEpoch(ctx, chainID))
+}
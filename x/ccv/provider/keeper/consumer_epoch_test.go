@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// TestEndBlockVSUConsumerEpochs tests that the validator updates of a consumer chain with an epoch
+// length of its own are queued at the end of its epochs, and that the updates of the dirty validators
+// are held back for the other consumer chains until the end of their epochs
+func TestEndBlockVSUConsumerEpochs(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	state := newStakingState(mocks, 4)
+	for _, chainID := range []string{"chain1", "chain2"} {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		for i := 0; i < 4; i++ {
+			providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+		}
+	}
+	// chain2 has epochs of 4 blocks, chain1 has the epochs of 10 blocks of the provider
+	providerKeeper.SetConsumerEpochParams(ctx, "chain2", providertypes.ConsumerEpochParams{BlocksPerEpoch: 4})
+
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(4))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "chain1"))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "chain2"), 1)
+
+	// validator 0 changes its power before the end of the next epoch of chain2
+	state.powers[state.validators[0].GetOperator()] = 100
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(0))
+
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(8))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "chain1"))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "chain2"), 2)
+	// the update is held back for chain1
+	require.Empty(t, providerKeeper.GetAllDirtyValidators(ctx))
+	require.Equal(t, []providertypes.ProviderConsAddress{state.providerAddr(0)},
+		providerKeeper.GetAllDirtyConsumerValidators(ctx, "chain1"))
+
+	// the epoch of chain1 ends with the one of the provider
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(10))
+	packets := providerKeeper.GetPendingVSCPackets(ctx, "chain1")
+	require.Len(t, packets, 1)
+	require.Len(t, packets[0].ValidatorUpdates, 4)
+	require.Empty(t, providerKeeper.GetAllDirtyConsumerValidators(ctx, "chain1"))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "chain2"), 2)
+}
+
+// TestQueryConsumerEpochs tests that the epoch length of every consumer chain and the height at
+// which its current epoch ends are returned
+func TestQueryConsumerEpochs(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(12)
+
+	providerKeeper.SetConsumerClientId(ctx, "chain1", "clientID")
+	providerKeeper.SetConsumerClientId(ctx, "chain2", "clientID")
+	providerKeeper.SetConsumerEpochParams(ctx, "chain2", providertypes.ConsumerEpochParams{BlocksPerEpoch: 4})
+
+	res, err := providerKeeper.QueryConsumerEpochs(ctx, &providertypes.QueryConsumerEpochsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*providertypes.ConsumerEpochInfo{
+		{ChainId: "chain1", BlocksPerEpoch: 10, CurrentEpoch: 2, NextEpochEndHeight: 20},
+		{ChainId: "chain2", BlocksPerEpoch: 4, CustomEpoch: true, CurrentEpoch: 4, NextEpochEndHeight: 16},
+	}, res.Epochs)
+}
+
+// TestEndBlockVSUTimeBasedEpochs tests that, when the epochs are based on block time, the validator
+// updates are queued once the block time reaches the end of the epoch, and that the next epoch starts
+// at a multiple of the epoch duration from the start of the first epoch
+func TestEndBlockVSUTimeBasedEpochs(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.EpochDuration = time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	state := newStakingState(mocks, 4)
+	for _, chainID := range []string{"chain1", "chain2"} {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		for i := 0; i < 4; i++ {
+			providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+		}
+	}
+	// chain2 keeps its epochs of 4 blocks
+	providerKeeper.SetConsumerEpochParams(ctx, "chain2", providertypes.ConsumerEpochParams{BlocksPerEpoch: 4})
+	require.True(t, providerKeeper.IsTimeBasedEpoch(ctx, "chain1"))
+	require.False(t, providerKeeper.IsTimeBasedEpoch(ctx, "chain2"))
+
+	// the first epoch starts with the first block
+	start := time.Unix(0, 0).UTC()
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(1).WithBlockTime(start))
+	epochState, found := providerKeeper.GetEpochState(ctx)
+	require.True(t, found)
+	require.Equal(t, providertypes.EpochState{Number: 1, StartHeight: 1, StartTime: start}, epochState)
+
+	// height 10 does not end the epoch of chain1
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(10).WithBlockTime(start.Add(10 * time.Minute)))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "chain1"))
+
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(12).WithBlockTime(start.Add(time.Hour + time.Minute)))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "chain1"), 1)
+	epochState, _ = providerKeeper.GetEpochState(ctx)
+	require.Equal(t, providertypes.EpochState{Number: 2, StartHeight: 13, StartTime: start.Add(time.Hour)}, epochState)
+
+	// the epochs skipped while no block was produced are counted
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(13).WithBlockTime(start.Add(4*time.Hour + time.Minute)))
+	epochState, _ = providerKeeper.GetEpochState(ctx)
+	require.Equal(t, providertypes.EpochState{Number: 5, StartHeight: 14, StartTime: start.Add(4 * time.Hour)}, epochState)
+
+	// the epoch state is deleted once the epochs are based on block height again
+	params.EpochDuration = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.EndBlockVSU(ctx.WithBlockHeight(14).WithBlockTime(start.Add(5 * time.Hour)))
+	_, found = providerKeeper.GetEpochState(ctx)
+	require.False(t, found)
+}
+
+// TestQueryEpochInfo tests that the current epoch of the provider chain is returned both
+// when the epochs are based on block height and when they are based on block time
+func TestQueryEpochInfo(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+	start := time.Unix(0, 0).UTC()
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(start.Add(20 * time.Minute))
+
+	res, err := providerKeeper.QueryEpochInfo(ctx, &providertypes.QueryEpochInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &providertypes.QueryEpochInfoResponse{
+		CurrentEpoch:   2,
+		BlocksPerEpoch: 10,
+		StartHeight:    11,
+		EndHeight:      20,
+		BlocksLeft:     8,
+	}, res)
+
+	params.EpochDuration = time.Hour
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.SetEpochState(ctx, providertypes.EpochState{Number: 3, StartHeight: 5, StartTime: start})
+	endTime := start.Add(time.Hour)
+
+	res, err = providerKeeper.QueryEpochInfo(ctx, &providertypes.QueryEpochInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &providertypes.QueryEpochInfoResponse{
+		TimeBased:     true,
+		CurrentEpoch:  3,
+		EpochDuration: time.Hour,
+		StartHeight:   5,
+		StartTime:     &start,
+		EndTime:       &endTime,
+		TimeLeft:      40 * time.Minute,
+	}, res)
+}
@@ -80,6 +80,11 @@ func (k Keeper) HandleConsumerDoubleVoting(
 		"confirmed equivocation",
 		"byzantine validator address", providerAddr.String(),
 	)
+	k.emitTypedEvent(ctx, &types.EventConsumerDoubleVotingPunished{
+		ChainId:          chainID,
+		ProviderConsAddr: providerAddr.String(),
+		InfractionHeight: evidence.VoteA.Height,
+	})
 
 	return nil
 }
@@ -176,7 +181,7 @@ func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, misbehaviour ibctmty
 		return err
 	}
 
-	provAddrs := make([]types.ProviderConsAddress, len(byzantineValidators))
+	provAddrs := make([]types.ProviderConsAddress, 0, len(byzantineValidators))
 
 	// slash, jail, and tombstone the Byzantine validators
 	for _, v := range byzantineValidators {
@@ -211,6 +216,15 @@ func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, misbehaviour ibctmty
 		"byzantine validators slashed, jailed and tombstoned", provAddrs,
 	)
 
+	event := types.EventConsumerMisbehaviourPunished{
+		ChainId:  misbehaviour.Header1.Header.ChainID,
+		ClientId: misbehaviour.ClientId,
+	}
+	for _, providerAddr := range provAddrs {
+		event.ProviderConsAddrs = append(event.ProviderConsAddrs, providerAddr.String())
+	}
+	k.emitTypedEvent(ctx, &event)
+
 	return nil
 }
 
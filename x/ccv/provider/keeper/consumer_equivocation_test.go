@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
@@ -319,6 +320,49 @@ func TestVerifyDoubleVotingEvidence(t *testing.T) {
 	}
 }
 
+// TestVerifyDoubleVotingEvidenceWithSecp256k1Key tests that double voting evidence
+// can be verified for consumer validators that use secp256k1 consensus keys
+func TestVerifyDoubleVotingEvidenceWithSecp256k1Key(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	ctx = ctx.WithBlockTime(time.Now())
+
+	signer := tmtypes.NewMockPVWithParams(secp256k1.GenPrivKey(), false, false)
+	val := tmtypes.NewValidator(signer.PrivKey.PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{val})
+
+	valPubkey, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
+	require.NoError(t, err)
+
+	evidence := tmtypes.DuplicateVoteEvidence{
+		VoteA: cryptotestutil.MakeAndSignVote(
+			cryptotestutil.MakeBlockID([]byte("blockhash"), 1000, []byte("partshash")),
+			ctx.BlockHeight(),
+			ctx.BlockTime(),
+			valSet,
+			signer,
+			chainID,
+		),
+		VoteB: cryptotestutil.MakeAndSignVote(
+			cryptotestutil.MakeBlockID([]byte("blockhash2"), 1000, []byte("partshash")),
+			ctx.BlockHeight(),
+			ctx.BlockTime(),
+			valSet,
+			signer,
+			chainID,
+		),
+		ValidatorPower:   val.VotingPower,
+		TotalVotingPower: val.VotingPower,
+		Timestamp:        ctx.BlockTime(),
+	}
+
+	require.NoError(t, keeper.VerifyDoubleVotingEvidence(evidence, chainID, valPubkey))
+	// the votes are not valid for another chain
+	require.Error(t, keeper.VerifyDoubleVotingEvidence(evidence, "other", valPubkey))
+}
+
 // TestJailAndTombstoneValidator tests that the jailing of a validator is only executed
 // under the conditions that the validator is neither unbonded, nor jailed, nor tombstoned.
 func TestJailAndTombstoneValidator(t *testing.T) {
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetConsumerMetadata sets the metadata of the given consumer chain
+func (k Keeper) SetConsumerMetadata(ctx sdk.Context, chainID string, metadata types.ConsumerMetadata) {
+	if err := k.consumerMetadata.Set(ctx, chainID, metadata); err != nil {
+		panic(fmt.Errorf("failed to set metadata for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetConsumerMetadata returns the metadata of the given consumer chain
+func (k Keeper) GetConsumerMetadata(ctx sdk.Context, chainID string) (types.ConsumerMetadata, bool) {
+	return mustGet(ctx, k.consumerMetadata.Get, chainID)
+}
+
+// DeleteConsumerMetadata deletes the metadata of the given consumer chain
+func (k Keeper) DeleteConsumerMetadata(ctx sdk.Context, chainID string) {
+	if err := k.consumerMetadata.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete metadata for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetAllConsumerMetadata returns the metadata of all the consumer chains, ordered by chain ID length and then by chain ID
+func (k Keeper) GetAllConsumerMetadata(ctx sdk.Context) (metadata []types.ConsumerChainMetadata) {
+	err := k.consumerMetadata.Walk(ctx, nil, func(chainID string, m types.ConsumerMetadata) (bool, error) {
+		metadata = append(metadata, types.ConsumerChainMetadata{ChainId: chainID, Metadata: m})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer metadata: %w", err))
+	}
+	return metadata
+}
+
+// deleteDroppedConsumerInfo deletes the metadata, the owner, the opt-in policy and records, the readiness
+// signals and the epoch params of a consumer chain whose launch was dropped, unless a consumer chain
+// with the same chain ID is running
+func (k Keeper) deleteDroppedConsumerInfo(ctx sdk.Context, chainID string) {
+	if _, found := k.GetConsumerClientId(ctx, chainID); found {
+		return
+	}
+	k.DeleteConsumerMetadata(ctx, chainID)
+	k.DeleteConsumerOwner(ctx, chainID)
+	k.DeleteOptInPolicy(ctx, chainID)
+	k.DeleteAllOptInRecords(ctx, chainID)
+	k.DeleteAllReadinessSignals(ctx, chainID)
+	k.DeleteConsumerEpochParams(ctx, chainID)
+}
+
+// isConsumerPendingOrRegistered returns whether the given consumer chain is either
+// waiting for its spawn time or already registered, i.e., it has a consumer client
+func (k Keeper) isConsumerPendingOrRegistered(ctx sdk.Context, chainID string) bool {
+	if _, found := k.GetConsumerClientId(ctx, chainID); found {
+		return true
+	}
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId == chainID {
+			return true
+		}
+	}
+	return false
+}
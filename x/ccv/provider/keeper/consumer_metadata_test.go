@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestConsumerMetadata(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerMetadata(ctx, "chainID")
+	require.False(t, found)
+
+	metadata := providertypes.ConsumerMetadata{
+		Name:        "Chain",
+		Description: "a consumer chain",
+		GenesisUrl:  "https://chain.zone/genesis.json",
+	}
+	providerKeeper.SetConsumerMetadata(ctx, "chainID", metadata)
+	providerKeeper.SetConsumerMetadata(ctx, "chain", providertypes.ConsumerMetadata{Name: "Other"})
+
+	got, found := providerKeeper.GetConsumerMetadata(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, metadata, got)
+
+	// chain IDs are ordered by length first since they are stored with their length
+	require.Equal(t, []providertypes.ConsumerChainMetadata{
+		{ChainId: "chain", Metadata: providertypes.ConsumerMetadata{Name: "Other"}},
+		{ChainId: "chainID", Metadata: metadata},
+	}, providerKeeper.GetAllConsumerMetadata(ctx))
+
+	providerKeeper.DeleteConsumerMetadata(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerMetadata(ctx, "chainID")
+	require.False(t, found)
+	// deleting a missing entry is a no-op
+	providerKeeper.DeleteConsumerMetadata(ctx, "chainID")
+	require.Len(t, providerKeeper.GetAllConsumerMetadata(ctx), 1)
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetConsumerOwner sets the owner account of the given consumer chain
+func (k Keeper) SetConsumerOwner(ctx sdk.Context, chainID string, owner sdk.AccAddress) {
+	if err := k.consumerOwner.Set(ctx, chainID, owner); err != nil {
+		panic(fmt.Errorf("failed to set owner for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetConsumerOwner returns the owner account of the given consumer chain
+func (k Keeper) GetConsumerOwner(ctx sdk.Context, chainID string) (sdk.AccAddress, bool) {
+	return mustGet(ctx, k.consumerOwner.Get, chainID)
+}
+
+// DeleteConsumerOwner deletes the owner account of the given consumer chain
+func (k Keeper) DeleteConsumerOwner(ctx sdk.Context, chainID string) {
+	if err := k.consumerOwner.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete owner for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetAllConsumerOwners returns the owner accounts of all the consumer chains, ordered by chain ID length and then by chain ID
+func (k Keeper) GetAllConsumerOwners(ctx sdk.Context) (owners []types.ConsumerOwner) {
+	err := k.consumerOwner.Walk(ctx, nil, func(chainID string, owner sdk.AccAddress) (bool, error) {
+		owners = append(owners, types.ConsumerOwner{ChainId: chainID, Owner: owner.String()})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer owners: %w", err))
+	}
+	return owners
+}
+
+// HandleConsumerUpdate applies the update of a consumer chain requested by its owner.
+// The owner is expected to be authorized by the caller. The chain ID and the spawn time
+// can only be updated before the chain launches.
+func (k Keeper) HandleConsumerUpdate(ctx sdk.Context, msg *types.MsgUpdateConsumer) error {
+	chainID := msg.ChainId
+	event := types.EventConsumerUpdated{Owner: msg.Owner}
+
+	if s := strings.TrimSpace(msg.NewChainId); s != "" && s != chainID {
+		if err := k.updatePrelaunchChainID(ctx, chainID, s); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidConsumerUpdate, err.Error())
+		}
+		chainID = s
+	}
+
+	if msg.SpawnTime != nil {
+		if err := k.updatePrelaunchSpawnTime(ctx, chainID, *msg.SpawnTime); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidConsumerUpdate, err.Error())
+		}
+		event.SpawnTime = msg.SpawnTime
+	}
+
+	if msg.Metadata != nil {
+		k.SetConsumerMetadata(ctx, chainID, *msg.Metadata)
+		event.MetadataUpdated = true
+	}
+
+	if msg.NewOwner != "" {
+		newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidConsumerUpdate, err.Error())
+		}
+		k.SetConsumerOwner(ctx, chainID, newOwner)
+		event.NewOwner = msg.NewOwner
+	}
+
+	event.ChainId = chainID
+	k.emitTypedEvent(ctx, &event)
+	return nil
+}
+
+// updatePrelaunchSpawnTime moves the pending addition proposals of the given consumer chain
+// to the given spawn time, which must not have passed yet.
+func (k Keeper) updatePrelaunchSpawnTime(ctx sdk.Context, chainID string, spawnTime time.Time) error {
+	if _, launched := k.GetConsumerClientId(ctx, chainID); launched {
+		return fmt.Errorf("cannot update the spawn time of a launched chain: %s", chainID)
+	}
+	if !spawnTime.After(ctx.BlockTime()) {
+		return fmt.Errorf("spawn time %s is not after the current block time %s", spawnTime.UTC(), ctx.BlockTime().UTC())
+	}
+
+	found := false
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId != chainID {
+			continue
+		}
+		k.DeletePendingConsumerAdditionProps(ctx, prop)
+		prop.SpawnTime = spawnTime
+		k.SetPendingConsumerAdditionProp(ctx, &prop)
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("no pending addition proposal for consumer chain: %s", chainID)
+	}
+	return nil
+}
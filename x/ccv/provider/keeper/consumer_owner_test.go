@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providerkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestConsumerOwner(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerOwner(ctx, "chainID")
+	require.False(t, found)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	other := sdk.AccAddress([]byte("other"))
+	providerKeeper.SetConsumerOwner(ctx, "chainID", owner)
+	providerKeeper.SetConsumerOwner(ctx, "chain", other)
+
+	got, found := providerKeeper.GetConsumerOwner(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, owner, got)
+
+	// chain IDs are ordered by length first since they are stored with their length
+	require.Equal(t, []providertypes.ConsumerOwner{
+		{ChainId: "chain", Owner: other.String()},
+		{ChainId: "chainID", Owner: owner.String()},
+	}, providerKeeper.GetAllConsumerOwners(ctx))
+
+	providerKeeper.DeleteConsumerOwner(ctx, "chainID")
+	_, found = providerKeeper.GetConsumerOwner(ctx, "chainID")
+	require.False(t, found)
+	// deleting a missing entry is a no-op
+	providerKeeper.DeleteConsumerOwner(ctx, "chainID")
+	require.Len(t, providerKeeper.GetAllConsumerOwners(ctx), 1)
+}
+
+func TestUpdateConsumer(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	other := sdk.AccAddress([]byte("other"))
+
+	// setup returns a keeper with a consumer chain owned by owner and waiting for its spawn time
+	setup := func(t *testing.T) (providerkeeper.Keeper, sdk.Context, providertypes.MsgServer, *providertypes.ConsumerAdditionProposal) {
+		t.Helper()
+		pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		t.Cleanup(ctrl.Finish)
+		ctx = ctx.WithBlockTime(time.Now())
+
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.ChainId = "chain-1"
+		prop.SpawnTime = ctx.BlockTime().Add(time.Hour)
+		pk.SetPendingConsumerAdditionProp(ctx, prop)
+		pk.SetConsumerOwner(ctx, prop.ChainId, owner)
+
+		return pk, ctx, providerkeeper.NewMsgServerImpl(&pk), prop
+	}
+
+	t.Run("only the owner can update the chain", func(t *testing.T) {
+		_, ctx, msgServer, _ := setup(t)
+
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:  "chain-1",
+			Owner:    other.String(),
+			Metadata: &providertypes.ConsumerMetadata{Name: "Chain"},
+		})
+		require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+		// a chain without an owner can only be updated by governance
+		_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:  "chain-2",
+			Owner:    owner.String(),
+			Metadata: &providertypes.ConsumerMetadata{Name: "Chain"},
+		})
+		require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	})
+
+	t.Run("the owner moves the spawn time", func(t *testing.T) {
+		pk, ctx, msgServer, prop := setup(t)
+
+		spawnTime := prop.SpawnTime.Add(time.Hour)
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:   prop.ChainId,
+			Owner:     owner.String(),
+			SpawnTime: &spawnTime,
+		})
+		require.NoError(t, err)
+
+		_, found := pk.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+		require.False(t, found)
+		moved, found := pk.GetPendingConsumerAdditionProp(ctx, spawnTime, prop.ChainId)
+		require.True(t, found)
+		require.Equal(t, spawnTime.UTC(), moved.SpawnTime.UTC())
+
+		// the spawn time cannot be moved to the past
+		past := ctx.BlockTime().Add(-time.Minute)
+		_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:   prop.ChainId,
+			Owner:     owner.String(),
+			SpawnTime: &past,
+		})
+		require.ErrorIs(t, err, providertypes.ErrInvalidConsumerUpdate)
+	})
+
+	t.Run("the owner renames the chain before launch", func(t *testing.T) {
+		pk, ctx, msgServer, prop := setup(t)
+
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:    prop.ChainId,
+			Owner:      owner.String(),
+			NewChainId: "chain-1-new",
+			Metadata:   &providertypes.ConsumerMetadata{Name: "Chain"},
+		})
+		require.NoError(t, err)
+
+		// the pending addition proposal and the owner are moved to the new chain ID
+		_, found := pk.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, prop.ChainId)
+		require.False(t, found)
+		renamed, found := pk.GetPendingConsumerAdditionProp(ctx, prop.SpawnTime, "chain-1-new")
+		require.True(t, found)
+		require.Equal(t, "chain-1-new", renamed.ChainId)
+		_, found = pk.GetConsumerOwner(ctx, prop.ChainId)
+		require.False(t, found)
+		got, found := pk.GetConsumerOwner(ctx, "chain-1-new")
+		require.True(t, found)
+		require.Equal(t, owner, got)
+		metadata, found := pk.GetConsumerMetadata(ctx, "chain-1-new")
+		require.True(t, found)
+		require.Equal(t, "Chain", metadata.Name)
+
+		var updated []*providertypes.EventConsumerUpdated
+		for _, ev := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*providertypes.EventConsumerUpdated); ok {
+				updated = append(updated, e)
+			}
+		}
+		require.Equal(t, []*providertypes.EventConsumerUpdated{
+			{ChainId: "chain-1-new", Owner: owner.String(), MetadataUpdated: true},
+		}, updated)
+	})
+
+	t.Run("a chain cannot be renamed to a pending chain", func(t *testing.T) {
+		pk, ctx, msgServer, prop := setup(t)
+
+		other := *prop
+		other.ChainId = "chain-2"
+		pk.SetPendingConsumerAdditionProp(ctx, &other)
+
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:    prop.ChainId,
+			Owner:      owner.String(),
+			NewChainId: "chain-2",
+		})
+		require.ErrorIs(t, err, providertypes.ErrInvalidConsumerUpdate)
+		require.Contains(t, err.Error(), "target chain id already exists")
+	})
+
+	t.Run("the owner transfers the ownership", func(t *testing.T) {
+		pk, ctx, msgServer, prop := setup(t)
+
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:  prop.ChainId,
+			Owner:    owner.String(),
+			NewOwner: other.String(),
+		})
+		require.NoError(t, err)
+		got, _ := pk.GetConsumerOwner(ctx, prop.ChainId)
+		require.Equal(t, other, got)
+
+		// the previous owner lost its rights
+		_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:  prop.ChainId,
+			Owner:    owner.String(),
+			Metadata: &providertypes.ConsumerMetadata{Name: "Chain"},
+		})
+		require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	})
+
+	t.Run("a launched chain only gets its metadata and owner updated", func(t *testing.T) {
+		pk, ctx, msgServer, prop := setup(t)
+		pk.DeletePendingConsumerAdditionProps(ctx, *prop)
+		pk.SetConsumerClientId(ctx, prop.ChainId, "07-tendermint-0")
+		pk.SetTopN(ctx, prop.ChainId, 95)
+
+		spawnTime := ctx.BlockTime().Add(time.Hour)
+		_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:   prop.ChainId,
+			Owner:     owner.String(),
+			SpawnTime: &spawnTime,
+		})
+		require.ErrorIs(t, err, providertypes.ErrInvalidConsumerUpdate)
+
+		_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:    prop.ChainId,
+			Owner:      owner.String(),
+			NewChainId: "chain-1-new",
+		})
+		require.ErrorIs(t, err, providertypes.ErrInvalidConsumerUpdate)
+
+		_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+			ChainId:  prop.ChainId,
+			Owner:    owner.String(),
+			Metadata: &providertypes.ConsumerMetadata{Name: "Chain"},
+		})
+		require.NoError(t, err)
+
+		// the Top N is left to governance
+		topN, found := pk.GetTopN(ctx, prop.ChainId)
+		require.True(t, found)
+		require.Equal(t, uint32(95), topN)
+	})
+}
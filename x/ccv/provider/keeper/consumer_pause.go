@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetConsumerPause marks the given consumer chain as paused
+func (k Keeper) SetConsumerPause(ctx sdk.Context, chainID string, pause types.ConsumerPause) {
+	if err := k.pausedConsumers.Set(ctx, chainID, pause); err != nil {
+		panic(fmt.Errorf("failed to set pause for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetConsumerPause returns the pause of the given consumer chain, if it is paused
+func (k Keeper) GetConsumerPause(ctx sdk.Context, chainID string) (types.ConsumerPause, bool) {
+	return mustGet(ctx, k.pausedConsumers.Get, chainID)
+}
+
+// IsConsumerPaused returns whether the given consumer chain is paused
+func (k Keeper) IsConsumerPaused(ctx sdk.Context, chainID string) bool {
+	paused, err := k.pausedConsumers.Has(ctx, chainID)
+	if err != nil {
+		panic(fmt.Errorf("failed to check whether consumer chain %s is paused: %w", chainID, err))
+	}
+	return paused
+}
+
+// DeleteConsumerPause unmarks the given consumer chain as paused
+func (k Keeper) DeleteConsumerPause(ctx sdk.Context, chainID string) {
+	if err := k.pausedConsumers.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete pause for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetAllPausedConsumers returns all the paused consumer chains, ordered by chain ID length and then by chain ID
+func (k Keeper) GetAllPausedConsumers(ctx sdk.Context) (paused []types.PausedConsumer) {
+	err := k.pausedConsumers.Walk(ctx, nil, func(chainID string, pause types.ConsumerPause) (bool, error) {
+		paused = append(paused, types.PausedConsumer{ChainId: chainID, Pause: pause})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate paused consumer chains: %w", err))
+	}
+	return paused
+}
+
+// PauseConsumerChain pauses a running consumer chain. While the chain is paused, the provider
+// sends it no VSC packets, ignores its slash packets and does not allocate its rewards.
+// The client, the keys and the rewards of the chain are kept.
+func (k Keeper) PauseConsumerChain(ctx sdk.Context, chainID, pausedBy string, emergency bool, reason string) error {
+	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+		return errorsmod.Wrapf(types.ErrUnknownConsumerChainId, "cannot pause consumer chain: %s", chainID)
+	}
+	if k.IsConsumerPaused(ctx, chainID) {
+		return errorsmod.Wrapf(types.ErrConsumerChainPaused, "cannot pause consumer chain: %s", chainID)
+	}
+
+	k.SetConsumerPause(ctx, chainID, types.ConsumerPause{
+		PausedBy:    pausedBy,
+		Emergency:   emergency,
+		Reason:      reason,
+		PauseHeight: ctx.BlockHeight(),
+		PauseTime:   ctx.BlockTime(),
+	})
+
+	k.Logger(ctx).Info("consumer chain paused", "chainID", chainID, "paused by", pausedBy, "emergency", emergency, "reason", reason)
+	k.emitTypedEvent(ctx, &types.EventConsumerPaused{
+		ChainId:   chainID,
+		PausedBy:  pausedBy,
+		Emergency: emergency,
+		Reason:    reason,
+	})
+	return nil
+}
+
+// ResumeConsumerChain resumes a paused consumer chain. The validator updates that were
+// held back while the chain was paused are sent with a catch-up VSC packet at the end
+// of the block, without waiting for the end of the epoch. The pruning of the previous
+// consumer addresses of the chain is delayed by the duration of the pause, since the
+// chain did not receive the key assignments made while it was paused.
+func (k Keeper) ResumeConsumerChain(ctx sdk.Context, chainID string) error {
+	pause, found := k.GetConsumerPause(ctx, chainID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrConsumerChainNotPaused, "cannot resume consumer chain: %s", chainID)
+	}
+	k.DeleteConsumerPause(ctx, chainID)
+	k.SetConsumerToCatchUp(ctx, chainID)
+	k.delayConsumerAddrsToPrune(ctx, chainID, ctx.BlockTime().Sub(pause.PauseTime))
+
+	k.Logger(ctx).Info("consumer chain resumed", "chainID", chainID, "pause height", pause.PauseHeight)
+	k.emitTypedEvent(ctx, &types.EventConsumerResumed{
+		ChainId:     chainID,
+		PauseHeight: pause.PauseHeight,
+	})
+	return nil
+}
+
+// SetConsumerToCatchUp marks the given consumer chain to be sent a catch-up VSC packet at the end of the block
+func (k Keeper) SetConsumerToCatchUp(ctx sdk.Context, chainID string) {
+	if err := k.consumersToCatchUp.Set(ctx, chainID); err != nil {
+		panic(fmt.Errorf("failed to mark consumer chain %s to catch up: %w", chainID, err))
+	}
+}
+
+// GetAllConsumersToCatchUp returns the consumer chains to be sent a catch-up VSC packet,
+// ordered by chain ID length and then by chain ID
+func (k Keeper) GetAllConsumersToCatchUp(ctx sdk.Context) (chainIDs []string) {
+	err := k.consumersToCatchUp.Walk(ctx, nil, func(chainID string) (bool, error) {
+		chainIDs = append(chainIDs, chainID)
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer chains to catch up: %w", err))
+	}
+	return chainIDs
+}
+
+// DeleteConsumerToCatchUp unmarks the given consumer chain to be sent a catch-up VSC packet
+func (k Keeper) DeleteConsumerToCatchUp(ctx sdk.Context, chainID string) {
+	if err := k.consumersToCatchUp.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to unmark consumer chain %s to catch up: %w", chainID, err))
+	}
+}
+
+// holdBackValidatorUpdates marks the given validators as dirty for a consumer chain that is paused
+// or not at the end of its epoch, so that their updates are sent with the next updates of the chain
+func (k Keeper) holdBackValidatorUpdates(ctx sdk.Context, chainID string, dirtyValidators []types.ProviderConsAddress) {
+	for _, providerAddr := range dirtyValidators {
+		k.SetDirtyConsumerValidator(ctx, chainID, providerAddr)
+	}
+}
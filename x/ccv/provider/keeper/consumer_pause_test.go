@@ -0,0 +1,359 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providerkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccv "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestConsumerPause(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.False(t, providerKeeper.IsConsumerPaused(ctx, "chainID"))
+	_, found := providerKeeper.GetConsumerPause(ctx, "chainID")
+	require.False(t, found)
+
+	pause := providertypes.ConsumerPause{
+		PausedBy:    sdk.AccAddress([]byte("emergency")).String(),
+		Emergency:   true,
+		Reason:      "exploit",
+		PauseHeight: 10,
+		PauseTime:   time.Unix(1000, 0).UTC(),
+	}
+	providerKeeper.SetConsumerPause(ctx, "chainID", pause)
+	providerKeeper.SetConsumerPause(ctx, "chain", providertypes.ConsumerPause{PauseTime: time.Unix(0, 0).UTC()})
+
+	require.True(t, providerKeeper.IsConsumerPaused(ctx, "chainID"))
+	got, found := providerKeeper.GetConsumerPause(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, pause, got)
+
+	// chain IDs are ordered by length first since they are stored with their length
+	paused := providerKeeper.GetAllPausedConsumers(ctx)
+	require.Len(t, paused, 2)
+	require.Equal(t, "chain", paused[0].ChainId)
+	require.Equal(t, providertypes.PausedConsumer{ChainId: "chainID", Pause: pause}, paused[1])
+
+	providerKeeper.DeleteConsumerPause(ctx, "chainID")
+	require.False(t, providerKeeper.IsConsumerPaused(ctx, "chainID"))
+	// deleting a missing entry is a no-op
+	providerKeeper.DeleteConsumerPause(ctx, "chainID")
+	require.Len(t, providerKeeper.GetAllPausedConsumers(ctx), 1)
+}
+
+func TestEmergencyPauseConsumer(t *testing.T) {
+	emergencyAuthority := sdk.AccAddress([]byte("emergency")).String()
+	other := sdk.AccAddress([]byte("other")).String()
+
+	// setup returns a keeper with a running consumer chain and an emergency authority
+	setup := func(t *testing.T) (providerkeeper.Keeper, sdk.Context, providertypes.MsgServer) {
+		t.Helper()
+		pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		t.Cleanup(ctrl.Finish)
+
+		params := providertypes.DefaultParams()
+		params.EmergencyAuthority = emergencyAuthority
+		pk.SetParams(ctx, params)
+		pk.SetConsumerClientId(ctx, "chain-1", "07-tendermint-0")
+
+		return pk, ctx, providerkeeper.NewMsgServerImpl(&pk)
+	}
+
+	t.Run("only the emergency authority can pause a chain", func(t *testing.T) {
+		pk, ctx, msgServer := setup(t)
+
+		_, err := msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(other, "chain-1", "exploit"))
+		require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+		// the emergency pause is disabled without an emergency authority
+		pk.SetParams(ctx, providertypes.DefaultParams())
+		_, err = msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(emergencyAuthority, "chain-1", "exploit"))
+		require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+		require.False(t, pk.IsConsumerPaused(ctx, "chain-1"))
+	})
+
+	t.Run("only running chains can be paused", func(t *testing.T) {
+		pk, ctx, msgServer := setup(t)
+
+		_, err := msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(emergencyAuthority, "chain-2", "exploit"))
+		require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+		_, err = msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(emergencyAuthority, "chain-1", "exploit"))
+		require.NoError(t, err)
+		_, err = msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(emergencyAuthority, "chain-1", "exploit"))
+		require.ErrorIs(t, err, providertypes.ErrConsumerChainPaused)
+
+		pause, found := pk.GetConsumerPause(ctx, "chain-1")
+		require.True(t, found)
+		require.Equal(t, emergencyAuthority, pause.PausedBy)
+		require.True(t, pause.Emergency)
+		require.Equal(t, "exploit", pause.Reason)
+	})
+
+	t.Run("only governance can resume a chain", func(t *testing.T) {
+		pk, ctx, msgServer := setup(t)
+
+		_, err := msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{Authority: pk.GetAuthority(), ChainId: "chain-1"})
+		require.ErrorIs(t, err, providertypes.ErrConsumerChainNotPaused)
+
+		_, err = msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(emergencyAuthority, "chain-1", "exploit"))
+		require.NoError(t, err)
+
+		_, err = msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{Authority: emergencyAuthority, ChainId: "chain-1"})
+		require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+		require.True(t, pk.IsConsumerPaused(ctx, "chain-1"))
+
+		_, err = msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{Authority: pk.GetAuthority(), ChainId: "chain-1"})
+		require.NoError(t, err)
+		require.False(t, pk.IsConsumerPaused(ctx, "chain-1"))
+	})
+
+	t.Run("stopping a paused chain removes its pause", func(t *testing.T) {
+		pk, ctx, msgServer := setup(t)
+
+		_, err := msgServer.EmergencyPauseConsumer(ctx, providertypes.NewMsgEmergencyPauseConsumer(emergencyAuthority, "chain-1", "exploit"))
+		require.NoError(t, err)
+		require.NoError(t, pk.StopConsumerChain(ctx, "chain-1", false))
+		require.False(t, pk.IsConsumerPaused(ctx, "chain-1"))
+	})
+}
+
+// TestPausedConsumerChain tests that the provider holds back the validator updates and
+// ignores the slash packets of a paused consumer chain
+func TestPausedConsumerChain(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	chainID := "chainID"
+	state := newStakingState(mocks, 3)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.SetChainToChannel(ctx, chainID, "channelID")
+	for i := range state.validators {
+		providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+	}
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1)
+
+	require.NoError(t, providerKeeper.PauseConsumerChain(ctx, chainID, "authority", true, "exploit"))
+
+	// the slash packets of the paused chain are acknowledged without being handled,
+	// i.e., without any call to the staking keeper
+	packetData := testkeeper.GetNewSlashPacketData()
+	packetData.Infraction = stakingtypes.Infraction_INFRACTION_DOWNTIME
+	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channelID", 1, packetData)
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+
+	// the updates of the paused chain are held back
+	state.powers[state.validators[1].GetOperator()] = 50
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(1))
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1)
+	require.Equal(t, []providertypes.ProviderConsAddress{state.providerAddr(1)},
+		providerKeeper.GetAllDirtyConsumerValidators(ctx, chainID))
+
+	// the held back updates are sent once the chain is resumed
+	require.NoError(t, providerKeeper.ResumeConsumerChain(ctx, chainID))
+	require.Equal(t, []string{chainID}, providerKeeper.GetAllConsumersToCatchUp(ctx))
+	providerKeeper.QueueVSCPackets(ctx)
+	pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+	require.Len(t, pending, 2)
+	require.Len(t, pending[1].ValidatorUpdates, 1)
+	require.Equal(t, int64(50), pending[1].ValidatorUpdates[0].Power)
+	// the chain got its updates at the end of the epoch, so no catch-up VSC packet is needed
+	require.Empty(t, providerKeeper.GetAllConsumersToCatchUp(ctx))
+}
+
+func TestPauseConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetConsumerClientId(ctx, "chain-1", "07-tendermint-0")
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	other := sdk.AccAddress([]byte("other")).String()
+	_, err := msgServer.PauseConsumer(ctx, &providertypes.MsgPauseConsumer{Authority: other, ChainId: "chain-1", Reason: "upgrade"})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	_, err = msgServer.PauseConsumer(ctx, &providertypes.MsgPauseConsumer{Authority: providerKeeper.GetAuthority(), ChainId: "chain-2", Reason: "upgrade"})
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	_, err = msgServer.PauseConsumer(ctx, &providertypes.MsgPauseConsumer{Authority: providerKeeper.GetAuthority(), ChainId: "chain-1", Reason: "upgrade"})
+	require.NoError(t, err)
+	pause, found := providerKeeper.GetConsumerPause(ctx, "chain-1")
+	require.True(t, found)
+	require.Equal(t, providerKeeper.GetAuthority(), pause.PausedBy)
+	require.False(t, pause.Emergency)
+	require.Equal(t, "upgrade", pause.Reason)
+
+	// the chain is kept, so that it can be resumed
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain-1")
+	require.True(t, found)
+	_, err = msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{Authority: providerKeeper.GetAuthority(), ChainId: "chain-1"})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsConsumerPaused(ctx, "chain-1"))
+}
+
+// TestSendCatchUpVSCPackets tests that a resumed consumer chain is sent its held back
+// validator updates at the end of the block, without waiting for the end of the epoch
+func TestSendCatchUpVSCPackets(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	state := newStakingState(mocks, 3)
+	for _, chainID := range []string{"paused", "running"} {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		for i := range state.validators {
+			providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+		}
+	}
+	providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, providerKeeper.PauseConsumerChain(ctx, "paused", "authority", false, "upgrade"))
+
+	// a validator changes during the pause
+	state.powers[state.validators[1].GetOperator()] = 50
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(1))
+	ctx = ctx.WithBlockHeight(10)
+	providerKeeper.EndBlockVSU(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "paused"), 1)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "running"), 2)
+
+	// nothing is sent in the middle of an epoch unless a chain is resumed
+	state.powers[state.validators[2].GetOperator()] = 60
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(2))
+	ctx = ctx.WithBlockHeight(11)
+	providerKeeper.EndBlockVSU(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "paused"), 1)
+
+	require.NoError(t, providerKeeper.ResumeConsumerChain(ctx, "paused"))
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	ctx = ctx.WithBlockHeight(12)
+	providerKeeper.EndBlockVSU(ctx)
+
+	// the catch-up VSC packet has a valset update id of its own
+	pending := providerKeeper.GetPendingVSCPackets(ctx, "paused")
+	require.Len(t, pending, 2)
+	require.Equal(t, valUpdateID, pending[1].ValsetUpdateId)
+	require.Len(t, pending[1].ValidatorUpdates, 2)
+	require.Equal(t, valUpdateID+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+	require.Empty(t, providerKeeper.GetAllConsumersToCatchUp(ctx))
+
+	// the other chains are updated at the end of the epoch
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "running"), 2)
+	require.Equal(t, []providertypes.ProviderConsAddress{state.providerAddr(2)}, providerKeeper.GetAllDirtyValidators(ctx))
+}
+
+// TestCatchUpVSCPacketAtEpochEnd tests that both the valset update id of the epoch and the one of
+// a catch-up VSC packet sent in the same block are mapped to a block height, so that the slash
+// packets referring to either of them are valid
+func TestCatchUpVSCPacketAtEpochEnd(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	state := newStakingState(mocks, 2)
+	for _, chainID := range []string{"paused", "running"} {
+		providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+		for i := range state.validators {
+			providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+		}
+	}
+	// the paused chain has epochs of its own, which do not end with the ones of the provider
+	providerKeeper.SetConsumerEpochParams(ctx, "paused", providertypes.ConsumerEpochParams{BlocksPerEpoch: 7})
+	providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, providerKeeper.PauseConsumerChain(ctx, "paused", "authority", false, "upgrade"))
+
+	state.powers[state.validators[1].GetOperator()] = 50
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(1))
+	require.NoError(t, providerKeeper.ResumeConsumerChain(ctx, "paused"))
+
+	// the chain is resumed in a block that ends the epoch of the other chain
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	ctx = ctx.WithBlockHeight(20)
+	providerKeeper.EndBlockCIS(ctx)
+	providerKeeper.EndBlockVSU(ctx)
+
+	running := providerKeeper.GetPendingVSCPackets(ctx, "running")
+	require.Equal(t, valUpdateID, running[len(running)-1].ValsetUpdateId)
+	paused := providerKeeper.GetPendingVSCPackets(ctx, "paused")
+	require.Equal(t, valUpdateID+1, paused[len(paused)-1].ValsetUpdateId)
+	require.Equal(t, valUpdateID+2, providerKeeper.GetValidatorSetUpdateId(ctx))
+
+	for _, vscID := range []uint64{valUpdateID, valUpdateID + 1} {
+		height, found := providerKeeper.GetValsetUpdateBlockHeight(ctx, vscID)
+		require.True(t, found)
+		require.Equal(t, uint64(21), height)
+	}
+	require.NoError(t, providerKeeper.ValidateSlashPacket(ctx, "paused", channeltypes.Packet{},
+		ccv.SlashPacketData{ValsetUpdateId: valUpdateID + 1}))
+}
+
+func TestPausedConsumerAddrsToPrune(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	start := time.Unix(10000, 0).UTC()
+	ctx = ctx.WithBlockTime(start)
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	consumerAddrs := []providertypes.ConsumerConsAddress{
+		providertypes.NewConsumerConsAddress([]byte("consumerAddr0")),
+		providertypes.NewConsumerConsAddress([]byte("consumerAddr1")),
+	}
+	isKept := func(i int) bool {
+		_, found := providerKeeper.GetValidatorByConsumerAddr(ctx, "chainID", consumerAddrs[i])
+		return found
+	}
+
+	// the old consumer addresses are due during and after the pause
+	for i, pruneTs := range []time.Time{start.Add(time.Hour), start.Add(3 * time.Hour)} {
+		providerKeeper.SetValidatorByConsumerAddr(ctx, "chainID", consumerAddrs[i], providerAddr)
+		providerKeeper.AppendConsumerAddrsToPrune(ctx, "chainID", pruneTs, consumerAddrs[i])
+	}
+	require.NoError(t, providerKeeper.PauseConsumerChain(ctx, "chainID", "gov", false, "upgrade"))
+
+	// the addresses of a paused chain are not pruned
+	ctx = ctx.WithBlockTime(start.Add(2 * time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	require.True(t, isKept(0))
+	require.True(t, isKept(1))
+
+	// once resumed, the pruning is delayed by the duration of the pause
+	require.NoError(t, providerKeeper.ResumeConsumerChain(ctx, "chainID"))
+	toPrune := providerKeeper.GetAllConsumerAddrsToPrune(ctx, "chainID")
+	require.Len(t, toPrune, 2)
+	require.Equal(t, start.Add(3*time.Hour), toPrune[0].PruneTs)
+	require.Equal(t, start.Add(5*time.Hour), toPrune[1].PruneTs)
+
+	providerKeeper.EndBlockCIS(ctx)
+	require.True(t, isKept(0))
+	require.True(t, isKept(1))
+
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	require.False(t, isKept(0))
+	require.True(t, isKept(1))
+
+	ctx = ctx.WithBlockTime(start.Add(5 * time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	require.False(t, isKept(1))
+	require.Empty(t, providerKeeper.GetAllConsumerAddrsToPrune(ctx, "chainID"))
+}
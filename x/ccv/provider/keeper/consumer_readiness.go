@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetReadinessSignal sets the readiness signal of a validator on a consumer chain
+func (k Keeper) SetReadinessSignal(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	signal types.ReadinessSignal,
+) {
+	if err := k.readinessSignals.Set(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()), signal); err != nil {
+		panic(fmt.Errorf("failed to set readiness signal: %w", err))
+	}
+}
+
+// GetReadinessSignal returns the readiness signal of a validator on a consumer chain, if it signalled its readiness
+func (k Keeper) GetReadinessSignal(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) (types.ReadinessSignal, bool) {
+	return mustGet(ctx, k.readinessSignals.Get, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+}
+
+// GetAllReadinessSignals returns the readiness signals of the validators on all consumer chains,
+// or only on chainID if it is not nil, ordered by chain ID length, chain ID and provider address
+func (k Keeper) GetAllReadinessSignals(ctx sdk.Context, chainID *string) (signals []types.ValidatorReadinessSignal) {
+	var ranger collections.Ranger[collections.Pair[string, sdk.ConsAddress]]
+	if chainID != nil {
+		ranger = collections.NewPrefixedPairRange[string, sdk.ConsAddress](*chainID)
+	}
+	err := k.readinessSignals.Walk(ctx, ranger,
+		func(key collections.Pair[string, sdk.ConsAddress], signal types.ReadinessSignal) (bool, error) {
+			signals = append(signals, types.ValidatorReadinessSignal{
+				ChainId:      key.K1(),
+				ProviderAddr: key.K2(),
+				Signal:       signal,
+			})
+			return false, nil
+		})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate readiness signals: %w", err))
+	}
+	return signals
+}
+
+// DeleteAllReadinessSignals deletes the readiness signals of all the validators on the given consumer chain
+func (k Keeper) DeleteAllReadinessSignals(ctx sdk.Context, chainID string) {
+	err := k.readinessSignals.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.ConsAddress](chainID))
+	if err != nil {
+		panic(fmt.Errorf("failed to delete readiness signals: %w", err))
+	}
+}
+
+// findPendingConsumerAdditionProp returns the pending consumer addition proposal of the given
+// consumer chain, if the chain is waiting for its launch
+func (k Keeper) findPendingConsumerAdditionProp(ctx sdk.Context, chainID string) (types.ConsumerAdditionProposal, bool) {
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId == chainID {
+			return prop, true
+		}
+	}
+	return types.ConsumerAdditionProposal{}, false
+}
+
+// ComputeExpectedInitialValSet returns the initial validator set that the consumer chain of the
+// given consumer addition proposal would have if it was launched at the current height
+func (k Keeper) ComputeExpectedInitialValSet(ctx sdk.Context, prop types.ConsumerAdditionProposal) ([]types.ConsumerValidator, error) {
+	// the power shaping parameters and the Top N validators are only set when the chain is launched
+	cachedCtx, _ := ctx.CacheContext()
+	k.setPowerShapingParams(cachedCtx, prop)
+
+	bondedValidators, err := k.GetLastBondedValidators(cachedCtx)
+	if err != nil {
+		return nil, err
+	}
+	if prop.Top_N > 0 {
+		minPower, err := k.ComputeMinPowerInTopN(cachedCtx, bondedValidators, prop.Top_N)
+		if err != nil {
+			return nil, err
+		}
+		k.OptInTopNValidators(cachedCtx, prop.ChainId, bondedValidators, minPower)
+	}
+	return k.ComputeNextValidators(cachedCtx, prop.ChainId, bondedValidators), nil
+}
+
+// isReadinessSignalValid returns whether a readiness signal was sent with the genesis and binary
+// hashes of the given consumer addition proposal
+func isReadinessSignalValid(signal types.ReadinessSignal, prop types.ConsumerAdditionProposal) bool {
+	return bytes.Equal(signal.GenesisHash, prop.GenesisHash) && bytes.Equal(signal.BinaryHash, prop.BinaryHash)
+}
+
+// HandleSignalConsumerReady records that a validator in the expected initial validator set of a
+// consumer chain that is waiting for its launch verified the genesis and binary hashes of the chain
+func (k Keeper) HandleSignalConsumerReady(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	genesisHash []byte,
+	binaryHash []byte,
+) error {
+	prop, found := k.findPendingConsumerAdditionProp(ctx, chainID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidReadinessSignal, "consumer chain %s is not waiting for its launch", chainID)
+	}
+
+	signal := types.ReadinessSignal{GenesisHash: genesisHash, BinaryHash: binaryHash, Height: ctx.BlockHeight()}
+	if !isReadinessSignalValid(signal, prop) {
+		return errorsmod.Wrapf(types.ErrInvalidReadinessSignal,
+			"genesis hash %X and binary hash %X do not match the hashes %X and %X of consumer chain %s",
+			genesisHash, binaryHash, prop.GenesisHash, prop.BinaryHash, chainID)
+	}
+
+	validators, err := k.ComputeExpectedInitialValSet(ctx, prop)
+	if err != nil {
+		return err
+	}
+	for _, v := range validators {
+		if bytes.Equal(v.ProviderConsAddr, providerAddr.ToSdkConsAddr()) {
+			k.SetReadinessSignal(ctx, chainID, providerAddr, signal)
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrInvalidReadinessSignal,
+		"validator %s is not in the expected initial validator set of consumer chain %s", providerAddr.String(), chainID)
+}
+
+// GetReadyPower returns the voting power of the given validators of a consumer chain held by the
+// validators that signalled their readiness with the hashes of the given consumer addition proposal,
+// and the total voting power of the validators
+func (k Keeper) GetReadyPower(
+	ctx sdk.Context,
+	prop types.ConsumerAdditionProposal,
+	validators []types.ConsumerValidator,
+) (readyPower, totalPower int64) {
+	for _, v := range validators {
+		totalPower += v.Power
+		signal, found := k.GetReadinessSignal(ctx, prop.ChainId, types.NewProviderConsAddress(v.ProviderConsAddr))
+		if found && isReadinessSignalValid(signal, prop) {
+			readyPower += v.Power
+		}
+	}
+	return readyPower, totalPower
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// TestSignalConsumerReady tests that only the validators in the expected initial validator set of a
+// consumer chain can signal their readiness, with the hashes of the consumer addition proposal, and
+// that the launch of the chain is postponed until enough voting power is ready
+func TestSignalConsumerReady(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(10)
+
+	// the validators have 1, 2, 3 and 4 of the 10 provider voting power
+	state := newStakingState(mocks, 4)
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(10), nil).AnyTimes()
+	for i := 0; i < 2; i++ {
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chain", clienttypes.NewHeight(4, 5))
+	}
+
+	err := providerKeeper.HandleSignalConsumerReady(ctx, "chain", state.providerAddr(1), []byte("gen_hash"), []byte("bin_hash"))
+	require.ErrorIs(t, err, providertypes.ErrInvalidReadinessSignal)
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.ChainId = "chain"
+	prop.SpawnTime = now.Add(time.Hour)
+	prop.LaunchThresholds = &providertypes.LaunchThresholds{
+		MinReadyPowerPercentage: 50,
+		PostponementInterval:    time.Hour,
+		MaxPostponements:        1,
+	}
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+	providerKeeper.SetOptedIn(ctx, "chain", state.providerAddr(1))
+	providerKeeper.SetOptedIn(ctx, "chain", state.providerAddr(3))
+
+	// a validator that is not opted in cannot signal its readiness
+	err = providerKeeper.HandleSignalConsumerReady(ctx, "chain", state.providerAddr(0), []byte("gen_hash"), []byte("bin_hash"))
+	require.ErrorIs(t, err, providertypes.ErrInvalidReadinessSignal)
+	// the hashes must be the ones of the proposal
+	err = providerKeeper.HandleSignalConsumerReady(ctx, "chain", state.providerAddr(1), []byte("gen_hash"), []byte("other_hash"))
+	require.ErrorIs(t, err, providertypes.ErrInvalidReadinessSignal)
+
+	require.NoError(t, providerKeeper.HandleSignalConsumerReady(ctx, "chain", state.providerAddr(1), []byte("gen_hash"), []byte("bin_hash")))
+
+	res, err := providerKeeper.QueryConsumerReadiness(ctx, &providertypes.QueryConsumerReadinessRequest{ChainId: "chain"})
+	require.NoError(t, err)
+	providerAddr1, providerAddr3 := state.providerAddr(1), state.providerAddr(3)
+	require.Equal(t, &providertypes.QueryConsumerReadinessResponse{
+		Validators: []*providertypes.ValidatorReadiness{
+			{ProviderAddress: providerAddr1.String(), Power: 2, Ready: true, SignalHeight: 10},
+			{ProviderAddress: providerAddr3.String(), Power: 4},
+		},
+		ReadyPower:              2,
+		TotalPower:              6,
+		MinReadyPowerPercentage: 50,
+	}, res)
+
+	// the launch is postponed as only a third of the voting power is ready
+	ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockInit(ctx)
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chain")
+	require.False(t, found)
+	postponed := launchEvents[*providertypes.EventConsumerLaunchPostponed](ctx)
+	require.Len(t, postponed, 1)
+	require.Contains(t, postponed[0].Reason, "have 2 of the 6 voting power")
+
+	require.NoError(t, providerKeeper.HandleSignalConsumerReady(ctx, "chain", state.providerAddr(3), []byte("gen_hash"), []byte("bin_hash")))
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	providerKeeper.BeginBlockInit(ctx)
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain")
+	require.True(t, found)
+
+	// the readiness signals are deleted once the chain is launched
+	require.Empty(t, providerKeeper.GetAllReadinessSignals(ctx, nil))
+	_, err = providerKeeper.QueryConsumerReadiness(ctx, &providertypes.QueryConsumerReadinessRequest{ChainId: "chain"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
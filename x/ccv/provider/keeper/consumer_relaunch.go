@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetStoppedConsumer records the launch parameters of a stopped consumer chain
+func (k Keeper) SetStoppedConsumer(ctx sdk.Context, stopped types.StoppedConsumer) {
+	chainID := stopped.LaunchParams.ChainId
+	if err := k.stoppedConsumers.Set(ctx, chainID, stopped); err != nil {
+		panic(fmt.Errorf("failed to set stopped consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetStoppedConsumer returns the launch parameters of the given stopped consumer chain, if any
+func (k Keeper) GetStoppedConsumer(ctx sdk.Context, chainID string) (types.StoppedConsumer, bool) {
+	return mustGet(ctx, k.stoppedConsumers.Get, chainID)
+}
+
+// DeleteStoppedConsumer deletes the launch parameters of the given stopped consumer chain
+func (k Keeper) DeleteStoppedConsumer(ctx sdk.Context, chainID string) {
+	if err := k.stoppedConsumers.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete stopped consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetAllStoppedConsumers returns all the stopped consumer chains, ordered by chain ID length and then by chain ID
+func (k Keeper) GetAllStoppedConsumers(ctx sdk.Context) (stopped []types.StoppedConsumer) {
+	err := k.stoppedConsumers.Walk(ctx, nil, func(_ string, sc types.StoppedConsumer) (bool, error) {
+		stopped = append(stopped, sc)
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate stopped consumer chains: %w", err))
+	}
+	return stopped
+}
+
+// isStoppedConsumerExpired returns true if the given consumer chain was stopped for longer than
+// the stopped consumer retention period, and thus can no longer be relaunched
+func (k Keeper) isStoppedConsumerExpired(ctx sdk.Context, stopped types.StoppedConsumer) bool {
+	return !ctx.BlockTime().Before(stopped.StopTime.Add(k.GetStoppedConsumerRetentionPeriod(ctx)))
+}
+
+// pruneStoppedConsumers deletes the records of the consumer chains that can no longer be relaunched
+func (k Keeper) pruneStoppedConsumers(ctx sdk.Context) {
+	for _, stopped := range k.GetAllStoppedConsumers(ctx) {
+		if k.isStoppedConsumerExpired(ctx, stopped) {
+			k.DeleteStoppedConsumer(ctx, stopped.LaunchParams.ChainId)
+		}
+	}
+}
+
+// recordStoppedConsumer records the parameters the given consumer chain was launched with,
+// as they are found in its consumer genesis and in the provider state, so that the chain can
+// later be relaunched. It must be called before the state of the chain is cleaned up.
+// The records of the chains stopped for longer than the stopped consumer retention period are
+// pruned first, and no record is made if the retention period is zero.
+func (k Keeper) recordStoppedConsumer(ctx sdk.Context, chainID, clientID string) {
+	k.pruneStoppedConsumers(ctx)
+	if k.GetStoppedConsumerRetentionPeriod(ctx) == 0 {
+		return
+	}
+
+	gen, found := k.GetConsumerGenesis(ctx, chainID)
+	if !found {
+		return
+	}
+
+	prop := types.ConsumerAdditionProposal{
+		ChainId:                           chainID,
+		UnbondingPeriod:                   gen.Params.UnbondingPeriod,
+		CcvTimeoutPeriod:                  gen.Params.CcvTimeoutPeriod,
+		TransferTimeoutPeriod:             gen.Params.TransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    gen.Params.ConsumerRedistributionFraction,
+		BlocksPerDistributionTransmission: gen.Params.BlocksPerDistributionTransmission,
+		HistoricalEntries:                 gen.Params.HistoricalEntries,
+		DistributionTransmissionChannel:   gen.Params.DistributionTransmissionChannel,
+		AllowedKeyTypes:                   gen.Params.AllowedKeyTypes,
+	}
+	prop.Top_N, _ = k.GetTopN(ctx, chainID)
+	prop.ValidatorsPowerCap, _ = k.GetValidatorsPowerCap(ctx, chainID)
+	prop.ValidatorSetCap, _ = k.GetValidatorSetCap(ctx, chainID)
+	for _, addr := range k.GetAllowList(ctx, chainID) {
+		prop.Allowlist = append(prop.Allowlist, addr.String())
+	}
+	for _, addr := range k.GetDenyList(ctx, chainID) {
+		prop.Denylist = append(prop.Denylist, addr.String())
+	}
+	if metadata, found := k.GetConsumerMetadata(ctx, chainID); found {
+		prop.Metadata = &metadata
+	}
+	if owner, found := k.GetConsumerOwner(ctx, chainID); found {
+		prop.Owner = owner.String()
+	}
+	if policy, found := mustGet(ctx, k.optInPolicies.Get, chainID); found {
+		prop.OptInPolicy = &policy
+	}
+	if params, found := k.GetConsumerEpochParams(ctx, chainID); found {
+		prop.EpochParams = &params
+	}
+
+	k.SetStoppedConsumer(ctx, types.StoppedConsumer{
+		LaunchParams: prop,
+		ClientId:     clientID,
+		StopTime:     ctx.BlockTime(),
+	})
+}
+
+// HandleConsumerRelaunchProposal relaunches a stopped consumer chain from its exported state.
+// The chain is launched again at the given spawn time with the parameters it was last launched
+// with, and a new consumer genesis is made at spawn time with the current validator set and
+// assigned consumer keys. The consumer keys assigned before the stop were deleted with the chain
+// state, so validators must assign them again before the spawn time to keep using them.
+// The new initial height must be greater than the last height of the stopped chain that is
+// known to the provider, and the chain must have been stopped within the stopped consumer
+// retention period.
+func (k Keeper) HandleConsumerRelaunchProposal(ctx sdk.Context, msg *types.MsgConsumerRelaunch) error {
+	stopped, found := k.GetStoppedConsumer(ctx, msg.ChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownConsumerChainId, "cannot relaunch consumer chain that was not stopped: %s", msg.ChainId)
+	}
+	if k.isStoppedConsumerExpired(ctx, stopped) {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRelaunch,
+			"consumer chain %s was stopped at %s, more than the stopped consumer retention period ago",
+			msg.ChainId, stopped.StopTime)
+	}
+	if k.isConsumerPendingOrRegistered(ctx, msg.ChainId) {
+		return errorsmod.Wrapf(types.ErrDuplicateConsumerChain, "cannot relaunch consumer chain: %s", msg.ChainId)
+	}
+
+	if clientState, found := k.clientKeeper.GetClientState(ctx, stopped.ClientId); found {
+		if tmClientState, ok := clientState.(*ibctmtypes.ClientState); ok && !msg.InitialHeight.GT(tmClientState.LatestHeight) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerRelaunch,
+				"initial height %s must be greater than the last height %s of the stopped consumer chain",
+				msg.InitialHeight, tmClientState.LatestHeight)
+		}
+	}
+
+	prop := stopped.LaunchParams
+	prop.InitialHeight = msg.InitialHeight
+	prop.GenesisHash = msg.GenesisHash
+	prop.BinaryHash = msg.BinaryHash
+	prop.SpawnTime = msg.SpawnTime
+	// the relaunched chain gets a new client and connection
+	prop.ConnectionId = ""
+	prop.Relaunch = true
+
+	return k.HandleLegacyConsumerAdditionProposal(ctx, &prop)
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestStoppedConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetStoppedConsumer(ctx, "chainID")
+	require.False(t, found)
+
+	stopped := providertypes.StoppedConsumer{
+		LaunchParams: providertypes.ConsumerAdditionProposal{ChainId: "chainID", Top_N: 50},
+		ClientId:     "07-tendermint-0",
+		StopTime:     time.Unix(1000, 0).UTC(),
+	}
+	providerKeeper.SetStoppedConsumer(ctx, stopped)
+	providerKeeper.SetStoppedConsumer(ctx, providertypes.StoppedConsumer{
+		LaunchParams: providertypes.ConsumerAdditionProposal{ChainId: "chain"},
+		StopTime:     time.Unix(0, 0).UTC(),
+	})
+
+	got, found := providerKeeper.GetStoppedConsumer(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, stopped, got)
+
+	all := providerKeeper.GetAllStoppedConsumers(ctx)
+	require.Len(t, all, 2)
+	require.Equal(t, "chain", all[0].LaunchParams.ChainId)
+	require.Equal(t, stopped, all[1])
+
+	providerKeeper.DeleteStoppedConsumer(ctx, "chainID")
+	_, found = providerKeeper.GetStoppedConsumer(ctx, "chainID")
+	require.False(t, found)
+	// deleting a chain that is not stopped is a no-op
+	providerKeeper.DeleteStoppedConsumer(ctx, "chainID")
+}
+
+func TestHandleConsumerRelaunchProposal(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	msg := &providertypes.MsgConsumerRelaunch{
+		Authority:     providerKeeper.GetAuthority(),
+		ChainId:       "chainID",
+		InitialHeight: clienttypes.NewHeight(0, 101),
+		GenesisHash:   []byte("new_gen_hash"),
+		BinaryHash:    []byte("new_bin_hash"),
+		SpawnTime:     time.Unix(2000, 0).UTC(),
+	}
+
+	// a chain that was never stopped cannot be relaunched
+	require.Error(t, providerKeeper.HandleConsumerRelaunchProposal(ctx, msg))
+
+	// launch and stop the chain
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	gomock.InOrder(testkeeper.GetMocksForStopConsumerChainWithCloseChannel(ctx, &mocks)...)
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+
+	launchProp := testkeeper.GetTestConsumerAdditionProp()
+	stopped, found := providerKeeper.GetStoppedConsumer(ctx, "chainID")
+	require.True(t, found)
+	require.Equal(t, "clientID", stopped.ClientId)
+	require.Equal(t, ctx.BlockTime(), stopped.StopTime)
+	require.Equal(t, launchProp.UnbondingPeriod, stopped.LaunchParams.UnbondingPeriod)
+	require.Equal(t, launchProp.CcvTimeoutPeriod, stopped.LaunchParams.CcvTimeoutPeriod)
+	require.Equal(t, launchProp.BlocksPerDistributionTransmission, stopped.LaunchParams.BlocksPerDistributionTransmission)
+	require.Equal(t, "chain", stopped.LaunchParams.Metadata.Name)
+	require.Equal(t, sdk.AccAddress([]byte("owner")).String(), stopped.LaunchParams.Owner)
+
+	// the initial height must be greater than the last height of the stopped chain
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+		&ibctmtypes.ClientState{LatestHeight: clienttypes.NewHeight(0, 101)}, true,
+	).Times(1)
+	err := providerKeeper.HandleConsumerRelaunchProposal(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerRelaunch)
+
+	// relaunch the chain
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+		&ibctmtypes.ClientState{LatestHeight: clienttypes.NewHeight(0, 100)}, true,
+	).Times(1)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{}, []int64{}, 1)
+	gomock.InOrder(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", msg.InitialHeight)...)
+	require.NoError(t, providerKeeper.HandleConsumerRelaunchProposal(ctx, msg))
+
+	prop, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, msg.SpawnTime, "chainID")
+	require.True(t, found)
+	require.True(t, prop.Relaunch)
+	require.Equal(t, msg.InitialHeight, prop.InitialHeight)
+	require.Equal(t, msg.GenesisHash, prop.GenesisHash)
+	require.Equal(t, msg.BinaryHash, prop.BinaryHash)
+	require.Equal(t, stopped.LaunchParams.UnbondingPeriod, prop.UnbondingPeriod)
+	_, found = providerKeeper.GetConsumerMetadata(ctx, "chainID")
+	require.True(t, found)
+
+	// a pending relaunch cannot be proposed twice
+	require.Error(t, providerKeeper.HandleConsumerRelaunchProposal(ctx, msg))
+
+	// the relaunched chain gets a valid client, a relaunch consumer genesis and is no longer stopped
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{}, []int64{}, 1)
+	gomock.InOrder(append(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour),
+		mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), ibcexported.Tendermint, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ sdk.Context, _ string, clientStateBz, _ []byte) (string, error) {
+				var clientState ibctmtypes.ClientState
+				require.NoError(t, clientState.Unmarshal(clientStateBz))
+				require.Equal(t, "chainID", clientState.ChainId)
+				require.Equal(t, msg.InitialHeight, clientState.LatestHeight)
+				return "clientID", clientState.Validate()
+			},
+		).Times(1),
+	)...)
+	require.NoError(t, providerKeeper.CreateConsumerClient(ctx, &prop))
+	gen, found := providerKeeper.GetConsumerGenesis(ctx, "chainID")
+	require.True(t, found)
+	require.True(t, gen.Relaunch)
+	require.True(t, gen.NewChain)
+	_, found = providerKeeper.GetStoppedConsumer(ctx, "chainID")
+	require.False(t, found)
+}
+
+func TestStoppedConsumerRetentionPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.StoppedConsumerRetentionPeriod = time.Hour
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(time.Unix(10000, 0).UTC())
+
+	msg := &providertypes.MsgConsumerRelaunch{
+		Authority:     providerKeeper.GetAuthority(),
+		ChainId:       "chainID",
+		InitialHeight: clienttypes.NewHeight(0, 101),
+		GenesisHash:   []byte("new_gen_hash"),
+		BinaryHash:    []byte("new_bin_hash"),
+		SpawnTime:     ctx.BlockTime().Add(2 * time.Hour),
+	}
+
+	// records of chains stopped more than the retention period ago are pruned when a chain is stopped
+	providerKeeper.SetStoppedConsumer(ctx, providertypes.StoppedConsumer{
+		LaunchParams: providertypes.ConsumerAdditionProposal{ChainId: "expired"},
+		StopTime:     ctx.BlockTime().Add(-time.Hour),
+	})
+	providerKeeper.SetStoppedConsumer(ctx, providertypes.StoppedConsumer{
+		LaunchParams: providertypes.ConsumerAdditionProposal{ChainId: "recent"},
+		StopTime:     ctx.BlockTime().Add(-time.Minute),
+	})
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	gomock.InOrder(testkeeper.GetMocksForStopConsumerChainWithCloseChannel(ctx, &mocks)...)
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+	_, found := providerKeeper.GetStoppedConsumer(ctx, "expired")
+	require.False(t, found)
+	_, found = providerKeeper.GetStoppedConsumer(ctx, "recent")
+	require.True(t, found)
+	_, found = providerKeeper.GetStoppedConsumer(ctx, "chainID")
+	require.True(t, found)
+
+	// a chain stopped more than the retention period ago cannot be relaunched
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	err := providerKeeper.HandleConsumerRelaunchProposal(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerRelaunch)
+
+	// a zero retention period disables the records of stopped chains
+	params.StoppedConsumerRetentionPeriod = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.DeleteStoppedConsumer(ctx, "chainID")
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	gomock.InOrder(testkeeper.GetMocksForStopConsumerChainWithCloseChannel(ctx, &mocks)...)
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+	require.Empty(t, providerKeeper.GetAllStoppedConsumers(ctx))
+}
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
-	storetypes "cosmossdk.io/store/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
@@ -32,34 +34,39 @@ func (k Keeper) SetConsumerRewardDenom(
 	ctx sdk.Context,
 	denom string,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ConsumerRewardDenomsKey(denom), []byte{})
+	if err := k.consumerRewardDenoms.Set(ctx, denom); err != nil {
+		panic(fmt.Errorf("failed to set consumer reward denom: %w", err))
+	}
 }
 
 func (k Keeper) ConsumerRewardDenomExists(
 	ctx sdk.Context,
 	denom string,
 ) bool {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ConsumerRewardDenomsKey(denom))
-	return bz != nil
+	found, err := k.consumerRewardDenoms.Has(ctx, denom)
+	if err != nil {
+		panic(fmt.Errorf("failed to check consumer reward denom: %w", err))
+	}
+	return found
 }
 
 func (k Keeper) DeleteConsumerRewardDenom(
 	ctx sdk.Context,
 	denom string,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ConsumerRewardDenomsKey(denom))
+	if err := k.consumerRewardDenoms.Remove(ctx, denom); err != nil {
+		panic(fmt.Errorf("failed to delete consumer reward denom: %w", err))
+	}
 }
 
 func (k Keeper) GetAllConsumerRewardDenoms(ctx sdk.Context) (consumerRewardDenoms []string) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ConsumerRewardDenomsBytePrefix})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()[1:]
-		consumerRewardDenoms = append(consumerRewardDenoms, string(key))
+	iterator, err := k.consumerRewardDenoms.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer reward denoms: %w", err))
+	}
+	consumerRewardDenoms, err = iterator.Keys()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse consumer reward denoms: %w", err))
 	}
 
 	return consumerRewardDenoms
@@ -75,6 +82,10 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 
 	// Iterate over all registered consumer chains
 	for _, consumerChainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+		// the rewards of a paused chain are kept until the chain is resumed
+		if k.IsConsumerPaused(ctx, consumerChainID) {
+			continue
+		}
 
 		// note that it's possible that no rewards are collected even though the
 		// reward pool isn't empty. This can happen if the reward pool holds some tokens
@@ -101,6 +112,12 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 			alloc.Rewards = rewardsChange
 			k.SetConsumerRewardsAllocation(ctx, consumerChainID, alloc)
 
+			incrRewardsAllocatedCounters(consumerChainID, sdk.NewDecCoinsFromCoins(rewardsToSend...))
+			k.emitTypedEvent(ctx, &types.EventConsumerRewardsAllocated{
+				ChainId:              consumerChainID,
+				CommunityPoolRewards: sdk.NewDecCoinsFromCoins(rewardsToSend...),
+			})
+
 			return
 		}
 
@@ -159,16 +176,35 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 		// set consumer allocations to the remaining rewards decimals
 		alloc.Rewards = validatorsRewardsChange.Add(remainingChanges...)
 		k.SetConsumerRewardsAllocation(ctx, consumerChainID, alloc)
+
+		incrRewardsAllocatedCounters(consumerChainID, sdk.NewDecCoinsFromCoins(validatorsRewardsTrunc.Add(remainingRewards...)...))
+		k.emitTypedEvent(ctx, &types.EventConsumerRewardsAllocated{
+			ChainId:              consumerChainID,
+			ValidatorsRewards:    sdk.NewDecCoinsFromCoins(validatorsRewardsTrunc...),
+			CommunityPoolRewards: sdk.NewDecCoinsFromCoins(remainingRewards...),
+		})
 	}
 }
 
-// IsEligibleForConsumerRewards returns `true` if the validator with `consumerValidatorHeight` has been a consumer
-// validator for a long period of time and hence is eligible to receive rewards, and false otherwise
-func (k Keeper) IsEligibleForConsumerRewards(ctx sdk.Context, consumerValidatorHeight int64) bool {
-	numberOfBlocksToStartReceivingRewards := k.GetNumberOfEpochsToStartReceivingRewards(ctx) * k.GetBlocksPerEpoch(ctx)
+// IsEligibleForConsumerRewards returns `true` if the given consumer validator has been a consumer validator
+// of consumer chain `chainID` for a long period of time and hence is eligible to receive rewards, and false
+// otherwise. The period is measured in epochs of the consumer chain.
+func (k Keeper) IsEligibleForConsumerRewards(ctx sdk.Context, chainID string, consumerValidator types.ConsumerValidator) bool {
+	if k.IsTimeBasedEpoch(ctx, chainID) {
+		// the validators that joined before their join time was recorded have a zero join time
+		return !ctx.BlockTime().Before(k.GetRewardsEligibilityTime(ctx, consumerValidator))
+	}
+
+	numberOfBlocksToStartReceivingRewards := k.GetNumberOfEpochsToStartReceivingRewards(ctx) * k.GetConsumerBlocksPerEpoch(ctx, chainID)
 
 	// a validator is eligible for rewards if it has been a consumer validator for `NumberOfEpochsToStartReceivingRewards` epochs
-	return (ctx.BlockHeight() - consumerValidatorHeight) >= numberOfBlocksToStartReceivingRewards
+	return (ctx.BlockHeight() - consumerValidator.JoinHeight) >= numberOfBlocksToStartReceivingRewards
+}
+
+// GetRewardsEligibilityTime returns the block time from which the given consumer validator is eligible
+// to receive rewards, when the epochs are based on block time
+func (k Keeper) GetRewardsEligibilityTime(ctx sdk.Context, consumerValidator types.ConsumerValidator) time.Time {
+	return consumerValidator.JoinTime.Add(time.Duration(k.GetNumberOfEpochsToStartReceivingRewards(ctx)) * k.GetEpochDuration(ctx))
 }
 
 // AllocateTokensToConsumerValidators allocates tokens
@@ -192,7 +228,7 @@ func (k Keeper) AllocateTokensToConsumerValidators(
 	// Allocate tokens by iterating over the consumer validators
 	for _, consumerVal := range k.GetConsumerValSet(ctx, chainID) {
 		// if a validator is not eligible, this means that the other eligible validators would get more rewards
-		if !k.IsEligibleForConsumerRewards(ctx, consumerVal.JoinHeight) {
+		if !k.IsEligibleForConsumerRewards(ctx, chainID, consumerVal) {
 			continue
 		}
 
@@ -255,6 +291,22 @@ func (k Keeper) SetConsumerRewardsAllocation(ctx sdk.Context, chainID string, po
 	store.Set(types.ConsumerRewardsAllocationKey(chainID), b)
 }
 
+// GetTotalConsumerRewardsAllocation returns the sum of the consumer rewards allocations of all
+// consumer chains, including the allocations that are left over by consumer chains that were stopped
+func (k Keeper) GetTotalConsumerRewardsAllocation(ctx sdk.Context) (total sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ConsumerRewardsAllocationBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var alloc types.ConsumerRewardsAllocation
+		k.cdc.MustUnmarshal(iterator.Value(), &alloc)
+		total = total.Add(alloc.Rewards...)
+	}
+
+	return total
+}
+
 // GetConsumerRewardsPool returns the balance
 // of the consumer rewards pool module account
 func (k Keeper) GetConsumerRewardsPool(ctx sdk.Context) sdk.Coins {
@@ -271,7 +323,7 @@ func (k Keeper) ComputeConsumerTotalVotingPower(ctx sdk.Context, chainID string)
 	for _, v := range k.GetConsumerValSet(ctx, chainID) {
 
 		// only consider the voting power of a validator that would receive rewards (i.e., validator has been validating for a number of blocks)
-		if !k.IsEligibleForConsumerRewards(ctx, v.JoinHeight) {
+		if !k.IsEligibleForConsumerRewards(ctx, chainID, v) {
 			continue
 		}
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -291,9 +292,28 @@ func TestIsEligibleForConsumerRewards(t *testing.T) {
 
 	numberOfBlocks := params.NumberOfEpochsToStartReceivingRewards * params.BlocksPerEpoch
 
-	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks-1), 0))
-	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks), 0))
-	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks+1), 0))
-	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks+1), 1))
-	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks+1), 2))
+	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks-1), "chainID", providertypes.ConsumerValidator{JoinHeight: 0}))
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks), "chainID", providertypes.ConsumerValidator{JoinHeight: 0}))
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks+1), "chainID", providertypes.ConsumerValidator{JoinHeight: 0}))
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks+1), "chainID", providertypes.ConsumerValidator{JoinHeight: 1}))
+	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks+1), "chainID", providertypes.ConsumerValidator{JoinHeight: 2}))
+
+	// the epochs of a consumer chain with an epoch length of its own are counted instead
+	keeper.SetConsumerEpochParams(ctx, "chainID", providertypes.ConsumerEpochParams{BlocksPerEpoch: 2})
+	numberOfBlocks = params.NumberOfEpochsToStartReceivingRewards * 2
+	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks-1), "chainID", providertypes.ConsumerValidator{JoinHeight: 0}))
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks), "chainID", providertypes.ConsumerValidator{JoinHeight: 0}))
+	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks), "otherChainID", providertypes.ConsumerValidator{JoinHeight: 0}))
+
+	// with epochs based on block time, the time elapsed since the validator joined is counted instead
+	params.EpochDuration = time.Hour
+	keeper.SetParams(ctx, params)
+	joinTime := time.Now().UTC()
+	validator := providertypes.ConsumerValidator{JoinTime: joinTime}
+	require.False(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockTime(joinTime.Add(10*time.Hour-time.Second)), "otherChainID", validator))
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockTime(joinTime.Add(10*time.Hour)), "otherChainID", validator))
+	// the validators that joined before their join time was recorded are eligible
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockTime(joinTime), "otherChainID", providertypes.ConsumerValidator{}))
+	// a consumer chain with an epoch length of its own keeps its epochs of blocks
+	require.True(t, keeper.IsEligibleForConsumerRewards(ctx.WithBlockHeight(numberOfBlocks).WithBlockTime(joinTime), "chainID", validator))
 }
@@ -39,7 +39,6 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		}
 		// check if the CCV channel was established
 		if cs.ChannelId != "" {
-			k.SetChannelToChain(ctx, cs.ChannelId, chainID)
 			k.SetChainToChannel(ctx, chainID, cs.ChannelId)
 			k.SetInitChainHeight(ctx, chainID, cs.InitialHeight)
 			k.SetSlashAcks(ctx, cs.ChainId, cs.SlashDowntimeAck)
@@ -67,6 +66,58 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		}
 	}
 
+	for _, item := range genState.ConsumerMetadata {
+		k.SetConsumerMetadata(ctx, item.ChainId, item.Metadata)
+	}
+
+	for _, item := range genState.ConsumerOwners {
+		owner, err := sdk.AccAddressFromBech32(item.Owner)
+		if err != nil {
+			panic(fmt.Errorf("invalid owner %s for consumer chain %s: %w", item.Owner, item.ChainId, err))
+		}
+		k.SetConsumerOwner(ctx, item.ChainId, owner)
+	}
+
+	for _, item := range genState.PausedConsumers {
+		k.SetConsumerPause(ctx, item.ChainId, item.Pause)
+	}
+
+	for _, item := range genState.StoppedConsumers {
+		k.SetStoppedConsumer(ctx, item)
+	}
+
+	for _, item := range genState.KeyAssignmentNonces {
+		k.SetKeyAssignmentNonce(ctx, item.ChainId, types.NewProviderConsAddress(item.ProviderAddr), item.Nonce)
+	}
+
+	for _, item := range genState.ConsumerKeyRotations {
+		k.SetConsumerKeyRotation(ctx, item.ChainId, types.NewProviderConsAddress(item.ProviderAddr), item.Rotation)
+	}
+
+	for _, item := range genState.KeyAssignmentHistory {
+		k.SetKeyAssignmentRecord(ctx, item.ChainId, types.NewProviderConsAddress(item.ProviderAddr), item.Index, item.Record)
+	}
+
+	for _, item := range genState.OptInPolicies {
+		k.SetOptInPolicy(ctx, item.ChainId, item.Policy)
+	}
+
+	for _, item := range genState.OptInRecords {
+		k.SetOptInRecord(ctx, item.ChainId, types.NewProviderConsAddress(item.ProviderAddr), item.Record)
+	}
+
+	for _, item := range genState.ReadinessSignals {
+		k.SetReadinessSignal(ctx, item.ChainId, types.NewProviderConsAddress(item.ProviderAddr), item.Signal)
+	}
+
+	for _, item := range genState.ConsumerEpochParams {
+		k.SetConsumerEpochParams(ctx, item.ChainId, item.Params)
+	}
+
+	if genState.EpochState != nil {
+		k.SetEpochState(ctx, *genState.EpochState)
+	}
+
 	k.SetParams(ctx, genState.Params)
 	k.InitializeSlashMeter(ctx)
 }
@@ -118,7 +169,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	params := k.GetParams(ctx)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -129,4 +180,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	gs.ConsumerMetadata = k.GetAllConsumerMetadata(ctx)
+	gs.ConsumerOwners = k.GetAllConsumerOwners(ctx)
+	gs.PausedConsumers = k.GetAllPausedConsumers(ctx)
+	gs.StoppedConsumers = k.GetAllStoppedConsumers(ctx)
+	gs.KeyAssignmentNonces = k.GetAllKeyAssignmentNonces(ctx)
+	gs.ConsumerKeyRotations = k.GetAllConsumerKeyRotations(ctx, nil)
+	gs.KeyAssignmentHistory = k.GetAllKeyAssignmentHistory(ctx)
+	gs.OptInPolicies = k.GetAllOptInPolicies(ctx)
+	gs.OptInRecords = k.GetAllOptInRecords(ctx, nil)
+	gs.ReadinessSignals = k.GetAllReadinessSignals(ctx, nil)
+	gs.ConsumerEpochParams = k.GetAllConsumerEpochParams(ctx)
+	if state, found := k.GetEpochState(ctx); found {
+		gs.EpochState = &state
+	}
+
+	return gs
 }
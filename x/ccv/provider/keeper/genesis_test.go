@@ -25,6 +25,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	oneHourFromNow := time.Now().UTC().Add(time.Hour)
 	initHeight, vscID := uint64(5), uint64(1)
 	params := providertypes.DefaultParams()
+	params.EpochDuration = 6 * time.Hour
 
 	// create validator keys and addresses for key assignment
 	providerCryptoId := crypto.NewCryptoIdentityFromIntSeed(7896)
@@ -88,6 +89,71 @@ func TestInitAndExportGenesis(t *testing.T) {
 			},
 		},
 	)
+	provGenesis.ConsumerMetadata = []providertypes.ConsumerChainMetadata{
+		{ChainId: cChainIDs[0], Metadata: providertypes.ConsumerMetadata{Name: "c0", Website: "https://c0.zone"}},
+		{ChainId: cChainIDs[1], Metadata: providertypes.ConsumerMetadata{Name: "c1"}},
+	}
+	provGenesis.ConsumerOwners = []providertypes.ConsumerOwner{
+		{ChainId: cChainIDs[0], Owner: sdk.AccAddress([]byte("owner")).String()},
+	}
+	provGenesis.PausedConsumers = []providertypes.PausedConsumer{
+		{ChainId: cChainIDs[1], Pause: providertypes.ConsumerPause{
+			PausedBy:    sdk.AccAddress([]byte("emergency")).String(),
+			Emergency:   true,
+			Reason:      "exploit",
+			PauseHeight: 10,
+			PauseTime:   time.Unix(1000, 0).UTC(),
+		}},
+	}
+	provGenesis.StoppedConsumers = []providertypes.StoppedConsumer{
+		{
+			LaunchParams: providertypes.ConsumerAdditionProposal{ChainId: "stopped", Top_N: 95},
+			ClientId:     "07-tendermint-9",
+			StopTime:     time.Unix(2000, 0).UTC(),
+		},
+	}
+	provGenesis.KeyAssignmentNonces = []providertypes.KeyAssignmentNonce{
+		{ChainId: cChainIDs[0], ProviderAddr: provAddr.ToSdkConsAddr(), Nonce: 3},
+	}
+	provGenesis.ConsumerKeyRotations = []providertypes.ScheduledConsumerKeyRotation{
+		{
+			ChainId:      cChainIDs[1],
+			ProviderAddr: provAddr.ToSdkConsAddr(),
+			Rotation: providertypes.ConsumerKeyRotation{
+				ConsumerKey: crypto.NewCryptoIdentityFromIntSeed(7898).TMProtoCryptoPublicKey(),
+				ApplyHeight: 1200,
+			},
+		},
+	}
+	provGenesis.KeyAssignmentHistory = []providertypes.KeyAssignmentHistoryEntry{
+		{
+			ChainId:      cChainIDs[0],
+			ProviderAddr: provAddr.ToSdkConsAddr(),
+			Index:        4,
+			Record: providertypes.KeyAssignmentRecord{
+				ConsumerKey:    consumerTmPubKey,
+				AssignedHeight: 100,
+				EffectiveVscId: 2,
+			},
+		},
+	}
+	provGenesis.OptInPolicies = []providertypes.ConsumerOptInPolicy{
+		{ChainId: cChainIDs[0], Policy: providertypes.OptInPolicy{MinCommitmentEpochs: 4, OptInCooldownEpochs: 2}},
+	}
+	provGenesis.OptInRecords = []providertypes.ValidatorOptInRecord{
+		{ChainId: cChainIDs[0], ProviderAddr: provAddr.ToSdkConsAddr(), Record: providertypes.OptInRecord{OptInHeight: 120}},
+	}
+	provGenesis.ReadinessSignals = []providertypes.ValidatorReadinessSignal{
+		{
+			ChainId:      cChainIDs[1],
+			ProviderAddr: provAddr.ToSdkConsAddr(),
+			Signal:       providertypes.ReadinessSignal{GenesisHash: []byte("gen_hash"), BinaryHash: []byte("bin_hash"), Height: 110},
+		},
+	}
+	provGenesis.ConsumerEpochParams = []providertypes.ChainEpochParams{
+		{ChainId: cChainIDs[0], Params: providertypes.ConsumerEpochParams{BlocksPerEpoch: 300}},
+	}
+	provGenesis.EpochState = &providertypes.EpochState{Number: 12, StartHeight: 1300, StartTime: time.Unix(3000, 0).UTC()}
 
 	// Instantiate in-mem provider keeper with mocks
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -138,6 +204,40 @@ func TestInitAndExportGenesis(t *testing.T) {
 	// Expect same list as what was provided in provGenesis
 	expectedAddrList := providertypes.AddressList{Addresses: [][]byte{consumerConsAddr.ToSdkConsAddr()}}
 	require.Equal(t, expectedAddrList, addrs)
+	// the cross-chain prune time index is rebuilt from the genesis entries
+	require.Equal(t, []string{cChainIDs[0]}, pk.GetConsumerChainsWithAddrsToPrune(ctx, oneHourFromNow))
+
+	metadata, found := pk.GetConsumerMetadata(ctx, cChainIDs[1])
+	require.True(t, found)
+	require.Equal(t, provGenesis.ConsumerMetadata[1].Metadata, metadata)
+	owner, found := pk.GetConsumerOwner(ctx, cChainIDs[0])
+	require.True(t, found)
+	require.Equal(t, provGenesis.ConsumerOwners[0].Owner, owner.String())
+	pause, found := pk.GetConsumerPause(ctx, cChainIDs[1])
+	require.True(t, found)
+	require.Equal(t, provGenesis.PausedConsumers[0].Pause, pause)
+	stopped, found := pk.GetStoppedConsumer(ctx, "stopped")
+	require.True(t, found)
+	require.Equal(t, provGenesis.StoppedConsumers[0], stopped)
+	nonce, found := pk.GetKeyAssignmentNonce(ctx, cChainIDs[0], provAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(3), nonce)
+	rotation, found := pk.GetConsumerKeyRotation(ctx, cChainIDs[1], provAddr)
+	require.True(t, found)
+	require.Equal(t, provGenesis.ConsumerKeyRotations[0].Rotation, rotation)
+	require.Equal(t, []providertypes.KeyAssignmentRecord{provGenesis.KeyAssignmentHistory[0].Record},
+		pk.GetKeyAssignmentHistory(ctx, cChainIDs[0], provAddr))
+	require.Equal(t, provGenesis.OptInPolicies[0].Policy, pk.GetOptInPolicy(ctx, cChainIDs[0]))
+	optInRecord, found := pk.GetOptInRecord(ctx, cChainIDs[0], provAddr)
+	require.True(t, found)
+	require.Equal(t, provGenesis.OptInRecords[0].Record, optInRecord)
+	signal, found := pk.GetReadinessSignal(ctx, cChainIDs[1], provAddr)
+	require.True(t, found)
+	require.Equal(t, provGenesis.ReadinessSignals[0].Signal, signal)
+	require.Equal(t, int64(300), pk.GetConsumerBlocksPerEpoch(ctx, cChainIDs[0]))
+	epochState, found := pk.GetEpochState(ctx)
+	require.True(t, found)
+	require.Equal(t, *provGenesis.EpochState, epochState)
 
 	// check provider chain's consumer chain states
 	assertConsumerChainStates(t, ctx, pk, provGenesis.ConsumerStates...)
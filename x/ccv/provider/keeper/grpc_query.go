@@ -7,9 +7,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/allinbits/interchain-security/x/ccv/provider/types"
 	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
@@ -17,6 +21,36 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+// paginate works as query.CollectionPaginate, except that a nil pageReq returns
+// all the entries of the collection and a nil page response, as the queries did
+// before they were paginated.
+func paginate[K, V any, C query.Collection[K, V], T any](
+	ctx context.Context,
+	coll C,
+	pageReq *query.PageRequest,
+	transform func(key K, value V) (T, error),
+	opts ...func(o *query.CollectionsPaginateOptions[K]),
+) ([]T, *query.PageResponse, error) {
+	if pageReq == nil {
+		results, _, err := query.CollectionPaginate(ctx, coll, &query.PageRequest{Limit: query.PaginationMaxLimit}, transform, opts...)
+		return results, nil, err
+	}
+	results, pageRes, err := query.CollectionPaginate(ctx, coll, pageReq, transform, opts...)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return results, pageRes, nil
+}
+
+// withCollectionPaginationTripleSuperPrefix restricts the pagination of a collection whose key
+// is a collections.Triple to the keys with the given first and second parts
+func withCollectionPaginationTripleSuperPrefix[K1, K2, K3 any](k1 K1, k2 K2) func(o *query.CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+	return func(o *query.CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+		prefix := collections.TripleSuperPrefix[K1, K2, K3](k1, k2)
+		o.Prefix = &prefix
+	}
+}
+
 func (k Keeper) QueryConsumerGenesis(c context.Context, req *types.QueryConsumerGenesisRequest) (*types.QueryConsumerGenesisResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -46,8 +80,14 @@ func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsu
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	chainIDs, pageRes, err := paginate(ctx, k.chainToClient, req.Pagination,
+		func(chainID, _ string) (string, error) { return chainID, nil })
+	if err != nil {
+		return nil, err
+	}
+
 	chains := []*types.Chain{}
-	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+	for _, chainID := range chainIDs {
 		c, err := k.GetConsumerChain(ctx, chainID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -55,7 +95,7 @@ func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsu
 		chains = append(chains, &c)
 	}
 
-	return &types.QueryConsumerChainsResponse{Chains: chains}, nil
+	return &types.QueryConsumerChainsResponse{Chains: chains, Pagination: pageRes}, nil
 }
 
 // GetConsumerChain returns a Chain data structure with all the necessary fields
@@ -92,15 +132,19 @@ func (k Keeper) QueryConsumerChainStarts(goCtx context.Context, req *types.Query
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var props []*types.ConsumerAdditionProposal
 
-	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
-		// prevent implicit memory aliasing
-		p := prop
-		props = append(props, &p)
+	props, pageRes, err := paginate(ctx, k.pendingConsumerAdditionProps, req.Pagination,
+		func(_ collections.Pair[uint64, string], prop types.ConsumerAdditionProposal) (*types.ConsumerAdditionProposal, error) {
+			return &prop, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryConsumerChainStartProposalsResponse{Proposals: &types.ConsumerAdditionProposals{Pending: props}}, nil
+	return &types.QueryConsumerChainStartProposalsResponse{
+		Proposals:  &types.ConsumerAdditionProposals{Pending: props},
+		Pagination: pageRes,
+	}, nil
 }
 
 func (k Keeper) QueryConsumerChainStops(goCtx context.Context, req *types.QueryConsumerChainStopProposalsRequest) (*types.QueryConsumerChainStopProposalsResponse, error) {
@@ -109,14 +153,19 @@ func (k Keeper) QueryConsumerChainStops(goCtx context.Context, req *types.QueryC
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var props []*types.ConsumerRemovalProposal
-	for _, prop := range k.GetAllPendingConsumerRemovalProps(ctx) {
-		// prevent implicit memory aliasing
-		p := prop
-		props = append(props, &p)
+
+	props, pageRes, err := paginate(ctx, k.pendingConsumerRemovalProps, req.Pagination,
+		func(_ collections.Pair[uint64, string], prop types.ConsumerRemovalProposal) (*types.ConsumerRemovalProposal, error) {
+			return &prop, nil
+		})
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryConsumerChainStopProposalsResponse{Proposals: &types.ConsumerRemovalProposals{Pending: props}}, nil
+	return &types.QueryConsumerChainStopProposalsResponse{
+		Proposals:  &types.ConsumerRemovalProposals{Pending: props},
+		Pagination: pageRes,
+	}, nil
 }
 
 func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.QueryValidatorConsumerAddrRequest) (*types.QueryValidatorConsumerAddrResponse, error) {
@@ -195,10 +244,15 @@ func (k Keeper) QueryRegisteredConsumerRewardDenoms(goCtx context.Context, req *
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	denoms := k.GetAllConsumerRewardDenoms(ctx)
+	denoms, pageRes, err := paginate(ctx, k.consumerRewardDenoms, req.Pagination,
+		func(denom string, _ collections.NoValue) (string, error) { return denom, nil })
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryRegisteredConsumerRewardDenomsResponse{
-		Denoms: denoms,
+		Denoms:     denoms,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -209,10 +263,20 @@ func (k Keeper) QueryProposedConsumerChainIDs(goCtx context.Context, req *types.
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	chains := k.GetAllProposedConsumerChainIDs(ctx)
+	chains, pageRes, err := paginate(ctx, k.proposedConsumerChains, req.Pagination,
+		func(proposalID uint64, chainID string) (types.ProposedChain, error) {
+			return types.ProposedChain{ChainID: chainID, ProposalID: proposalID}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	if chains == nil {
+		chains = []types.ProposedChain{}
+	}
 
 	return &types.QueryProposedChainIDsResponse{
 		ProposedChains: chains,
+		Pagination:     pageRes,
 	}, nil
 }
 
@@ -225,25 +289,33 @@ func (k Keeper) QueryAllPairsValConAddrByConsumerChainID(goCtx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "empty chainId")
 	}
 
-	// list of pairs valconsensus addr <providerValConAddrs : consumerValConAddrs>
-	pairValConAddrs := []*types.PairValConAddrProviderAndConsumer{}
-
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	validatorConsumerPubKeys := k.GetAllValidatorConsumerPubKeys(ctx, &req.ChainId)
-	for _, data := range validatorConsumerPubKeys {
-		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*data.ConsumerKey)
-		if err != nil {
-			return nil, err
-		}
-		pairValConAddrs = append(pairValConAddrs, &types.PairValConAddrProviderAndConsumer{
-			ProviderAddress: sdk.ConsAddress(data.ProviderAddr).String(),
-			ConsumerAddress: consumerAddr.String(),
-			ConsumerKey:     data.ConsumerKey,
-		})
+
+	// list of pairs valconsensus addr <providerValConAddrs : consumerValConAddrs>
+	pairValConAddrs, pageRes, err := paginate(ctx, k.validatorConsumerPubKeys, req.Pagination,
+		func(key collections.Pair[string, sdk.ConsAddress], consumerKey tmprotocrypto.PublicKey) (*types.PairValConAddrProviderAndConsumer, error) {
+			consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+			if err != nil {
+				return nil, err
+			}
+			return &types.PairValConAddrProviderAndConsumer{
+				ProviderAddress: key.K2().String(),
+				ConsumerAddress: consumerAddr.String(),
+				ConsumerKey:     &consumerKey,
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.ConsAddress](req.ChainId),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if pairValConAddrs == nil {
+		pairValConAddrs = []*types.PairValConAddrProviderAndConsumer{}
 	}
 
 	return &types.QueryAllPairsValConAddrByConsumerChainIDResponse{
 		PairValConAddr: pairValConAddrs,
+		Pagination:     pageRes,
 	}, nil
 }
 
@@ -277,17 +349,23 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("no started consumer chain: %s", consumerChainID))
 	}
 
-	var validators []*types.QueryConsumerValidatorsValidator
-	for _, v := range k.GetConsumerValSet(ctx, consumerChainID) {
-		validators = append(validators, &types.QueryConsumerValidatorsValidator{
-			ProviderAddress: sdk.ConsAddress(v.ProviderConsAddr).String(),
-			ConsumerKey:     v.ConsumerPublicKey,
-			Power:           v.Power,
-		})
+	validators, pageRes, err := paginate(ctx, k.consumerValidators, req.Pagination,
+		func(_ collections.Pair[string, sdk.ConsAddress], v types.ConsumerValidator) (*types.QueryConsumerValidatorsValidator, error) {
+			return &types.QueryConsumerValidatorsValidator{
+				ProviderAddress: sdk.ConsAddress(v.ProviderConsAddr).String(),
+				ConsumerKey:     v.ConsumerPublicKey,
+				Power:           v.Power,
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.ConsAddress](consumerChainID),
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.QueryConsumerValidatorsResponse{
 		Validators: validators,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -302,18 +380,319 @@ func (k Keeper) QueryConsumerChainOptedInValidators(goCtx context.Context, req *
 		return nil, status.Error(codes.InvalidArgument, "empty chainId")
 	}
 
-	optedInVals := []string{}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	if !k.IsConsumerProposedOrRegistered(ctx, consumerChainID) {
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerChainID))
 	}
 
-	for _, v := range k.GetAllOptedIn(ctx, consumerChainID) {
-		optedInVals = append(optedInVals, v.ToSdkConsAddr().String())
+	optedInVals, pageRes, err := paginate(ctx, k.optedIn, req.Pagination,
+		func(key collections.Pair[string, sdk.ConsAddress], _ collections.NoValue) (string, error) {
+			return key.K2().String(), nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.ConsAddress](consumerChainID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if optedInVals == nil {
+		optedInVals = []string{}
 	}
 
 	return &types.QueryConsumerChainOptedInValidatorsResponse{
 		ValidatorsProviderAddresses: optedInVals,
+		Pagination:                  pageRes,
+	}, nil
+}
+
+// QueryConsumerMetadata returns the metadata and the owner stored for a given consumer chain
+func (k Keeper) QueryConsumerMetadata(goCtx context.Context, req *types.QueryConsumerMetadataRequest) (*types.QueryConsumerMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty chainId")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata, found := k.GetConsumerMetadata(ctx, req.ChainId)
+	owner, hasOwner := k.GetConsumerOwner(ctx, req.ChainId)
+	if !found && !hasOwner {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrUnknownConsumerChainId, "no metadata for consumer chain: %s", req.ChainId).Error(),
+		)
+	}
+
+	res := &types.QueryConsumerMetadataResponse{Metadata: metadata}
+	if hasOwner {
+		res.Owner = owner.String()
+	}
+	return res, nil
+}
+
+// QueryConsumerKeyRotations returns the consumer key rotations scheduled on a given consumer chain,
+// optionally for a single validator
+func (k Keeper) QueryConsumerKeyRotations(goCtx context.Context, req *types.QueryConsumerKeyRotationsRequest) (*types.QueryConsumerKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty chainId")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var rotations []types.ScheduledConsumerKeyRotation
+	if req.ProviderAddress != "" {
+		providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+		if rotation, found := k.GetConsumerKeyRotation(ctx, req.ChainId, providerAddr); found {
+			rotations = append(rotations, types.ScheduledConsumerKeyRotation{
+				ChainId:      req.ChainId,
+				ProviderAddr: providerAddr.ToSdkConsAddr(),
+				Rotation:     rotation,
+			})
+		}
+	} else {
+		rotations = k.GetAllConsumerKeyRotations(ctx, &req.ChainId)
+	}
+
+	res := &types.QueryConsumerKeyRotationsResponse{}
+	for _, r := range rotations {
+		info, err := k.GetConsumerKeyRotationInfo(ctx, r)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Rotations = append(res.Rotations, &info)
+	}
+	return res, nil
+}
+
+// QueryValidatorObligations returns the obligations of a given validator on every running consumer chain
+func (k Keeper) QueryValidatorObligations(goCtx context.Context, req *types.QueryValidatorObligationsRequest) (*types.QueryValidatorObligationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no validator with consensus address %s", req.ProviderAddress))
+	}
+
+	obligations, err := k.GetValidatorObligations(ctx, validator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorObligationsResponse{Obligations: obligations}, nil
+}
+
+// QueryKeyAssignmentHistory returns the consumer keys assigned by a given validator on a given consumer chain
+func (k Keeper) QueryKeyAssignmentHistory(goCtx context.Context, req *types.QueryKeyAssignmentHistoryRequest) (*types.QueryKeyAssignmentHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty chainId")
+	}
+
+	providerAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records, pageRes, err := paginate(ctx, k.keyAssignmentHistory, req.Pagination,
+		func(_ collections.Triple[string, sdk.ConsAddress, uint64], r types.KeyAssignmentRecord) (*types.KeyAssignmentHistoryRecord, error) {
+			consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(r.ConsumerKey)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return &types.KeyAssignmentHistoryRecord{
+				ConsumerAddress:  consumerAddr.String(),
+				ConsumerKey:      &r.ConsumerKey,
+				AssignedHeight:   r.AssignedHeight,
+				EffectiveVscId:   r.EffectiveVscId,
+				SupersededHeight: r.SupersededHeight,
+				SupersededVscId:  r.SupersededVscId,
+			}, nil
+		},
+		withCollectionPaginationTripleSuperPrefix[string, sdk.ConsAddress, uint64](req.ChainId, providerAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryKeyAssignmentHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// QueryOptInCommitments returns the opt-in policy of a given consumer chain and the commitments
+// and cooldowns of the validators that opted in to or out of it, optionally for a single validator
+func (k Keeper) QueryOptInCommitments(goCtx context.Context, req *types.QueryOptInCommitmentsRequest) (*types.QueryOptInCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty chainId")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerProposedOrRegistered(ctx, req.ChainId) {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown consumer chain: %s", req.ChainId))
+	}
+
+	var records []types.ValidatorOptInRecord
+	if req.ProviderAddress != "" {
+		providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+		if record, found := k.GetOptInRecord(ctx, req.ChainId, providerAddr); found {
+			records = append(records, types.ValidatorOptInRecord{
+				ChainId:      req.ChainId,
+				ProviderAddr: providerAddr.ToSdkConsAddr(),
+				Record:       record,
+			})
+		}
+	} else {
+		records = k.GetAllOptInRecords(ctx, &req.ChainId)
+	}
+
+	res := &types.QueryOptInCommitmentsResponse{Policy: k.GetOptInPolicy(ctx, req.ChainId)}
+	for _, r := range records {
+		commitment := k.GetOptInCommitment(ctx, r)
+		res.Commitments = append(res.Commitments, &commitment)
+	}
+	return res, nil
+}
+
+// QueryConsumerReadiness returns which validators in the expected initial validator set of a consumer
+// chain that is waiting for its launch signalled that they are ready to validate it, with their voting power
+func (k Keeper) QueryConsumerReadiness(goCtx context.Context, req *types.QueryConsumerReadinessRequest) (*types.QueryConsumerReadinessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty chainId")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	prop, found := k.findPendingConsumerAdditionProp(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("consumer chain %s is not waiting for its launch", req.ChainId))
+	}
+
+	validators, err := k.ComputeExpectedInitialValSet(ctx, prop)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryConsumerReadinessResponse{}
+	res.ReadyPower, res.TotalPower = k.GetReadyPower(ctx, prop, validators)
+	if prop.LaunchThresholds != nil {
+		res.MinReadyPowerPercentage = prop.LaunchThresholds.MinReadyPowerPercentage
+	}
+	for _, v := range validators {
+		providerAddr := types.NewProviderConsAddress(v.ProviderConsAddr)
+		readiness := &types.ValidatorReadiness{
+			ProviderAddress: providerAddr.String(),
+			Power:           v.Power,
+		}
+		if signal, found := k.GetReadinessSignal(ctx, req.ChainId, providerAddr); found {
+			readiness.Ready = isReadinessSignalValid(signal, prop)
+			readiness.SignalHeight = signal.Height
+		}
+		res.Validators = append(res.Validators, readiness)
+	}
+	return res, nil
+}
+
+// QueryConsumerEpochs returns the epoch length of every registered consumer chain and the height
+// at which its current epoch ends
+func (k Keeper) QueryConsumerEpochs(goCtx context.Context, req *types.QueryConsumerEpochsRequest) (*types.QueryConsumerEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.QueryConsumerEpochsResponse{}
+	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+		params, found := k.GetConsumerEpochParams(ctx, chainID)
+		info := &types.ConsumerEpochInfo{
+			ChainId:      chainID,
+			CustomEpoch:  found && params.BlocksPerEpoch > 0,
+			CurrentEpoch: k.GetCurrentEpoch(ctx, chainID),
+		}
+		if k.IsTimeBasedEpoch(ctx, chainID) {
+			endTime := k.GetEpochEndTime(ctx)
+			info.TimeBased = true
+			info.NextEpochEndTime = &endTime
+		} else {
+			info.BlocksPerEpoch = k.GetConsumerBlocksPerEpoch(ctx, chainID)
+			// the latest block has been committed, so the epoch of the next block is considered
+			info.NextEpochEndHeight = k.GetEpochEndHeight(ctx, chainID, ctx.BlockHeight()+1)
+		}
+		res.Epochs = append(res.Epochs, info)
+	}
+	return res, nil
+}
+
+// QueryEpochInfo returns the current epoch of the provider chain and the time or the number of
+// blocks left until its end
+func (k Keeper) QueryEpochInfo(goCtx context.Context, req *types.QueryEpochInfoRequest) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the latest block has been committed, so the epoch of the next block is considered
+	if duration := k.GetEpochDuration(ctx); duration > 0 {
+		state := k.GetCurrentEpochState(ctx)
+		endTime := state.StartTime.Add(duration)
+		return &types.QueryEpochInfoResponse{
+			TimeBased:     true,
+			CurrentEpoch:  state.Number,
+			EpochDuration: duration,
+			StartHeight:   state.StartHeight,
+			StartTime:     &state.StartTime,
+			EndTime:       &endTime,
+			TimeLeft:      max(endTime.Sub(ctx.BlockTime()), 0),
+		}, nil
+	}
+
+	blocksPerEpoch := k.GetBlocksPerEpoch(ctx)
+	endHeight := (ctx.BlockHeight()/blocksPerEpoch + 1) * blocksPerEpoch
+	return &types.QueryEpochInfoResponse{
+		CurrentEpoch:   uint64(endHeight / blocksPerEpoch),
+		BlocksPerEpoch: blocksPerEpoch,
+		StartHeight:    endHeight - blocksPerEpoch + 1,
+		EndHeight:      endHeight,
+		BlocksLeft:     endHeight - ctx.BlockHeight(),
 	}, nil
 }
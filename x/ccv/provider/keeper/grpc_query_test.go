@@ -9,6 +9,7 @@ import (
 	"github.com/allinbits/interchain-security/x/ccv/provider/types"
 	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 )
 
@@ -132,3 +133,276 @@ func TestGetConsumerChain(t *testing.T) {
 		require.Equal(t, expectedGetAllOrder[i], c)
 	}
 }
+
+// TestQueryPagination tests that the list queries return all the entries
+// when no pagination is set and the requested page otherwise
+func TestQueryPagination(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chain-1"
+	pk.SetConsumerClientId(ctx, chainID, "client-1")
+	pk.SetConsumerClientId(ctx, "chain-2", "client-2")
+	pk.SetConsumerClientId(ctx, "chain-3", "client-3")
+	for i := 1; i <= 3; i++ {
+		pk.SetConsumerRewardDenom(ctx, fmt.Sprintf("denom-%d", i))
+		pk.SetOptedIn(ctx, chainID, types.NewProviderConsAddress([]byte(fmt.Sprintf("providerAddr%d", i))))
+		// opted in to another chain, must not be returned for chainID
+		pk.SetOptedIn(ctx, "chain-2", types.NewProviderConsAddress([]byte(fmt.Sprintf("otherAddr%d", i))))
+	}
+
+	// no pagination
+	chains, err := pk.QueryConsumerChains(ctx, &types.QueryConsumerChainsRequest{})
+	require.NoError(t, err)
+	require.Len(t, chains.Chains, 3)
+	require.Nil(t, chains.Pagination)
+
+	denoms, err := pk.QueryRegisteredConsumerRewardDenoms(ctx, &types.QueryRegisteredConsumerRewardDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"denom-1", "denom-2", "denom-3"}, denoms.Denoms)
+	require.Nil(t, denoms.Pagination)
+
+	optedIn, err := pk.QueryConsumerChainOptedInValidators(ctx, &types.QueryConsumerChainOptedInValidatorsRequest{ChainId: chainID})
+	require.NoError(t, err)
+	require.Len(t, optedIn.ValidatorsProviderAddresses, 3)
+	require.Nil(t, optedIn.Pagination)
+
+	// first page
+	chains, err = pk.QueryConsumerChains(ctx, &types.QueryConsumerChainsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, chains.Chains, 2)
+	require.Equal(t, "chain-1", chains.Chains[0].ChainId)
+	require.Equal(t, "chain-2", chains.Chains[1].ChainId)
+	require.Equal(t, uint64(3), chains.Pagination.Total)
+	require.NotNil(t, chains.Pagination.NextKey)
+
+	// next page
+	chains, err = pk.QueryConsumerChains(ctx, &types.QueryConsumerChainsRequest{
+		Pagination: &query.PageRequest{Key: chains.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, chains.Chains, 1)
+	require.Equal(t, "chain-3", chains.Chains[0].ChainId)
+	require.Nil(t, chains.Pagination.NextKey)
+
+	denoms, err = pk.QueryRegisteredConsumerRewardDenoms(ctx, &types.QueryRegisteredConsumerRewardDenomsRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"denom-2"}, denoms.Denoms)
+
+	// per chain pagination only returns the entries of the chain
+	optedIn, err = pk.QueryConsumerChainOptedInValidators(ctx, &types.QueryConsumerChainOptedInValidatorsRequest{
+		ChainId:    chainID,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		sdktypes.ConsAddress("providerAddr1").String(),
+		sdktypes.ConsAddress("providerAddr2").String(),
+	}, optedIn.ValidatorsProviderAddresses)
+	require.Equal(t, uint64(3), optedIn.Pagination.Total)
+
+	optedIn, err = pk.QueryConsumerChainOptedInValidators(ctx, &types.QueryConsumerChainOptedInValidatorsRequest{
+		ChainId:    chainID,
+		Pagination: &query.PageRequest{Key: optedIn.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{sdktypes.ConsAddress("providerAddr3").String()}, optedIn.ValidatorsProviderAddresses)
+}
+
+func TestQueryConsumerMetadata(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	metadata := types.ConsumerMetadata{
+		Name:             "Consumer",
+		Website:          "https://consumer.zone",
+		BinaryRepository: "https://github.com/consumer/consumer",
+	}
+	pk.SetConsumerMetadata(ctx, "chain-1", metadata)
+
+	_, err := pk.QueryConsumerMetadata(ctx, nil)
+	require.Error(t, err)
+
+	_, err = pk.QueryConsumerMetadata(ctx, &types.QueryConsumerMetadataRequest{})
+	require.Error(t, err)
+
+	_, err = pk.QueryConsumerMetadata(ctx, &types.QueryConsumerMetadataRequest{ChainId: "chain-2"})
+	require.Error(t, err)
+
+	res, err := pk.QueryConsumerMetadata(ctx, &types.QueryConsumerMetadataRequest{ChainId: "chain-1"})
+	require.NoError(t, err)
+	require.Equal(t, metadata, res.Metadata)
+	require.Empty(t, res.Owner)
+
+	// a chain with an owner but no metadata is found
+	owner := sdktypes.AccAddress([]byte("owner"))
+	pk.SetConsumerOwner(ctx, "chain-2", owner)
+	res, err = pk.QueryConsumerMetadata(ctx, &types.QueryConsumerMetadataRequest{ChainId: "chain-2"})
+	require.NoError(t, err)
+	require.Equal(t, types.ConsumerMetadata{}, res.Metadata)
+	require.Equal(t, owner.String(), res.Owner)
+}
+
+func TestQueryConsumerKeyRotations(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := types.DefaultParams()
+	params.BlocksPerEpoch = 10
+	pk.SetParams(ctx, params)
+
+	providerId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	providerAddr := providerId.ProviderConsAddress()
+	currentKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	consumerId := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	consumerKey := consumerId.TMProtoCryptoPublicKey()
+	pk.SetConsumerKeyRotation(ctx, "chain-1", providerAddr, types.ConsumerKeyRotation{ConsumerKey: consumerKey, ApplyHeight: 30})
+
+	_, err := pk.QueryConsumerKeyRotations(ctx, nil)
+	require.Error(t, err)
+
+	_, err = pk.QueryConsumerKeyRotations(ctx, &types.QueryConsumerKeyRotationsRequest{})
+	require.Error(t, err)
+
+	_, err = pk.QueryConsumerKeyRotations(ctx, &types.QueryConsumerKeyRotationsRequest{ChainId: "chain-1", ProviderAddress: "invalid"})
+	require.Error(t, err)
+
+	res, err := pk.QueryConsumerKeyRotations(ctx, &types.QueryConsumerKeyRotationsRequest{ChainId: "chain-2"})
+	require.NoError(t, err)
+	require.Empty(t, res.Rotations)
+
+	// a validator without a consumer key uses its provider key before the rotation
+	expected := &types.ConsumerKeyRotationInfo{
+		ProviderAddress:        providerAddr.String(),
+		CurrentConsumerAddress: providerAddr.String(),
+		ConsumerAddress:        consumerId.SDKValConsAddress().String(),
+		ConsumerKey:            &consumerKey,
+		ApplyHeight:            30,
+		ApplyEpoch:             3,
+	}
+	res, err = pk.QueryConsumerKeyRotations(ctx, &types.QueryConsumerKeyRotationsRequest{ChainId: "chain-1"})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ConsumerKeyRotationInfo{expected}, res.Rotations)
+
+	pk.SetValidatorConsumerPubKey(ctx, "chain-1", providerAddr, currentKey)
+	expected.CurrentConsumerAddress = cryptotestutil.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress().String()
+	res, err = pk.QueryConsumerKeyRotations(ctx, &types.QueryConsumerKeyRotationsRequest{
+		ChainId:         "chain-1",
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.ConsumerKeyRotationInfo{expected}, res.Rotations)
+
+	otherAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(3).ProviderConsAddress()
+	res, err = pk.QueryConsumerKeyRotations(ctx, &types.QueryConsumerKeyRotationsRequest{
+		ChainId:         "chain-1",
+		ProviderAddress: otherAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Rotations)
+}
+
+func TestQueryValidatorObligations(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := types.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.NumberOfEpochsToStartReceivingRewards = 2
+	pk.SetParams(ctx, params)
+
+	// validators 0, 1 and 2 have powers 1, 2 and 3
+	state := newStakingState(mocks, 3)
+	providerAddr0, providerAddr1, providerAddr2 := state.providerAddr(0), state.providerAddr(1), state.providerAddr(2)
+
+	// validator 2 is in the top N of chain "top", where validator 0 is denylisted
+	pk.SetConsumerClientId(ctx, "top", "clientID-top")
+	pk.SetTopN(ctx, "top", 50)
+	pk.SetMinimumPowerInTopN(ctx, "top", 3)
+	pk.SetOptedIn(ctx, "top", providerAddr2)
+	pk.SetDenylist(ctx, "top", providerAddr0)
+	// validator 0 opted in to chain "optin"
+	pk.SetConsumerClientId(ctx, "optin", "clientID-optin")
+	pk.SetOptedIn(ctx, "optin", providerAddr0)
+
+	ctx = ctx.WithBlockHeight(10)
+	pk.EndBlockVSU(ctx)
+
+	// in the next epoch, validator 0 uses a consumer key on chain "optin" and validator 1 validates chain "top"
+	ctx = ctx.WithBlockHeight(11)
+	consumerId := cryptotestutil.NewCryptoIdentityFromIntSeed(10)
+	consumerKey := consumerId.TMProtoCryptoPublicKey()
+	require.NoError(t, pk.AssignConsumerKey(ctx, "optin", state.validators[0], consumerKey))
+	require.NoError(t, pk.HandleOptIn(ctx, "top", providerAddr1, ""))
+
+	_, err := pk.QueryValidatorObligations(ctx, nil)
+	require.Error(t, err)
+
+	_, err = pk.QueryValidatorObligations(ctx, &types.QueryValidatorObligationsRequest{ProviderAddress: "invalid"})
+	require.Error(t, err)
+
+	unknownAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(5).ProviderConsAddress()
+	_, err = pk.QueryValidatorObligations(ctx, &types.QueryValidatorObligationsRequest{ProviderAddress: unknownAddr.String()})
+	require.Error(t, err)
+
+	queryObligations := func(providerAddr types.ProviderConsAddress) map[string]types.ValidatorObligation {
+		res, err := pk.QueryValidatorObligations(ctx, &types.QueryValidatorObligationsRequest{ProviderAddress: providerAddr.String()})
+		require.NoError(t, err)
+		obligations := map[string]types.ValidatorObligation{}
+		for _, o := range res.Obligations {
+			obligations[o.ChainId] = o
+		}
+		require.Len(t, obligations, 2)
+		return obligations
+	}
+
+	providerKey0 := cryptotestutil.NewCryptoIdentityFromIntSeed(0).TMProtoCryptoPublicKey()
+	obligations := queryObligations(providerAddr0)
+	require.Equal(t, types.ValidatorObligation{
+		ChainId:                  "optin",
+		Membership:               types.MembershipOptedIn,
+		Reason:                   "opted in",
+		IsConsumerValidator:      true,
+		Power:                    1,
+		ConsumerKey:              &providerKey0,
+		ConsumerAddress:          providerAddr0.String(),
+		ConsumerKeyAssigned:      true,
+		EligibleForRewards:       false,
+		RewardsEligibilityHeight: 30,
+		NextEpoch: types.ValidatorObligationChange{
+			Height:              20,
+			IsConsumerValidator: true,
+			Power:               1,
+			ConsumerKey:         &consumerKey,
+			ConsumerAddress:     consumerId.SDKValConsAddress().String(),
+			ConsumerKeyChanges:  true,
+		},
+	}, obligations["optin"])
+	require.Equal(t, types.MembershipExcluded, obligations["top"].Membership)
+	require.False(t, obligations["top"].IsConsumerValidator)
+	require.False(t, obligations["top"].NextEpoch.IsConsumerValidator)
+
+	obligations = queryObligations(providerAddr1)
+	require.Equal(t, types.MembershipOptedIn, obligations["top"].Membership)
+	require.False(t, obligations["top"].IsConsumerValidator)
+	require.True(t, obligations["top"].NextEpoch.IsConsumerValidator)
+	require.Equal(t, int64(2), obligations["top"].NextEpoch.Power)
+	require.Equal(t, types.MembershipEligible, obligations["optin"].Membership)
+	require.False(t, obligations["optin"].NextEpoch.IsConsumerValidator)
+
+	obligations = queryObligations(providerAddr2)
+	require.Equal(t, types.MembershipForcedTopN, obligations["top"].Membership)
+	require.True(t, obligations["top"].IsConsumerValidator)
+	require.Equal(t, int64(3), obligations["top"].Power)
+	require.False(t, obligations["top"].ConsumerKeyAssigned)
+	require.False(t, obligations["top"].NextEpoch.ConsumerKeyChanges)
+
+	// validator 2 is eligible for rewards two epochs after it joined
+	ctx = ctx.WithBlockHeight(30)
+	obligations = queryObligations(providerAddr2)
+	require.True(t, obligations["top"].EligibleForRewards)
+	require.Equal(t, int64(30), obligations["top"].RewardsEligibilityHeight)
+	require.Equal(t, int64(40), obligations["top"].NextEpoch.Height)
+}
@@ -49,6 +49,8 @@ func (h Hooks) AfterValidatorCreated(goCtx context.Context, valAddr sdk.ValAddre
 func (h Hooks) AfterValidatorRemoved(goCtx context.Context, valConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	h.k.SetDirtyValidator(ctx, providertypes.NewProviderConsAddress(valConsAddr))
+
 	for _, validatorConsumerPubKey := range h.k.GetAllValidatorConsumerPubKeys(ctx, nil) {
 		if sdk.ConsAddress(validatorConsumerPubKey.ProviderAddr).Equals(valConsAddr) {
 			consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*validatorConsumerPubKey.ConsumerKey)
@@ -74,11 +76,15 @@ func (h Hooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddres
 	return nil
 }
 
-func (h Hooks) AfterDelegationModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// AfterDelegationModified marks the validator as dirty as its power might change
+func (h Hooks) AfterDelegationModified(goCtx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.setDirtyValidatorByOperator(sdk.UnwrapSDKContext(goCtx), valAddr)
 	return nil
 }
 
-func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+// BeforeValidatorSlashed marks the validator as dirty as its power might change
+func (h Hooks) BeforeValidatorSlashed(goCtx context.Context, valAddr sdk.ValAddress, _ math.LegacyDec) error {
+	h.setDirtyValidatorByOperator(sdk.UnwrapSDKContext(goCtx), valAddr)
 	return nil
 }
 
@@ -86,15 +92,21 @@ func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) erro
 	return nil
 }
 
-func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// AfterValidatorBonded marks the validator as dirty as it joins the bonded validators
+func (h Hooks) AfterValidatorBonded(goCtx context.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	h.k.SetDirtyValidator(sdk.UnwrapSDKContext(goCtx), providertypes.NewProviderConsAddress(valConsAddr))
 	return nil
 }
 
-func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+// AfterValidatorBeginUnbonding marks the validator as dirty as it leaves the bonded validators
+func (h Hooks) AfterValidatorBeginUnbonding(goCtx context.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	h.k.SetDirtyValidator(sdk.UnwrapSDKContext(goCtx), providertypes.NewProviderConsAddress(valConsAddr))
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// BeforeDelegationRemoved marks the validator as dirty as its power might change
+func (h Hooks) BeforeDelegationRemoved(goCtx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.setDirtyValidatorByOperator(sdk.UnwrapSDKContext(goCtx), valAddr)
 	return nil
 }
 
@@ -102,6 +114,29 @@ func (h Hooks) BeforeTokenizeShareRecordRemoved(_ context.Context, _ uint64) err
 	return nil
 }
 
+// setDirtyValidatorByOperator marks the validator with operator address `valAddr` as dirty,
+// so that it is recomputed in the consumer validator sets at the end of the epoch.
+// A failure to mark the validator as dirty must not make the staking operation fail, so the
+// error is logged and the consumer validator sets are computed from scratch instead.
+func (h Hooks) setDirtyValidatorByOperator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	validator, err := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err == nil {
+		var consAddr sdk.ConsAddress
+		if consAddr, err = validator.GetConsAddr(); err == nil {
+			h.k.SetDirtyValidator(ctx, providertypes.NewProviderConsAddress(consAddr))
+			return
+		}
+	}
+
+	h.k.Logger(ctx).Error("failed to mark validator as dirty, consumer validator sets are recomputed from scratch",
+		"validator", valAddr.String(),
+		"error", err,
+	)
+	for _, chainID := range h.k.GetAllRegisteredConsumerChainIDs(ctx) {
+		h.k.DeleteConsumerValSetTracked(ctx, chainID)
+	}
+}
+
 //
 // gov hooks
 //
@@ -166,3 +201,19 @@ func (h Hooks) GetConsumerAdditionLegacyPropFromProp(
 	}
 	return providertypes.ConsumerAdditionProposal{}, false
 }
+
+//
+// provider hooks
+//
+
+// callHookInCachedCtx calls hook in a cached context, whose state changes are only
+// written if the hook succeeds. An error is only logged, since the state transition
+// that the hook reacts to cannot be reverted.
+func (k Keeper) callHookInCachedCtx(ctx sdk.Context, hookName string, hook func(cachedCtx sdk.Context) error) {
+	cachedCtx, writeFn := ctx.CacheContext()
+	if err := hook(cachedCtx); err != nil {
+		k.Logger(ctx).Error("provider hook failed", "hook", hookName, "error", err.Error())
+		return
+	}
+	writeFn()
+}
@@ -1,13 +1,20 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
 	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
 	providerkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidatorConsensusKeyInUse(t *testing.T) {
@@ -79,3 +86,186 @@ func TestValidatorConsensusKeyInUse(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderHooksOptInAndOptOut(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hooks := testkeeper.NewMockProviderHooks(ctrl)
+	keeperParams.ProviderHooks = hooks
+	providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, testkeeper.NewMockedKeepers(ctrl))
+	ctx := keeperParams.Ctx
+
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	providerKeeper.SetProposedConsumerChain(ctx, "chainID", 1)
+
+	// a failing hook does not abort the opt-in, but its state changes are discarded
+	hooks.EXPECT().AfterValidatorOptedIn(gomock.Any(), "chainID", providerAddr.ToSdkConsAddr()).DoAndReturn(
+		func(ctx context.Context, chainID string, _ sdk.ConsAddress) error {
+			providerKeeper.SetTopN(sdk.UnwrapSDKContext(ctx), chainID, 50)
+			return errors.New("hook error")
+		})
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chainID", providerAddr, ""))
+	require.True(t, providerKeeper.IsOptedIn(ctx, "chainID", providerAddr))
+	_, found := providerKeeper.GetTopN(ctx, "chainID")
+	require.False(t, found)
+
+	// no hook is called if the opt-out fails
+	require.Error(t, providerKeeper.HandleOptOut(ctx, "chainID", providerAddr))
+
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	hooks.EXPECT().AfterValidatorOptedOut(gomock.Any(), "chainID", providerAddr.ToSdkConsAddr()).Return(nil)
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "chainID", providerAddr))
+	require.False(t, providerKeeper.IsOptedIn(ctx, "chainID", providerAddr))
+}
+
+func TestProviderHooksConsumerValidatorSetChanged(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hooks := testkeeper.NewMockProviderHooks(ctrl)
+	keeperParams.ProviderHooks = hooks
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+
+	chainID := "chainID"
+	state := newStakingState(mocks, 3)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	for i := range state.validators {
+		providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+	}
+
+	// the hook receives the queued validator updates; since it fails,
+	// its state changes are discarded, but the VSC packet is still queued
+	hooks.EXPECT().AfterConsumerValidatorSetChanged(gomock.Any(), chainID, uint64(0), gomock.Len(3)).DoAndReturn(
+		func(ctx context.Context, chainID string, _ uint64, _ []abci.ValidatorUpdate) error {
+			providerKeeper.SetTopN(sdk.UnwrapSDKContext(ctx), chainID, 50)
+			return errors.New("hook error")
+		})
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1)
+	_, found := providerKeeper.GetTopN(ctx, chainID)
+	require.False(t, found)
+
+	// without validator set changes, the hook is not called
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1)
+}
+
+func TestProviderHooksConsumerLaunched(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hooks := testkeeper.NewMockProviderHooks(ctrl)
+	keeperParams.ProviderHooks = hooks
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	state := newStakingState(mocks, 2)
+	testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chainID", clienttypes.NewHeight(4, 5))
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.SpawnTime = now
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+	providerKeeper.SetOptedIn(ctx, "chainID", state.providerAddr(0))
+
+	// a failing hook cannot veto the launch approved by governance, but its state changes are discarded
+	hooks.EXPECT().AfterConsumerLaunched(gomock.Any(), "chainID", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, chainID, _ string) error {
+			providerKeeper.SetTopN(sdk.UnwrapSDKContext(ctx), chainID, 50)
+			return errors.New("hook error")
+		})
+	providerKeeper.BeginBlockInit(ctx)
+
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chainID")
+	require.True(t, found)
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+	topN, _ := providerKeeper.GetTopN(ctx, "chainID")
+	require.Equal(t, prop.Top_N, topN)
+}
+
+func TestProviderHooksConsumerKeyAssigned(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hooks := testkeeper.NewMockProviderHooks(ctrl)
+	keeperParams.ProviderHooks = hooks
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+	params := types.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(15)
+
+	state := newStakingState(mocks, 1)
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(10).TMProtoCryptoPublicKey()
+
+	// the key of a scheduled rotation is only assigned in a dry run, so the hook is not called
+	_, err := providerKeeper.ScheduleConsumerKeyRotation(ctx, "chainID", state.validators[0], consumerKey, 0, 3)
+	require.NoError(t, err)
+
+	// the hook is not called by the obligations query either, which applies the rotation in a dry run
+	providerAddr := state.providerAddr(0)
+	res, err := providerKeeper.QueryValidatorObligations(ctx.WithBlockHeight(25), &types.QueryValidatorObligationsRequest{
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Obligations, 1)
+	require.Equal(t, &consumerKey, res.Obligations[0].NextEpoch.ConsumerKey)
+
+	// a failing hook does not abort the key assignment
+	hooks.EXPECT().AfterConsumerKeyAssigned(gomock.Any(), "chainID", providerAddr.ToSdkConsAddr(), consumerKey).
+		Return(errors.New("hook error"))
+	require.NoError(t, providerKeeper.AssignConsumerKey(ctx, "chainID", state.validators[0], consumerKey))
+	assignedKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "chainID", state.providerAddr(0))
+	require.True(t, found)
+	require.Equal(t, consumerKey, assignedKey)
+}
+
+func TestProviderHooksEventsEmittedOnce(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hooks := testkeeper.NewMockProviderHooks(ctrl)
+	keeperParams.ProviderHooks = hooks
+	providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, testkeeper.NewMockedKeepers(ctrl))
+	ctx := keeperParams.Ctx
+
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	providerKeeper.SetProposedConsumerChain(ctx, "chainID", 1)
+
+	countHookEvents := func() int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "hook_event" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// an event emitted by a succeeding hook is emitted exactly once
+	hooks.EXPECT().AfterValidatorOptedIn(gomock.Any(), "chainID", providerAddr.ToSdkConsAddr()).DoAndReturn(
+		func(ctx context.Context, _ string, _ sdk.ConsAddress) error {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("hook_event"))
+			return nil
+		})
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chainID", providerAddr, ""))
+	require.Equal(t, 1, countHookEvents())
+
+	// an event emitted by a failing hook is discarded
+	providerKeeper.SetConsumerClientId(ctx, "chainID", "clientID")
+	hooks.EXPECT().AfterValidatorOptedOut(gomock.Any(), "chainID", providerAddr.ToSdkConsAddr()).DoAndReturn(
+		func(ctx context.Context, _ string, _ sdk.ConsAddress) error {
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("hook_event"))
+			return errors.New("hook error")
+		})
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "chainID", providerAddr))
+	require.Equal(t, 1, countHookEvents())
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// RegisterInvariants registers all provider invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "consumer-rewards-pool",
+		ConsumerRewardsPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "key-assignment-index",
+		KeyAssignmentIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "consumer-registration",
+		ConsumerRegistrationInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "consumer-validators-bonded",
+		ConsumerValidatorsBondedInvariant(k))
+}
+
+// AllInvariants runs all invariants of the provider module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			ConsumerRewardsPoolInvariant(k),
+			KeyAssignmentIndexInvariant(k),
+			ConsumerRegistrationInvariant(k),
+			ConsumerValidatorsBondedInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ConsumerRewardsPoolInvariant checks that the balance of the consumer rewards pool
+// covers the sum of the consumer rewards allocations of all consumer chains
+func ConsumerRewardsPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		allocated := k.GetTotalConsumerRewardsAllocation(ctx)
+		pool := sdk.NewDecCoinsFromCoins(k.GetConsumerRewardsPool(ctx)...)
+
+		_, broken := pool.SafeSub(allocated)
+
+		return sdk.FormatInvariant(types.ModuleName, "consumer-rewards-pool",
+			fmt.Sprintf("\tconsumer rewards pool balance: %s\n"+
+				"\tsum of consumer rewards allocations: %s\n",
+				pool, allocated)), broken
+	}
+}
+
+// KeyAssignmentIndexInvariant checks that the ValidatorConsumerPubKey and ValidatorByConsumerAddr
+// indexes agree, i.e.,
+//   - for each consumer key assigned by a validator, the address of the key maps back to the validator
+//   - each consumer address in ValidatorByConsumerAddr is either the address of the consumer key
+//     currently assigned by the validator it maps to, or it is waiting to be pruned
+func KeyAssignmentIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			broken bool
+			msg    string
+		)
+
+		for _, item := range k.GetAllValidatorConsumerPubKeys(ctx, nil) {
+			providerAddr := types.NewProviderConsAddress(item.ProviderAddr)
+			consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*item.ConsumerKey)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tinvalid consumer key of validator %s on chain %s: %s\n",
+					providerAddr.String(), item.ChainId, err)
+				continue
+			}
+			consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+
+			mappedAddr, found := k.GetValidatorByConsumerAddr(ctx, item.ChainId, consumerAddr)
+			if !found || !mappedAddr.ToSdkConsAddr().Equals(providerAddr.ToSdkConsAddr()) {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer address %s of validator %s on chain %s does not map back to the validator\n",
+					consumerAddr.String(), providerAddr.String(), item.ChainId)
+			}
+		}
+
+		// consumer addresses that are waiting to be pruned, per consumer chain
+		addrsToPrune := map[string]map[string]bool{}
+		for _, item := range k.GetAllValidatorsByConsumerAddr(ctx, nil) {
+			consumerAddr := types.NewConsumerConsAddress(item.ConsumerAddr)
+			providerAddr := types.NewProviderConsAddress(item.ProviderAddr)
+
+			if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, item.ChainId, providerAddr); found {
+				currentAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+				if err == nil && currentAddr.Equals(consumerAddr.ToSdkConsAddr()) {
+					continue
+				}
+			}
+
+			if _, ok := addrsToPrune[item.ChainId]; !ok {
+				addrsToPrune[item.ChainId] = map[string]bool{}
+				for _, toPrune := range k.GetAllConsumerAddrsToPrune(ctx, item.ChainId) {
+					for _, addr := range toPrune.ConsumerAddrs.Addresses {
+						addrsToPrune[item.ChainId][sdk.ConsAddress(addr).String()] = true
+					}
+				}
+			}
+			if !addrsToPrune[item.ChainId][consumerAddr.String()] {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer address %s on chain %s maps to validator %s, "+
+					"but it is neither the validator's current consumer address nor waiting to be pruned\n",
+					consumerAddr.String(), item.ChainId, providerAddr.String())
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "key-assignment-index", msg), broken
+	}
+}
+
+// ConsumerRegistrationInvariant checks that every registered consumer chain has
+// an IBC client and a consumer genesis, and that all the per-chain state that
+// requires a client, i.e., CCV channels and consumer validator sets, only
+// belongs to registered consumer chains
+func ConsumerRegistrationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			broken bool
+			msg    string
+		)
+
+		for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+			clientID, _ := k.GetConsumerClientId(ctx, chainID)
+			if _, found := k.clientKeeper.GetClientState(ctx, clientID); !found {
+				broken = true
+				msg += fmt.Sprintf("\tclient %s of consumer chain %s does not exist\n", clientID, chainID)
+			}
+			if _, found := k.GetConsumerGenesis(ctx, chainID); !found {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer chain %s has no consumer genesis\n", chainID)
+			}
+		}
+
+		for _, channel := range k.GetAllChannelToChains(ctx) {
+			if _, found := k.GetConsumerClientId(ctx, channel.ChainId); !found {
+				broken = true
+				msg += fmt.Sprintf("\tCCV channel %s belongs to consumer chain %s that has no client\n",
+					channel.ChannelId, channel.ChainId)
+			}
+			if _, found := k.GetInitChainHeight(ctx, channel.ChainId); !found {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer chain %s has a CCV channel but no init chain height\n", channel.ChainId)
+			}
+		}
+
+		iterator, err := k.consumerValidators.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Errorf("failed to iterate consumer validators: %w", err))
+		}
+		defer iterator.Close()
+
+		checked := map[string]bool{}
+		for ; iterator.Valid(); iterator.Next() {
+			key, err := iterator.Key()
+			if err != nil {
+				panic(fmt.Errorf("failed to parse consumer validator key: %w", err))
+			}
+			chainID := key.K1()
+			if checked[chainID] {
+				continue
+			}
+			checked[chainID] = true
+			if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer chain %s has a validator set but no client\n", chainID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "consumer-registration", msg), broken
+	}
+}
+
+// ConsumerValidatorsBondedInvariant checks that every consumer validator is a bonded
+// validator on the provider chain. As consumer validator sets are only updated at the
+// end of an epoch, a consumer validator can stop being bonded during an epoch. In
+// that case, the validator must be marked as dirty, either for all consumer chains or
+// for the chain only, e.g., while the chain is paused or its epoch has not ended yet,
+// so that it is removed from the consumer validator set at the end of the epoch.
+// The validator sets of the consumer chains that are not tracked incrementally, e.g.,
+// after a genesis import, are computed from scratch at the end of the epoch and are
+// therefore not checked.
+func ConsumerValidatorsBondedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			broken bool
+			msg    string
+		)
+
+		dirty := map[string]bool{}
+		for _, providerAddr := range k.GetAllDirtyValidators(ctx) {
+			dirty[providerAddr.String()] = true
+		}
+		// the validators whose updates are held back for a consumer chain, per tracked consumer chain
+		chainDirty := map[string]map[string]bool{}
+		hasPendingUpdate := func(chainID string, providerAddr types.ProviderConsAddress) bool {
+			if dirty[providerAddr.String()] || !k.IsConsumerValSetTracked(ctx, chainID) {
+				return true
+			}
+			if _, ok := chainDirty[chainID]; !ok {
+				chainDirty[chainID] = map[string]bool{}
+				for _, addr := range k.GetAllDirtyConsumerValidators(ctx, chainID) {
+					chainDirty[chainID][addr.String()] = true
+				}
+			}
+			return chainDirty[chainID][providerAddr.String()]
+		}
+
+		iterator, err := k.consumerValidators.Iterate(ctx, nil)
+		if err != nil {
+			panic(fmt.Errorf("failed to iterate consumer validators: %w", err))
+		}
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			kv, err := iterator.KeyValue()
+			if err != nil {
+				panic(fmt.Errorf("failed to parse consumer validator: %w", err))
+			}
+			chainID := kv.Key.K1()
+			providerAddr := types.NewProviderConsAddress(kv.Value.ProviderConsAddr)
+			if hasPendingUpdate(chainID, providerAddr) {
+				continue
+			}
+
+			validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer validator %s on chain %s is not a provider validator\n",
+					providerAddr.String(), chainID)
+				continue
+			}
+			if !validator.IsBonded() {
+				broken = true
+				msg += fmt.Sprintf("\tconsumer validator %s on chain %s is not bonded on the provider\n",
+					providerAddr.String(), chainID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "consumer-validators-bonded", msg), broken
+	}
+}
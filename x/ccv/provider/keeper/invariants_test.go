@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providerkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestConsumerRewardsPoolInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	poolAcc := authtypes.NewEmptyModuleAccount(types.ConsumerRewardsPool)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, types.ConsumerRewardsPool).Return(poolAcc).AnyTimes()
+
+	// the allocations of stopped consumer chains are accounted for as well
+	providerKeeper.SetConsumerRewardsAllocation(ctx, "chain1", types.ConsumerRewardsAllocation{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", math.LegacyNewDecWithPrec(15, 1))),
+	})
+	providerKeeper.SetConsumerRewardsAllocation(ctx, "chain2", types.ConsumerRewardsAllocation{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoin("uatom", math.NewInt(3)), sdk.NewDecCoin("untrn", math.NewInt(2))),
+	})
+
+	testCases := []struct {
+		name   string
+		pool   sdk.Coins
+		broken bool
+	}{
+		{
+			"pool covers the allocations",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("untrn", 2)),
+			false,
+		},
+		{
+			"pool holds tokens of other denoms",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("untrn", 2), sdk.NewInt64Coin("uosmo", 7)),
+			false,
+		},
+		{
+			"pool does not cover the decimals",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 4), sdk.NewInt64Coin("untrn", 2)),
+			true,
+		},
+		{
+			"pool misses a denom",
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, poolAcc.GetAddress()).Return(tc.pool).Times(1)
+
+		_, broken := providerkeeper.ConsumerRewardsPoolInvariant(providerKeeper)(ctx)
+		require.Equal(t, tc.broken, broken, tc.name)
+	}
+}
+
+func TestKeyAssignmentIndexInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	oldConsumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	// consistent indexes
+	providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, validator.ProviderConsAddress(), consumerKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, chainID, consumerKey.ConsumerConsAddress(), validator.ProviderConsAddress())
+	_, broken := providerkeeper.KeyAssignmentIndexInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+
+	// a consumer address that is neither current nor to be pruned
+	providerKeeper.SetValidatorByConsumerAddr(ctx, chainID, oldConsumerKey.ConsumerConsAddress(), validator.ProviderConsAddress())
+	_, broken = providerkeeper.KeyAssignmentIndexInvariant(providerKeeper)(ctx)
+	require.True(t, broken)
+
+	// the consumer address is waiting to be pruned
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, chainID, time.Now().UTC(), oldConsumerKey.ConsumerConsAddress())
+	_, broken = providerkeeper.KeyAssignmentIndexInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+
+	// the assigned consumer key does not map back to the validator
+	providerKeeper.DeleteValidatorByConsumerAddr(ctx, chainID, consumerKey.ConsumerConsAddress())
+	_, broken = providerkeeper.KeyAssignmentIndexInvariant(providerKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestConsumerRegistrationInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	err := providerKeeper.SetConsumerGenesis(ctx, chainID, ccvtypes.ConsumerGenesisState{})
+	require.NoError(t, err)
+	providerKeeper.SetChainToChannel(ctx, chainID, "channelID")
+	providerKeeper.SetInitChainHeight(ctx, chainID, 1)
+	providerKeeper.SetConsumerValidator(ctx, chainID, types.ConsumerValidator{
+		ProviderConsAddr: cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKValConsAddress(),
+	})
+
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(&ibctmtypes.ClientState{}, true),
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(nil, false),
+	)
+
+	_, broken := providerkeeper.ConsumerRegistrationInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+
+	// the client of the consumer chain does not exist
+	_, broken = providerkeeper.ConsumerRegistrationInvariant(providerKeeper)(ctx)
+	require.True(t, broken)
+
+	// the CCV channel and the consumer validator set belong to a chain without a client
+	providerKeeper.DeleteConsumerClientId(ctx, chainID)
+	_, broken = providerkeeper.ConsumerRegistrationInvariant(providerKeeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestConsumerValidatorsBondedInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	providerKeeper.SetConsumerValidator(ctx, chainID, types.ConsumerValidator{
+		ProviderConsAddr: identity.SDKValConsAddress(),
+		Power:            1,
+	})
+	providerKeeper.SetConsumerValSetTracked(ctx, chainID)
+
+	bonded := identity.SDKStakingValidator()
+	bonded.Status = stakingtypes.Bonded
+	unbonding := identity.SDKStakingValidator()
+	unbonding.Status = stakingtypes.Unbonding
+
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, identity.SDKValConsAddress()).Return(bonded, nil),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, identity.SDKValConsAddress()).Return(unbonding, nil),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, identity.SDKValConsAddress()).
+			Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound),
+	)
+
+	_, broken := providerkeeper.ConsumerValidatorsBondedInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+
+	// the validator started unbonding during the epoch without being marked as dirty
+	_, broken = providerkeeper.ConsumerValidatorsBondedInvariant(providerKeeper)(ctx)
+	require.True(t, broken)
+
+	// the validator was removed from the staking module without being marked as dirty
+	_, broken = providerkeeper.ConsumerValidatorsBondedInvariant(providerKeeper)(ctx)
+	require.True(t, broken)
+
+	// a validator that is dirty on the chain only, e.g., while the chain is paused, is removed
+	// from the consumer validator set at the end of the epoch of the chain
+	providerKeeper.SetDirtyConsumerValidator(ctx, chainID, identity.ProviderConsAddress())
+	_, broken = providerkeeper.ConsumerValidatorsBondedInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+	providerKeeper.DeleteAllDirtyConsumerValidators(ctx, chainID)
+
+	// the validator set of a chain that is not tracked, e.g., after a genesis import, is
+	// computed from scratch at the end of the epoch
+	providerKeeper.DeleteConsumerValSetTracked(ctx, chainID)
+	_, broken = providerkeeper.ConsumerValidatorsBondedInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+	providerKeeper.SetConsumerValSetTracked(ctx, chainID)
+
+	// a dirty validator is removed from the consumer validator set at the end of the epoch
+	providerKeeper.SetDirtyValidator(ctx, identity.ProviderConsAddress())
+	_, broken = providerkeeper.ConsumerValidatorsBondedInvariant(providerKeeper)(ctx)
+	require.False(t, broken)
+}
@@ -3,12 +3,17 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
 	addresscodec "cosmossdk.io/core/address"
 
+	"github.com/cosmos/gogoproto/proto"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -19,11 +24,14 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"cosmossdk.io/log"
 
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
 	consumertypes "github.com/allinbits/interchain-security/x/ccv/consumer/types"
 	"github.com/allinbits/interchain-security/x/ccv/provider/types"
 	ccv "github.com/allinbits/interchain-security/x/ccv/types"
@@ -49,9 +57,49 @@ type Keeper struct {
 	bankKeeper         ccv.BankKeeper
 	govKeeper          ccv.GovKeeper
 	feeCollectorName   string
+	// hooks are optional and may be nil
+	hooks ccv.ProviderHooks
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+
+	// Schema is the schema of the provider state stored in collections
+	Schema                       collections.Schema
+	chainToChannel               *collections.IndexedMap[string, string, chainToChannelIndexes]
+	chainToClient                collections.Map[string, string]
+	pendingConsumerAdditionProps collections.Map[collections.Pair[uint64, string], types.ConsumerAdditionProposal]
+	pendingConsumerRemovalProps  collections.Map[collections.Pair[uint64, string], types.ConsumerRemovalProposal]
+	proposedConsumerChains       collections.Map[uint64, string]
+	consumerRewardDenoms         collections.KeySet[string]
+	validatorConsumerPubKeys     collections.Map[collections.Pair[string, sdk.ConsAddress], tmprotocrypto.PublicKey]
+	// validatorsByConsumerAddr is kept as a map of its own rather than as an index of validatorConsumerPubKeys,
+	// since a replaced consumer address must still resolve to its provider address until it is pruned
+	validatorsByConsumerAddr collections.Map[collections.Pair[string, sdk.ConsAddress], sdk.ConsAddress]
+	consumerValidators       collections.Map[collections.Pair[string, sdk.ConsAddress], types.ConsumerValidator]
+	optedIn                  collections.KeySet[collections.Pair[string, sdk.ConsAddress]]
+	consumerMetadata         collections.Map[string, types.ConsumerMetadata]
+	consumerOwner            collections.Map[string, sdk.AccAddress]
+	pausedConsumers          collections.Map[string, types.ConsumerPause]
+	consumersToCatchUp       collections.KeySet[string]
+	stoppedConsumers         collections.Map[string, types.StoppedConsumer]
+	keyAssignmentNonces      collections.Map[collections.Pair[string, sdk.ConsAddress], uint64]
+	consumerKeyRotations     collections.Map[collections.Pair[string, sdk.ConsAddress], types.ConsumerKeyRotation]
+	keyAssignmentHistory     collections.Map[collections.Triple[string, sdk.ConsAddress, uint64], types.KeyAssignmentRecord]
+	optInPolicies            collections.Map[string, types.OptInPolicy]
+	optInRecords             collections.Map[collections.Pair[string, sdk.ConsAddress], types.OptInRecord]
+	readinessSignals         collections.Map[collections.Pair[string, sdk.ConsAddress], types.ReadinessSignal]
+	consumerEpochParams      collections.Map[string, types.ConsumerEpochParams]
+	epochState               collections.Item[types.EpochState]
+}
+
+// chainToChannelIndexes defines the indexes of the mapping from consumer chain IDs to CCV channel IDs
+type chainToChannelIndexes struct {
+	// ChannelToChain is the reverse lookup from CCV channel IDs to consumer chain IDs
+	ChannelToChain *indexes.Unique[string, string, string]
+}
+
+func (i chainToChannelIndexes) IndexesList() []collections.Index[string, string] {
+	return []collections.Index[string, string]{i.ChannelToChain}
 }
 
 // NewKeeper creates a new provider Keeper instance
@@ -67,6 +115,7 @@ func NewKeeper(
 	authority string,
 	validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
 	feeCollectorName string,
+	hooks ccv.ProviderHooks,
 ) Keeper {
 	k := Keeper{
 		cdc:       cdc,
@@ -86,12 +135,87 @@ func NewKeeper(
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
 		govKeeper:             govKeeper,
+		hooks:                 hooks,
 	}
 
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key.(*storetypes.KVStoreKey)))
+	k.chainToChannel = collections.NewIndexedMap(
+		sb, types.ChainToChannelPrefix, "chain_to_channel", collections.StringKey, collections.StringValue,
+		chainToChannelIndexes{
+			ChannelToChain: indexes.NewUnique(
+				sb, types.ChannelToChainPrefix, "channel_to_chain", collections.StringKey, collections.StringKey,
+				func(_, channelID string) (string, error) { return channelID, nil },
+			),
+		},
+	)
+	k.chainToClient = collections.NewMap(sb, types.ChainToClientPrefix, "chain_to_client",
+		collections.StringKey, collections.StringValue)
+	k.pendingConsumerAdditionProps = collections.NewMap(sb, types.PendingCAPPrefix, "pending_consumer_addition_props",
+		collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ConsumerAdditionProposal](cdc))
+	k.pendingConsumerRemovalProps = collections.NewMap(sb, types.PendingCRPPrefix, "pending_consumer_removal_props",
+		collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ConsumerRemovalProposal](cdc))
+	k.proposedConsumerChains = collections.NewMap(sb, types.ProposedConsumerChainPrefix, "proposed_consumer_chains",
+		collections.Uint64Key, collections.StringValue)
+	k.consumerRewardDenoms = collections.NewKeySet(sb, types.ConsumerRewardDenomsPrefix, "consumer_reward_denoms",
+		collections.StringKey)
+	k.validatorConsumerPubKeys = collections.NewMap(sb, types.ValidatorConsumerPubKeysPrefix, "validator_consumer_pub_keys",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), codec.CollValue[tmprotocrypto.PublicKey](cdc))
+	k.validatorsByConsumerAddr = collections.NewMap(sb, types.ValidatorsByConsumerAddrPrefix, "validators_by_consumer_addr",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), collcodec.KeyToValueCodec(sdk.ConsAddressKey))
+	k.consumerValidators = collections.NewMap(sb, types.ConsumerValidatorPrefix, "consumer_validators",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), codec.CollValue[types.ConsumerValidator](cdc))
+	k.optedIn = collections.NewKeySet(sb, types.OptedInPrefix, "opted_in",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey))
+	k.consumerMetadata = collections.NewMap(sb, types.ConsumerMetadataPrefix, "consumer_metadata",
+		types.ChainIDKey, codec.CollValue[types.ConsumerMetadata](cdc))
+	k.consumerOwner = collections.NewMap(sb, types.ConsumerOwnerPrefix, "consumer_owner",
+		types.ChainIDKey, collcodec.KeyToValueCodec(sdk.AccAddressKey))
+	k.pausedConsumers = collections.NewMap(sb, types.PausedConsumerPrefix, "paused_consumers",
+		types.ChainIDKey, codec.CollValue[types.ConsumerPause](cdc))
+	k.consumersToCatchUp = collections.NewKeySet(sb, types.ConsumerToCatchUpPrefix, "consumers_to_catch_up",
+		types.ChainIDKey)
+	k.stoppedConsumers = collections.NewMap(sb, types.StoppedConsumerPrefix, "stopped_consumers",
+		types.ChainIDKey, codec.CollValue[types.StoppedConsumer](cdc))
+	k.keyAssignmentNonces = collections.NewMap(sb, types.KeyAssignmentNoncePrefix, "key_assignment_nonces",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), collections.Uint64Value)
+	k.consumerKeyRotations = collections.NewMap(sb, types.ConsumerKeyRotationPrefix, "consumer_key_rotations",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), codec.CollValue[types.ConsumerKeyRotation](cdc))
+	k.keyAssignmentHistory = collections.NewMap(sb, types.KeyAssignmentHistoryPrefix, "key_assignment_history",
+		collections.TripleKeyCodec(types.ChainIDKey, sdk.ConsAddressKey, collections.Uint64Key),
+		codec.CollValue[types.KeyAssignmentRecord](cdc))
+	k.optInPolicies = collections.NewMap(sb, types.OptInPolicyPrefix, "opt_in_policies",
+		types.ChainIDKey, codec.CollValue[types.OptInPolicy](cdc))
+	k.optInRecords = collections.NewMap(sb, types.OptInRecordPrefix, "opt_in_records",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), codec.CollValue[types.OptInRecord](cdc))
+	k.readinessSignals = collections.NewMap(sb, types.ReadinessSignalPrefix, "readiness_signals",
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), codec.CollValue[types.ReadinessSignal](cdc))
+	k.consumerEpochParams = collections.NewMap(sb, types.ConsumerEpochParamsPrefix, "consumer_epoch_params",
+		types.ChainIDKey, codec.CollValue[types.ConsumerEpochParams](cdc))
+	k.epochState = collections.NewItem(sb, types.EpochStatePrefix, "epoch_state", codec.CollValue[types.EpochState](cdc))
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(fmt.Errorf("failed to build provider collections schema: %w", err))
+	}
+	k.Schema = schema
+
 	k.mustValidateFields()
 	return k
 }
 
+// mustGet returns the value returned by get for key and whether it was found.
+// It panics on errors other than collections.ErrNotFound, since these
+// indicate that the stored value cannot be decoded.
+func mustGet[K, V any](ctx context.Context, get func(context.Context, K) (V, error), key K) (V, bool) {
+	value, err := get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return value, false
+	} else if err != nil {
+		panic(fmt.Errorf("failed to get stored value: %w", err))
+	}
+	return value, true
+}
+
 // GetAuthority returns the x/ccv/provider module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -112,8 +236,8 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
 	// Reference: https://github.com/cosmos/interchain-security/blob/v7.0.1/x/ccv/provider/keeper/keeper.go#L113
-	if reflect.ValueOf(k).NumField() != 15 {
-		panic(fmt.Sprintf("number of fields in provider keeper is not 15 - have %d", reflect.ValueOf(k).NumField()))
+	if reflect.ValueOf(k).NumField() != 40 {
+		panic(fmt.Sprintf("number of fields in provider keeper is not 40 - have %d", reflect.ValueOf(k).NumField()))
 	}
 
 	if k.validatorAddressCodec == nil || k.consensusAddressCodec == nil {
@@ -139,6 +263,10 @@ func (k Keeper) mustValidateFields() {
 
 	// this can be nil in tests
 	// ccv.PanicIfZeroOrNil(k.govKeeper, "govKeeper")                         // 17
+
+	// the hooks are optional
+
+	// the Schema and the collections are built by NewKeeper and hence not validated
 }
 
 // Logger returns a module-specific logger.
@@ -147,6 +275,14 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 	return sdkCtx.Logger().With("module", "x/"+ibchost.ModuleName+"-"+types.ModuleName)
 }
 
+// emitTypedEvent emits the given typed event next to the string events of the module
+func (k Keeper) emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		// the typed events are defined by this module, so this should never happen
+		k.Logger(ctx).Error("failed to emit typed event", "event", proto.MessageName(event), "error", err.Error())
+	}
+}
+
 // IsBound checks if the CCV module is already bound to the desired port
 // IBC v10: Capabilities removed, always return true as port binding is handled differently
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
@@ -171,70 +307,67 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 // IBC v10: Capability methods removed - no longer needed
 
 // SetChainToChannel sets the mapping from a consumer chainID to the CCV channel ID for that consumer chain.
+// The reverse mapping from the CCV channel ID to the consumer chainID is indexed alongside.
 func (k Keeper) SetChainToChannel(ctx sdk.Context, chainID, channelID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ChainToChannelKey(chainID), []byte(channelID))
+	if err := k.chainToChannel.Set(ctx, chainID, channelID); err != nil {
+		// An error here would indicate something is very wrong,
+		// e.g., the CCV channel is already mapped to a different consumer chain.
+		panic(fmt.Errorf("failed to set CCV channel for consumer chain %s: %w", chainID, err))
+	}
 }
 
 // GetChainToChannel gets the CCV channelID for the given consumer chainID
 func (k Keeper) GetChainToChannel(ctx sdk.Context, chainID string) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ChainToChannelKey(chainID))
-	if bz == nil {
-		return "", false
-	}
-	return string(bz), true
+	return mustGet(ctx, k.chainToChannel.Get, chainID)
 }
 
-// DeleteChainToChannel deletes the CCV channel ID for the given consumer chain ID
+// DeleteChainToChannel deletes the CCV channel ID for the given consumer chain ID,
+// together with the reverse mapping from the CCV channel ID to the consumer chain ID
 func (k Keeper) DeleteChainToChannel(ctx sdk.Context, chainID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ChainToChannelKey(chainID))
+	if err := k.chainToChannel.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete CCV channel for consumer chain %s: %w", chainID, err))
+	}
 }
 
 // SetProposedConsumerChain stores a consumer chainId corresponding to a submitted consumer addition proposal
 // This consumer chainId is deleted once the voting period for the proposal ends.
 func (k Keeper) SetProposedConsumerChain(ctx sdk.Context, chainID string, proposalID uint64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ProposedConsumerChainKey(proposalID), []byte(chainID))
+	if err := k.proposedConsumerChains.Set(ctx, proposalID, chainID); err != nil {
+		panic(fmt.Errorf("failed to set proposed consumer chain: %w", err))
+	}
 }
 
 // GetProposedConsumerChain returns the proposed chainID for the given consumerAddition proposal ID.
 // This method is only used for testing.
 func (k Keeper) GetProposedConsumerChain(ctx sdk.Context, proposalID uint64) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
-	consumerChain := store.Get(types.ProposedConsumerChainKey(proposalID))
-	if consumerChain != nil {
-		return string(consumerChain), true
-	}
-	return "", false
+	return mustGet(ctx, k.proposedConsumerChains.Get, proposalID)
 }
 
 // DeleteProposedConsumerChainInStore deletes the consumer chainID from store
 // which is in gov consumerAddition proposal
 func (k Keeper) DeleteProposedConsumerChainInStore(ctx sdk.Context, proposalID uint64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ProposedConsumerChainKey(proposalID))
+	if err := k.proposedConsumerChains.Remove(ctx, proposalID); err != nil {
+		panic(fmt.Errorf("failed to delete proposed consumer chain: %w", err))
+	}
 }
 
 // GetAllProposedConsumerChainIDs returns the proposed chainID of all gov consumerAddition proposals that are still in the voting period.
 func (k Keeper) GetAllProposedConsumerChainIDs(ctx sdk.Context) []types.ProposedChain {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ProposedConsumerChainByteKey})
-	defer iterator.Close()
+	iterator, err := k.proposedConsumerChains.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate proposed consumer chains: %w", err))
+	}
+	kvs, err := iterator.KeyValues()
+	if err != nil {
+		panic(fmt.Errorf("proposed chains cannot be parsed: %w", err))
+	}
 
 	proposedChains := []types.ProposedChain{}
-	for ; iterator.Valid(); iterator.Next() {
-		proposalID, err := types.ParseProposedConsumerChainKey(types.ProposedConsumerChainByteKey, iterator.Key())
-		if err != nil {
-			panic(fmt.Errorf("proposed chains cannot be parsed: %w", err))
-		}
-
+	for _, kv := range kvs {
 		proposedChains = append(proposedChains, types.ProposedChain{
-			ChainID:    string(iterator.Value()),
-			ProposalID: proposalID,
+			ChainID:    kv.Value,
+			ProposalID: kv.Key,
 		})
-
 	}
 
 	return proposedChains
@@ -258,41 +391,24 @@ func (k Keeper) GetAllPendingConsumerChainIDs(ctx sdk.Context) []string {
 // ChainToClientBytePrefix | chainID
 // Thus, the returned array is in ascending order of chainIDs.
 func (k Keeper) GetAllRegisteredConsumerChainIDs(ctx sdk.Context) []string {
-	chainIDs := []string{}
-
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ChainToClientBytePrefix})
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		// remove 1 byte prefix from key to retrieve chainID
-		chainID := string(iterator.Key()[1:])
-		chainIDs = append(chainIDs, chainID)
+	iterator, err := k.chainToClient.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer clients: %w", err))
+	}
+	chainIDs, err := iterator.Keys()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse consumer chain IDs: %w", err))
 	}
 
+	if chainIDs == nil {
+		return []string{}
+	}
 	return chainIDs
 }
 
-// SetChannelToChain sets the mapping from the CCV channel ID to the consumer chainID.
-func (k Keeper) SetChannelToChain(ctx sdk.Context, channelID, chainID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ChannelToChainKey(channelID), []byte(chainID))
-}
-
 // GetChannelToChain gets the consumer chainID for a given CCV channelID
 func (k Keeper) GetChannelToChain(ctx sdk.Context, channelID string) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ChannelToChainKey(channelID))
-	if bz == nil {
-		return "", false
-	}
-	return string(bz), true
-}
-
-// DeleteChannelToChain deletes the consumer chain ID for a given CCV channelID
-func (k Keeper) DeleteChannelToChain(ctx sdk.Context, channelID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ChannelToChainKey(channelID))
+	return mustGet(ctx, k.chainToChannel.Indexes.ChannelToChain.MatchExact, channelID)
 }
 
 // GetAllChannelToChains gets all channel to chain mappings. If a mapping exists,
@@ -303,18 +419,19 @@ func (k Keeper) DeleteChannelToChain(ctx sdk.Context, channelID string) {
 // ChannelToChainBytePrefix | channelID
 // Thus, the returned array is in ascending order of channelIDs.
 func (k Keeper) GetAllChannelToChains(ctx sdk.Context) (channels []types.ChannelToChain) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ChannelToChainBytePrefix})
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		// remove prefix from key to retrieve channelID
-		channelID := string(iterator.Key()[1:])
-		chainID := string(iterator.Value())
+	iterator, err := k.chainToChannel.Indexes.ChannelToChain.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate CCV channels: %w", err))
+	}
+	keys, err := iterator.FullKeys()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse CCV channels: %w", err))
+	}
 
+	for _, key := range keys {
 		channels = append(channels, types.ChannelToChain{
-			ChannelId: channelID,
-			ChainId:   chainID,
+			ChannelId: key.K1(),
+			ChainId:   key.K2(),
 		})
 	}
 
@@ -408,9 +525,10 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 	// the CCV channel is established:
 	// - set channel mappings
 	k.SetChainToChannel(ctx, chainID, channelID)
-	k.SetChannelToChain(ctx, channelID, chainID)
 	// - set current block height for the consumer chain initialization
 	k.SetInitChainHeight(ctx, chainID, uint64(ctx.BlockHeight()))
+	// - count the commitments of the validators that opted in before from the current epoch
+	k.startOptInCommitments(ctx, chainID)
 
 	// emit event on successful addition
 	ctx.EventManager().EmitEvent(
@@ -423,6 +541,12 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 			sdk.NewAttribute(conntypes.AttributeKeyConnectionID, connectionID),
 		),
 	)
+	k.emitTypedEvent(ctx, &types.EventCCVChannelEstablished{
+		ChainId:      chainID,
+		ClientId:     clientID,
+		ConnectionId: connectionID,
+		ChannelId:    channelID,
+	})
 	return nil
 }
 
@@ -659,24 +783,21 @@ func (k Keeper) DeletePendingVSCPackets(ctx sdk.Context, chainID string) {
 
 // SetConsumerClientId sets the client ID for the given chain ID
 func (k Keeper) SetConsumerClientId(ctx sdk.Context, chainID, clientID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.ChainToClientKey(chainID), []byte(clientID))
+	if err := k.chainToClient.Set(ctx, chainID, clientID); err != nil {
+		panic(fmt.Errorf("failed to set consumer client ID: %w", err))
+	}
 }
 
 // GetConsumerClientId returns the client ID for the given chain ID.
 func (k Keeper) GetConsumerClientId(ctx sdk.Context, chainID string) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
-	clientIdBytes := store.Get(types.ChainToClientKey(chainID))
-	if clientIdBytes == nil {
-		return "", false
-	}
-	return string(clientIdBytes), true
+	return mustGet(ctx, k.chainToClient.Get, chainID)
 }
 
 // DeleteConsumerClientId removes from the store the clientID for the given chainID.
 func (k Keeper) DeleteConsumerClientId(ctx sdk.Context, chainID string) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ChainToClientKey(chainID))
+	if err := k.chainToClient.Remove(ctx, chainID); err != nil {
+		panic(fmt.Errorf("failed to delete consumer client ID: %w", err))
+	}
 }
 
 // SetSlashLog updates validator's slash log for a consumer chain
@@ -771,8 +892,9 @@ func (k Keeper) SetOptedIn(
 	chainID string,
 	providerConsAddress types.ProviderConsAddress,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.OptedInKey(chainID, providerConsAddress), []byte{})
+	if err := k.optedIn.Set(ctx, collections.Join(chainID, providerConsAddress.ToSdkConsAddr())); err != nil {
+		panic(fmt.Errorf("failed to set opted-in validator: %w", err))
+	}
 }
 
 func (k Keeper) DeleteOptedIn(
@@ -780,8 +902,9 @@ func (k Keeper) DeleteOptedIn(
 	chainID string,
 	providerAddr types.ProviderConsAddress,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.OptedInKey(chainID, providerAddr))
+	if err := k.optedIn.Remove(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr())); err != nil {
+		panic(fmt.Errorf("failed to delete opted-in validator: %w", err))
+	}
 }
 
 func (k Keeper) IsOptedIn(
@@ -789,21 +912,28 @@ func (k Keeper) IsOptedIn(
 	chainID string,
 	providerAddr types.ProviderConsAddress,
 ) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Get(types.OptedInKey(chainID, providerAddr)) != nil
+	optedIn, err := k.optedIn.Has(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+	if err != nil {
+		panic(fmt.Errorf("failed to check opted-in validator: %w", err))
+	}
+	return optedIn
 }
 
 func (k Keeper) GetAllOptedIn(
 	ctx sdk.Context,
 	chainID string,
 ) (providerConsAddresses []types.ProviderConsAddress) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.ChainIdWithLenKey(types.OptedInBytePrefix, chainID)
-	iterator := storetypes.KVStorePrefixIterator(store, key)
-	defer iterator.Close()
+	iterator, err := k.optedIn.Iterate(ctx, collections.NewPrefixedPairRange[string, sdk.ConsAddress](chainID))
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate opted-in validators: %w", err))
+	}
+	keys, err := iterator.Keys()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse opted-in validators: %w", err))
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		providerConsAddresses = append(providerConsAddresses, types.NewProviderConsAddress(iterator.Key()[len(key):]))
+	for _, key := range keys {
+		providerConsAddresses = append(providerConsAddresses, types.NewProviderConsAddress(key.K2()))
 	}
 
 	return providerConsAddresses
@@ -813,18 +943,9 @@ func (k Keeper) DeleteAllOptedIn(
 	ctx sdk.Context,
 	chainID string,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.ChainIdWithLenKey(types.OptedInBytePrefix, chainID)
-	iterator := storetypes.KVStorePrefixIterator(store, key)
-
-	keysToDel := [][]byte{}
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		keysToDel = append(keysToDel, iterator.Key())
-	}
-
-	for _, key := range keysToDel {
-		store.Delete(key)
+	err := k.optedIn.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.ConsAddress](chainID))
+	if err != nil {
+		panic(fmt.Errorf("failed to delete opted-in validators: %w", err))
 	}
 }
 
@@ -239,6 +239,39 @@ func TestGetAllRegisteredConsumerChainIDs(t *testing.T) {
 	require.Equal(t, expectedChainIDs, result)
 }
 
+// TestChainToChannelIndex tests that the channel to chain index is kept
+// consistent with the chain to channel mapping
+func TestChainToChannelIndex(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	pk.SetChainToChannel(ctx, "chain-1", "channel-1")
+	pk.SetChainToChannel(ctx, "chain-2", "channel-2")
+	chainID, found := pk.GetChannelToChain(ctx, "channel-1")
+	require.True(t, found)
+	require.Equal(t, "chain-1", chainID)
+
+	// a CCV channel cannot be used by two consumer chains
+	require.Panics(t, func() { pk.SetChainToChannel(ctx, "chain-3", "channel-1") })
+
+	// replacing the channel of a chain replaces the index entry
+	pk.SetChainToChannel(ctx, "chain-1", "channel-3")
+	_, found = pk.GetChannelToChain(ctx, "channel-1")
+	require.False(t, found)
+	chainID, found = pk.GetChannelToChain(ctx, "channel-3")
+	require.True(t, found)
+	require.Equal(t, "chain-1", chainID)
+
+	// deleting the channel of a chain deletes the index entry
+	pk.DeleteChainToChannel(ctx, "chain-2")
+	_, found = pk.GetChannelToChain(ctx, "channel-2")
+	require.False(t, found)
+	// deleting it twice is a no-op
+	pk.DeleteChainToChannel(ctx, "chain-2")
+
+	require.Equal(t, []types.ChannelToChain{{ChannelId: "channel-3", ChainId: "chain-1"}}, pk.GetAllChannelToChains(ctx))
+}
+
 // TestSetSlashLog tests slash log getter and setter methods
 func TestSetSlashLog(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
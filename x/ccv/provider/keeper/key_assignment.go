@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -17,8 +19,9 @@ import (
 	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
 )
 
-// ParseConsumerKey parses the ED25519 PubKey`consumerKey` from a JSON string
-// and constructs its corresponding `tmprotocrypto.PublicKey`
+// ParseConsumerKey parses the ED25519 or SECP256K1 PubKey`consumerKey` from a JSON string
+// and constructs its corresponding `tmprotocrypto.PublicKey`. BLS12-381 keys are rejected,
+// as CometBFT v0.38 has no BLS12-381 validator keys.
 func (k Keeper) ParseConsumerKey(consumerKey string) (tmprotocrypto.PublicKey, error) {
 	// parse consumer key as long as it's in the right format
 	pkType, keyStr, err := types.ParseConsumerKeyFromJson(consumerKey)
@@ -41,12 +44,12 @@ func (k Keeper) ParseConsumerKey(consumerKey string) (tmprotocrypto.PublicKey, e
 	// 	}
 	// }
 
-	// For now, only accept ed25519.
-	// TODO: decide what types should be supported.
-	if pkType != "/cosmos.crypto.ed25519.PubKey" {
+	// Accept the key types that CometBFT supports for validators. Whether a key type
+	// can be used on a given consumer chain is checked when the key is assigned.
+	if pkType != "/cosmos.crypto.ed25519.PubKey" && pkType != "/cosmos.crypto.secp256k1.PubKey" {
 		return tmprotocrypto.PublicKey{}, errorsmod.Wrapf(
 			stakingtypes.ErrValidatorPubKeyTypeNotSupported,
-			"got: %s, expected: %s", pkType, "/cosmos.crypto.ed25519.PubKey",
+			"got: %s, expected one of: %s, %s", pkType, "/cosmos.crypto.ed25519.PubKey", "/cosmos.crypto.secp256k1.PubKey",
 		)
 	}
 
@@ -55,6 +58,14 @@ func (k Keeper) ParseConsumerKey(consumerKey string) (tmprotocrypto.PublicKey, e
 		return tmprotocrypto.PublicKey{}, err
 	}
 
+	if pkType == "/cosmos.crypto.secp256k1.PubKey" {
+		return tmprotocrypto.PublicKey{
+			Sum: &tmprotocrypto.PublicKey_Secp256K1{
+				Secp256K1: pubKeyBytes,
+			},
+		}, nil
+	}
+
 	consumerTMPublicKey := tmprotocrypto.PublicKey{
 		Sum: &tmprotocrypto.PublicKey_Ed25519{
 			Ed25519: pubKeyBytes,
@@ -64,24 +75,113 @@ func (k Keeper) ParseConsumerKey(consumerKey string) (tmprotocrypto.PublicKey, e
 	return consumerTMPublicKey, nil
 }
 
-// GetValidatorConsumerPubKey returns a validator's public key assigned for a consumer chain
-func (k Keeper) GetValidatorConsumerPubKey(
+// GetAllowedKeyTypes returns the consensus key types declared by the given consumer chain, as found in
+// its consumer genesis or, before the chain is launched, in its pending consumer addition proposal.
+// If the chain does not declare any key types, only the default key types are allowed.
+func (k Keeper) GetAllowedKeyTypes(ctx sdk.Context, chainID string) []string {
+	if gen, found := k.GetConsumerGenesis(ctx, chainID); found {
+		if len(gen.Params.AllowedKeyTypes) > 0 {
+			return gen.Params.AllowedKeyTypes
+		}
+		return ccvtypes.DefaultAllowedKeyTypes
+	}
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId == chainID && len(prop.AllowedKeyTypes) > 0 {
+			return prop.AllowedKeyTypes
+		}
+	}
+	return ccvtypes.DefaultAllowedKeyTypes
+}
+
+// IsKeyTypeAllowed returns true if the validators of the given consumer chain can use the given consensus key
+func (k Keeper) IsKeyTypeAllowed(ctx sdk.Context, chainID string, key tmprotocrypto.PublicKey) bool {
+	keyType, err := ccvtypes.ConsensusKeyType(key)
+	if err != nil {
+		return false
+	}
+	return ccvtypes.IsKeyTypeAllowed(k.GetAllowedKeyTypes(ctx, chainID), keyType)
+}
+
+// VerifyConsumerKeyPossession checks that the signature was made with the private key of consumerKey
+// over the consumer key possession payload of the chain, the provider validator and the nonce, and that
+// the nonce is greater than the last nonce used by the validator on the chain. The nonce is then recorded,
+// so that the signature cannot be replayed. It must be called before assigning the consumer key.
+func (k Keeper) VerifyConsumerKeyPossession(
 	ctx sdk.Context,
 	chainID string,
+	providerValAddr string,
 	providerAddr types.ProviderConsAddress,
-) (consumerKey tmprotocrypto.PublicKey, found bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ConsumerValidatorsKey(chainID, providerAddr))
-	if bz == nil {
-		return consumerKey, false
+	consumerKey tmprotocrypto.PublicKey,
+	nonce uint64,
+	signature []byte,
+) error {
+	if lastNonce, found := k.GetKeyAssignmentNonce(ctx, chainID, providerAddr); found && nonce <= lastNonce {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerKeyPossession,
+			"nonce %d must be greater than the last nonce %d", nonce, lastNonce)
 	}
-	err := consumerKey.Unmarshal(bz)
+
+	pubKey, err := cryptocodec.FromCmtProtoPublicKey(consumerKey)
 	if err != nil {
-		// An error here would indicate something is very wrong,
-		// the consumer key is assumed to be correctly serialized in SetValidatorConsumerPubKey.
-		panic(fmt.Sprintf("failed to unmarshal consumer key: %v", err))
+		return errorsmod.Wrap(types.ErrInvalidConsumerConsensusPubKey, err.Error())
 	}
-	return consumerKey, true
+	if !pubKey.VerifySignature(types.ConsumerKeyPossessionBytes(chainID, providerValAddr, nonce), signature) {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerKeyPossession,
+			"invalid signature of the consumer key possession by validator %s on chain %s", providerValAddr, chainID)
+	}
+
+	k.SetKeyAssignmentNonce(ctx, chainID, providerAddr, nonce)
+	return nil
+}
+
+// GetKeyAssignmentNonce returns the last nonce used by a validator to prove the possession of a consumer key
+func (k Keeper) GetKeyAssignmentNonce(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) (nonce uint64, found bool) {
+	return mustGet(ctx, k.keyAssignmentNonces.Get, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+}
+
+// SetKeyAssignmentNonce sets the last nonce used by a validator to prove the possession of a consumer key
+func (k Keeper) SetKeyAssignmentNonce(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	nonce uint64,
+) {
+	if err := k.keyAssignmentNonces.Set(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()), nonce); err != nil {
+		panic(fmt.Errorf("failed to set key assignment nonce: %w", err))
+	}
+}
+
+// GetAllKeyAssignmentNonces returns the last nonces used to prove the possession of consumer keys,
+// ordered by chain ID length, chain ID and provider address.
+//
+// Note that the nonces are kept when a consumer chain is stopped,
+// so that the signatures cannot be replayed if the chain is relaunched.
+func (k Keeper) GetAllKeyAssignmentNonces(ctx sdk.Context) (nonces []types.KeyAssignmentNonce) {
+	err := k.keyAssignmentNonces.Walk(ctx, nil,
+		func(key collections.Pair[string, sdk.ConsAddress], nonce uint64) (bool, error) {
+			nonces = append(nonces, types.KeyAssignmentNonce{
+				ChainId:      key.K1(),
+				ProviderAddr: key.K2(),
+				Nonce:        nonce,
+			})
+			return false, nil
+		})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate key assignment nonces: %w", err))
+	}
+	return nonces
+}
+
+// GetValidatorConsumerPubKey returns a validator's public key assigned for a consumer chain
+func (k Keeper) GetValidatorConsumerPubKey(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) (consumerKey tmprotocrypto.PublicKey, found bool) {
+	return mustGet(ctx, k.validatorConsumerPubKeys.Get, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
 }
 
 // SetValidatorConsumerPubKey sets a validator's public key assigned for a consumer chain
@@ -91,14 +191,12 @@ func (k Keeper) SetValidatorConsumerPubKey(
 	providerAddr types.ProviderConsAddress,
 	consumerKey tmprotocrypto.PublicKey,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := consumerKey.Marshal()
+	err := k.validatorConsumerPubKeys.Set(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()), consumerKey)
 	if err != nil {
 		// An error here would indicate something is very wrong,
 		// the consumer key is obtained from GetValidatorConsumerPubKey, called from
 		panic(fmt.Sprintf("failed to marshal consumer key: %v", err))
 	}
-	store.Set(types.ConsumerValidatorsKey(chainID, providerAddr), bz)
 }
 
 // GetAllValidatorConsumerPubKeys gets all the validators public keys assigned for a consumer chain
@@ -108,41 +206,27 @@ func (k Keeper) SetValidatorConsumerPubKey(
 // with the following format: ConsumerValidatorsBytePrefix | len(chainID) | chainID | providerAddress
 // Thus, the returned array is
 //   - in ascending order of providerAddresses, if chainID is not nil;
-//   - in undetermined order, if chainID is nil.
+//   - in ascending order of (len(chainID), chainID, providerAddress), if chainID is nil.
 func (k Keeper) GetAllValidatorConsumerPubKeys(ctx sdk.Context, chainID *string) (validatorConsumerPubKeys []types.ValidatorConsumerPubKey) {
-	store := ctx.KVStore(k.storeKey)
-	var prefix []byte
-	if chainID == nil {
-		// iterate over the validators public keys assigned for all consumer chains
-		prefix = []byte{types.ConsumerValidatorsBytePrefix}
-	} else {
-		// iterate over the validators public keys assigned for chainID
-		prefix = types.ChainIdWithLenKey(types.ConsumerValidatorsBytePrefix, *chainID)
-	}
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		// TODO: store chainID and provider cons address in value bytes, marshaled as protobuf type
-		chainID, providerAddrTmp, err := types.ParseChainIdAndConsAddrKey(types.ConsumerValidatorsBytePrefix, iterator.Key())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// the store key is assumed to be correctly serialized in SetValidatorConsumerPubKey.
-			panic(err)
-		}
-		providerAddr := types.NewProviderConsAddress(providerAddrTmp)
-		var consumerKey tmprotocrypto.PublicKey
-		err = consumerKey.Unmarshal(iterator.Value())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// the consumer key is assumed to be correctly serialized in SetValidatorConsumerPubKey.
-			panic(fmt.Sprintf("failed to unmarshal consumer key: %v", err))
-		}
-
-		validatorConsumerPubKeys = append(validatorConsumerPubKeys, types.ValidatorConsumerPubKey{
-			ChainId:      chainID,
-			ProviderAddr: providerAddr.ToSdkConsAddr(),
-			ConsumerKey:  &consumerKey,
+	// iterate over the validators public keys assigned for all consumer chains
+	// or only over the ones assigned for chainID
+	var ranger collections.Ranger[collections.Pair[string, sdk.ConsAddress]]
+	if chainID != nil {
+		ranger = collections.NewPrefixedPairRange[string, sdk.ConsAddress](*chainID)
+	}
+	err := k.validatorConsumerPubKeys.Walk(ctx, ranger,
+		func(key collections.Pair[string, sdk.ConsAddress], consumerKey tmprotocrypto.PublicKey) (bool, error) {
+			validatorConsumerPubKeys = append(validatorConsumerPubKeys, types.ValidatorConsumerPubKey{
+				ChainId:      key.K1(),
+				ProviderAddr: key.K2(),
+				ConsumerKey:  &consumerKey,
+			})
+			return false, nil
 		})
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the consumer key is assumed to be correctly serialized in SetValidatorConsumerPubKey.
+		panic(fmt.Sprintf("failed to unmarshal consumer key: %v", err))
 	}
 
 	return validatorConsumerPubKeys
@@ -150,8 +234,9 @@ func (k Keeper) GetAllValidatorConsumerPubKeys(ctx sdk.Context, chainID *string)
 
 // DeleteValidatorConsumerPubKey deletes a validator's public key assigned for a consumer chain
 func (k Keeper) DeleteValidatorConsumerPubKey(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ConsumerValidatorsKey(chainID, providerAddr))
+	if err := k.validatorConsumerPubKeys.Remove(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr())); err != nil {
+		panic(fmt.Sprintf("failed to delete consumer key: %v", err))
+	}
 }
 
 // GetValidatorByConsumerAddr returns a validator's consensus address on the provider
@@ -161,13 +246,11 @@ func (k Keeper) GetValidatorByConsumerAddr(
 	chainID string,
 	consumerAddr types.ConsumerConsAddress,
 ) (providerAddr types.ProviderConsAddress, found bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.ValidatorsByConsumerAddrKey(chainID, consumerAddr))
-	if bz == nil {
+	addr, found := mustGet(ctx, k.validatorsByConsumerAddr.Get, collections.Join(chainID, consumerAddr.ToSdkConsAddr()))
+	if !found {
 		return providerAddr, false
 	}
-	providerAddr = types.NewProviderConsAddress(bz)
-	return providerAddr, true
+	return types.NewProviderConsAddress(addr), true
 }
 
 // SetValidatorByConsumerAddr sets the mapping from a validator's consensus address on a consumer
@@ -178,10 +261,10 @@ func (k Keeper) SetValidatorByConsumerAddr(
 	consumerAddr types.ConsumerConsAddress,
 	providerAddr types.ProviderConsAddress,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	// Cons address is a type alias for a byte string, no marshaling needed
-	bz := providerAddr.ToSdkConsAddr()
-	store.Set(types.ValidatorsByConsumerAddrKey(chainID, consumerAddr), bz)
+	err := k.validatorsByConsumerAddr.Set(ctx, collections.Join(chainID, consumerAddr.ToSdkConsAddr()), providerAddr.ToSdkConsAddr())
+	if err != nil {
+		panic(fmt.Sprintf("failed to set validator by consumer address: %v", err))
+	}
 }
 
 // GetValidatorsByConsumerAddrs gets all the mappings from consensus addresses
@@ -192,35 +275,27 @@ func (k Keeper) SetValidatorByConsumerAddr(
 // ValidatorsByConsumerAddrBytePrefix | len(chainID) | chainID | consumerAddress
 // Thus, the returned array is
 //   - in ascending order of consumerAddresses, if chainID is not nil;
-//   - in undetermined order, if chainID is nil.
+//   - in ascending order of (len(chainID), chainID, consumerAddress), if chainID is nil.
 func (k Keeper) GetAllValidatorsByConsumerAddr(ctx sdk.Context, chainID *string) (validatorConsumerAddrs []types.ValidatorByConsumerAddr) {
-	store := ctx.KVStore(k.storeKey)
-	var prefix []byte
-	if chainID == nil {
-		// iterate over the mappings from consensus addresses on all consumer chains
-		prefix = []byte{types.ValidatorsByConsumerAddrBytePrefix}
-	} else {
-		// iterate over the mappings from consensus addresses on chainID
-		prefix = types.ChainIdWithLenKey(types.ValidatorsByConsumerAddrBytePrefix, *chainID)
-	}
-	iterator := storetypes.KVStorePrefixIterator(store, prefix)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		// TODO: store chainID and consumer cons address in value bytes, marshaled as protobuf type
-		chainID, consumerAddrTmp, err := types.ParseChainIdAndConsAddrKey(types.ValidatorsByConsumerAddrBytePrefix, iterator.Key())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// store keys are assumed to be correctly serialized in SetValidatorByConsumerAddr.
-			panic(fmt.Sprintf("failed to parse chainID and consumer address: %v", err))
-		}
-		consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
-		providerAddr := types.NewProviderConsAddress(iterator.Value())
-
-		validatorConsumerAddrs = append(validatorConsumerAddrs, types.ValidatorByConsumerAddr{
-			ConsumerAddr: consumerAddr.ToSdkConsAddr(),
-			ProviderAddr: providerAddr.ToSdkConsAddr(),
-			ChainId:      chainID,
+	// iterate over the mappings from consensus addresses on all consumer chains
+	// or only over the mappings from consensus addresses on chainID
+	var ranger collections.Ranger[collections.Pair[string, sdk.ConsAddress]]
+	if chainID != nil {
+		ranger = collections.NewPrefixedPairRange[string, sdk.ConsAddress](*chainID)
+	}
+	err := k.validatorsByConsumerAddr.Walk(ctx, ranger,
+		func(key collections.Pair[string, sdk.ConsAddress], providerAddr sdk.ConsAddress) (bool, error) {
+			validatorConsumerAddrs = append(validatorConsumerAddrs, types.ValidatorByConsumerAddr{
+				ConsumerAddr: key.K2(),
+				ProviderAddr: providerAddr,
+				ChainId:      key.K1(),
+			})
+			return false, nil
 		})
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// store keys are assumed to be correctly serialized in SetValidatorByConsumerAddr.
+		panic(fmt.Sprintf("failed to parse chainID and consumer address: %v", err))
 	}
 
 	return validatorConsumerAddrs
@@ -229,8 +304,9 @@ func (k Keeper) GetAllValidatorsByConsumerAddr(ctx sdk.Context, chainID *string)
 // DeleteValidatorByConsumerAddr deletes the mapping from a validator's consensus address on a consumer
 // to the validator's consensus address on the provider
 func (k Keeper) DeleteValidatorByConsumerAddr(ctx sdk.Context, chainID string, consumerAddr types.ConsumerConsAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ValidatorsByConsumerAddrKey(chainID, consumerAddr))
+	if err := k.validatorsByConsumerAddr.Remove(ctx, collections.Join(chainID, consumerAddr.ToSdkConsAddr())); err != nil {
+		panic(fmt.Sprintf("failed to delete validator by consumer address: %v", err))
+	}
 }
 
 // AppendConsumerAddrsToPrune appends a consumer validator address to the list of consumer addresses
@@ -262,6 +338,7 @@ func (k Keeper) AppendConsumerAddrsToPrune(ctx sdk.Context, chainID string, prun
 		panic(err)
 	}
 	store.Set(storeKey, bz)
+	store.Set(types.ConsumerAddrsToPruneTimeIndexKey(pruneTs, chainID), []byte{})
 }
 
 // GetConsumerAddrsToPrune returns the list of consumer addresses
@@ -304,7 +381,8 @@ func (k Keeper) ConsumeConsumerAddrsToPrune(ctx sdk.Context, chainID string, ts
 	var keysToDel [][]byte
 	for ; iterator.Valid(); iterator.Next() {
 		// Sanity check
-		if _, pruneTs, err := types.ParseChainIdAndTsKey(consumerAddrsToPruneKeyPrefix, iterator.Key()); err != nil {
+		_, pruneTs, err := types.ParseChainIdAndTsKey(consumerAddrsToPruneKeyPrefix, iterator.Key())
+		if err != nil {
 			// An error here would indicate something is very wrong,
 			// store keys are assumed to be correctly serialized in AppendConsumerAddrsToPrune.
 			k.Logger(ctx).Error("ParseChainIdAndTsKey failed",
@@ -318,7 +396,7 @@ func (k Keeper) ConsumeConsumerAddrsToPrune(ctx sdk.Context, chainID string, ts
 			continue
 		}
 
-		keysToDel = append(keysToDel, iterator.Key())
+		keysToDel = append(keysToDel, iterator.Key(), types.ConsumerAddrsToPruneTimeIndexKey(pruneTs, chainID))
 
 		var addrs types.AddressList
 		if err := addrs.Unmarshal(iterator.Value()); err != nil {
@@ -381,6 +459,54 @@ func (k Keeper) GetAllConsumerAddrsToPrune(ctx sdk.Context, chainID string) (con
 func (k Keeper) DeleteConsumerAddrsToPruneV2(ctx sdk.Context, chainID string, pruneTs time.Time) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerAddrsToPruneV2Key(chainID, pruneTs))
+	store.Delete(types.ConsumerAddrsToPruneTimeIndexKey(pruneTs, chainID))
+}
+
+// delayConsumerAddrsToPrune postpones the pruning of all the consumer addresses of the given
+// consumer chain by delay, keeping the order in which they are pruned
+func (k Keeper) delayConsumerAddrsToPrune(ctx sdk.Context, chainID string, delay time.Duration) {
+	consumerAddrsToPrune := k.GetAllConsumerAddrsToPrune(ctx, chainID)
+	// delete all the entries first, as a delayed entry may fall on the timestamp of another entry
+	for _, item := range consumerAddrsToPrune {
+		k.DeleteConsumerAddrsToPruneV2(ctx, chainID, item.PruneTs)
+	}
+	for _, item := range consumerAddrsToPrune {
+		for _, addrBz := range item.ConsumerAddrs.Addresses {
+			k.AppendConsumerAddrsToPrune(ctx, chainID, item.PruneTs.Add(delay), types.NewConsumerConsAddress(addrBz))
+		}
+	}
+}
+
+// GetConsumerChainsWithAddrsToPrune returns the IDs of the consumer chains that have
+// consumer addresses that can be pruned at timestamp ts, without duplicates.
+//
+// Note that the cross-chain index is stored under keys with the following format:
+// ConsumerAddrsToPruneTimeIndexBytePrefix | pruneTs.UnixNano() | chainID
+// Thus, the iteration stops at the first entry with pruneTs > ts and the cost of this
+// method depends only on the number of entries that are due.
+func (k Keeper) GetConsumerChainsWithAddrsToPrune(ctx sdk.Context, ts time.Time) (chainIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ConsumerAddrsToPruneTimeIndexBytePrefix})
+	defer iterator.Close()
+
+	seen := map[string]bool{}
+	for ; iterator.Valid(); iterator.Next() {
+		pruneTs, chainID, err := types.ParseConsumerAddrsToPruneTimeIndexKey(iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// store keys are assumed to be correctly serialized in AppendConsumerAddrsToPrune.
+			panic(fmt.Errorf("failed to parse consumer addresses to prune time index key: %w", err))
+		}
+		if pruneTs.After(ts) {
+			break
+		}
+		if !seen[chainID] {
+			seen[chainID] = true
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+
+	return chainIDs
 }
 
 // AssignConsumerKey assigns the consumerKey to the validator with providerAddr
@@ -391,6 +517,30 @@ func (k Keeper) AssignConsumerKey(
 	chainID string,
 	validator stakingtypes.Validator,
 	consumerKey tmprotocrypto.PublicKey,
+) error {
+	if err := k.assignConsumerKey(ctx, chainID, validator, consumerKey); err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		k.callHookInCachedCtx(ctx, "AfterConsumerKeyAssigned", func(cachedCtx sdk.Context) error {
+			return k.hooks.AfterConsumerKeyAssigned(cachedCtx, chainID, consAddr, consumerKey)
+		})
+	}
+	return nil
+}
+
+// assignConsumerKey assigns the consumer key as AssignConsumerKey does, but without calling
+// the provider hooks, e.g., to check in a cached context whether a key can be assigned
+func (k Keeper) assignConsumerKey(
+	ctx sdk.Context,
+	chainID string,
+	validator stakingtypes.Validator,
+	consumerKey tmprotocrypto.PublicKey,
 ) error {
 	// check that the consumer chain is either registered or that
 	// a ConsumerAdditionProposal was submitted.
@@ -400,6 +550,13 @@ func (k Keeper) AssignConsumerKey(
 		)
 	}
 
+	if !k.IsKeyTypeAllowed(ctx, chainID, consumerKey) {
+		return errorsmod.Wrapf(
+			types.ErrConsumerKeyTypeNotAllowed,
+			"allowed key types on consumer chain %s: %v", chainID, k.GetAllowedKeyTypes(ctx, chainID),
+		)
+	}
+
 	consAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
 	if err != nil {
 		return err
@@ -474,12 +631,16 @@ func (k Keeper) AssignConsumerKey(
 	// overwrite if already exists
 	// note: this state is deleted when the validator is removed from the staking module
 	k.SetValidatorConsumerPubKey(ctx, chainID, providerAddr, consumerKey)
+	k.MarkConsumerValidatorDirty(ctx, chainID, providerAddr)
 
 	// set the mapping from this validator's new consensus address on the consumer
 	// to its consensus address on the provider;
 	// note: this state must be deleted through the pruning mechanism
 	k.SetValidatorByConsumerAddr(ctx, chainID, consumerAddr, providerAddr)
 
+	// record the new consumer key, which is kept after the old consumer addresses are pruned
+	k.AppendKeyAssignmentRecord(ctx, chainID, providerAddr, consumerKey)
+
 	return nil
 }
 
@@ -513,7 +674,9 @@ func (k Keeper) PruneKeyAssignments(ctx sdk.Context, chainID string) {
 			"consumer consensus addr", consumerAddr.String(),
 		)
 	}
-
+	if len(consumerAddrs.Addresses) != 0 {
+		incrConsumerCounter(types.MetricKeyKeyAssignmentsPruned, chainID, float32(len(consumerAddrs.Addresses)))
+	}
 }
 
 // DeleteKeyAssignments deletes all the state needed for key assignments on a consumer chain
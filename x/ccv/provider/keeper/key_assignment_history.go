@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetKeyAssignmentRecord sets the entry with the given index of the key assignment history
+// of a validator on a consumer chain
+func (k Keeper) SetKeyAssignmentRecord(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	index uint64,
+	record types.KeyAssignmentRecord,
+) {
+	if err := k.keyAssignmentHistory.Set(ctx, collections.Join3(chainID, providerAddr.ToSdkConsAddr(), index), record); err != nil {
+		panic(fmt.Errorf("failed to set key assignment record: %w", err))
+	}
+}
+
+// GetKeyAssignmentHistory returns the key assignment history of a validator on a consumer chain,
+// from the oldest to the latest consumer key
+func (k Keeper) GetKeyAssignmentHistory(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) []types.KeyAssignmentRecord {
+	iterator, err := k.keyAssignmentHistory.Iterate(ctx,
+		collections.NewSuperPrefixedTripleRange[string, sdk.ConsAddress, uint64](chainID, providerAddr.ToSdkConsAddr()))
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate key assignment history: %w", err))
+	}
+	records, err := iterator.Values()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse key assignment history: %w", err))
+	}
+	return records
+}
+
+// GetAllKeyAssignmentHistory returns the key assignment history of all validators on all consumer chains
+func (k Keeper) GetAllKeyAssignmentHistory(ctx sdk.Context) []types.KeyAssignmentHistoryEntry {
+	iterator, err := k.keyAssignmentHistory.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate key assignment history: %w", err))
+	}
+	kvs, err := iterator.KeyValues()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse key assignment history: %w", err))
+	}
+
+	entries := make([]types.KeyAssignmentHistoryEntry, 0, len(kvs))
+	for _, kv := range kvs {
+		entries = append(entries, types.KeyAssignmentHistoryEntry{
+			ChainId:      kv.Key.K1(),
+			ProviderAddr: kv.Key.K2(),
+			Index:        kv.Key.K3(),
+			Record:       kv.Value,
+		})
+	}
+	return entries
+}
+
+// AppendKeyAssignmentRecord records that a validator assigned a consumer key on a consumer chain.
+// The previous consumer key of the validator is marked as superseded and the oldest entries are
+// removed, so that at most KeyAssignmentHistorySize entries are kept.
+func (k Keeper) AppendKeyAssignmentRecord(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	consumerKey tmprotocrypto.PublicKey,
+) {
+	// the consumer key is sent in a VSC packet queued for the chain with this or a greater
+	// valset update id, which depends on the epochs of the chain and on its validator set
+	vscID := k.GetValidatorSetUpdateId(ctx)
+
+	iterator, err := k.keyAssignmentHistory.Iterate(ctx,
+		collections.NewSuperPrefixedTripleRangeReversed[string, sdk.ConsAddress, uint64](chainID, providerAddr.ToSdkConsAddr()))
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate key assignment history: %w", err))
+	}
+	kvs, err := iterator.KeyValues()
+	if err != nil {
+		panic(fmt.Errorf("failed to parse key assignment history: %w", err))
+	}
+
+	index := uint64(0)
+	if len(kvs) > 0 {
+		latest := kvs[0]
+		latest.Value.SupersededHeight = ctx.BlockHeight()
+		latest.Value.SupersededVscId = vscID
+		k.SetKeyAssignmentRecord(ctx, chainID, providerAddr, latest.Key.K3(), latest.Value)
+		index = latest.Key.K3() + 1
+	}
+
+	size := k.GetKeyAssignmentHistorySize(ctx)
+	if size > 0 {
+		k.SetKeyAssignmentRecord(ctx, chainID, providerAddr, index, types.KeyAssignmentRecord{
+			ConsumerKey:    consumerKey,
+			AssignedHeight: ctx.BlockHeight(),
+			EffectiveVscId: vscID,
+		})
+		// the new entry is kept together with the size-1 latest previous ones
+		size--
+	}
+
+	// remove the oldest entries, which come last in descending order
+	for i := int(size); i < len(kvs); i++ {
+		if err := k.keyAssignmentHistory.Remove(ctx, kvs[i].Key); err != nil {
+			panic(fmt.Errorf("failed to delete key assignment record: %w", err))
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// TestAppendKeyAssignmentRecord tests that the previous consumer key is marked as superseded
+// and that only the latest KeyAssignmentHistorySize consumer keys are kept
+func TestAppendKeyAssignmentRecord(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.KeyAssignmentHistorySize = 2
+	providerKeeper.SetParams(ctx, params)
+
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	keys := make([]providertypes.KeyAssignmentRecord, 3)
+	for i := range keys {
+		ctx = ctx.WithBlockHeight(int64(10 * (i + 1)))
+		providerKeeper.SetValidatorSetUpdateId(ctx, uint64(i+1))
+		keys[i] = providertypes.KeyAssignmentRecord{
+			ConsumerKey:    cryptotestutil.NewCryptoIdentityFromIntSeed(i + 1).TMProtoCryptoPublicKey(),
+			AssignedHeight: ctx.BlockHeight(),
+			EffectiveVscId: uint64(i + 1),
+		}
+		providerKeeper.AppendKeyAssignmentRecord(ctx, "chain", providerAddr, keys[i].ConsumerKey)
+	}
+	// the history of other chains is not affected
+	providerKeeper.AppendKeyAssignmentRecord(ctx, "other-chain", providerAddr, keys[0].ConsumerKey)
+
+	keys[1].SupersededHeight = 30
+	keys[1].SupersededVscId = 3
+	require.Equal(t, keys[1:], providerKeeper.GetKeyAssignmentHistory(ctx, "chain", providerAddr))
+	entries := providerKeeper.GetAllKeyAssignmentHistory(ctx)
+	require.Len(t, entries, 3)
+	require.Equal(t, uint64(2), entries[1].Index)
+
+	// disabling the history deletes it on the next key assignment
+	params.KeyAssignmentHistorySize = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.AppendKeyAssignmentRecord(ctx, "chain", providerAddr, keys[0].ConsumerKey)
+	require.Empty(t, providerKeeper.GetKeyAssignmentHistory(ctx, "chain", providerAddr))
+	require.Len(t, providerKeeper.GetAllKeyAssignmentHistory(ctx), 1)
+}
+
+// TestKeyAssignmentHistoryOutlivesStop tests that the key assignment history of a consumer chain
+// is kept after the chain is stopped
+func TestKeyAssignmentHistoryOutlivesStop(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	testkeeper.SetupForStoppingConsumerChain(t, ctx, &providerKeeper, mocks)
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	providerKeeper.AppendKeyAssignmentRecord(ctx, "chainID", providerAddr, consumerKey)
+
+	gomock.InOrder(testkeeper.GetMocksForStopConsumerChainWithCloseChannel(ctx, &mocks)...)
+	require.NoError(t, providerKeeper.StopConsumerChain(ctx, "chainID", true))
+
+	history := providerKeeper.GetKeyAssignmentHistory(ctx, "chainID", providerAddr)
+	require.Len(t, history, 1)
+	require.Equal(t, consumerKey, history[0].ConsumerKey)
+}
+
+// TestKeyAssignmentHistoryOutlivesPruning tests that a consumer key is kept in the key assignment
+// history after the mapping from its consumer address is pruned
+func TestKeyAssignmentHistoryOutlivesPruning(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	state := newStakingState(mocks, 1)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	providerAddr := state.providerAddr(0)
+	ctx = ctx.WithBlockHeight(5)
+
+	oldId := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	require.NoError(t, providerKeeper.AssignConsumerKey(ctx, "chain", state.validators[0], oldId.TMProtoCryptoPublicKey()))
+	require.NoError(t, providerKeeper.AssignConsumerKey(ctx, "chain", state.validators[0],
+		cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	providerKeeper.PruneKeyAssignments(ctx, "chain")
+	_, found := providerKeeper.GetValidatorByConsumerAddr(ctx, "chain", oldId.ConsumerConsAddress())
+	require.False(t, found)
+
+	res, err := providerKeeper.QueryKeyAssignmentHistory(ctx, &providertypes.QueryKeyAssignmentHistoryRequest{
+		ChainId:         "chain",
+		ProviderAddress: providerAddr.String(),
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, oldId.SDKValConsAddress().String(), res.Records[0].ConsumerAddress)
+	require.Equal(t, int64(5), res.Records[0].SupersededHeight)
+
+	res, err = providerKeeper.QueryKeyAssignmentHistory(ctx, &providertypes.QueryKeyAssignmentHistoryRequest{
+		ChainId:         "chain",
+		ProviderAddress: providerAddr.String(),
+		Pagination:      &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Zero(t, res.Records[0].SupersededHeight)
+
+	_, err = providerKeeper.QueryKeyAssignmentHistory(ctx, &providertypes.QueryKeyAssignmentHistoryRequest{ChainId: "chain"})
+	require.Error(t, err)
+}
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"fmt"
 	"math/rand"
 	"sort"
 	"testing"
@@ -15,6 +17,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
@@ -237,6 +240,49 @@ func TestConsumerAddrsToPruneCRUD(t *testing.T) {
 	require.Equal(t, addrsToPrune[0], consumerAddr2.ToSdkConsAddr().Bytes())
 }
 
+// TestGetConsumerChainsWithAddrsToPrune tests that the cross-chain time index
+// returns only the consumer chains with consumer addresses that are due to be pruned
+func TestGetConsumerChainsWithAddrsToPrune(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerAddr1 := types.NewConsumerConsAddress([]byte("consumerAddr1"))
+	consumerAddr2 := types.NewConsumerConsAddress([]byte("consumerAddr2"))
+	consumerAddr3 := types.NewConsumerConsAddress([]byte("consumerAddr3"))
+
+	ts1 := time.Now().UTC()
+	ts2 := ts1.Add(time.Hour)
+
+	require.Empty(t, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+
+	keeper.AppendConsumerAddrsToPrune(ctx, "chain-b", ts1, consumerAddr1)
+	keeper.AppendConsumerAddrsToPrune(ctx, "chain-a", ts1, consumerAddr2)
+	keeper.AppendConsumerAddrsToPrune(ctx, "chain-b", ts2, consumerAddr3)
+	keeper.AppendConsumerAddrsToPrune(ctx, "chain-c", ts2, consumerAddr3)
+
+	require.Empty(t, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts1.Add(-time.Nanosecond)))
+	require.Equal(t, []string{"chain-a", "chain-b"}, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts1))
+	require.Equal(t, []string{"chain-a", "chain-b", "chain-c"}, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+
+	// consuming the addresses of a chain removes its entries from the index
+	keeper.ConsumeConsumerAddrsToPrune(ctx, "chain-b", ts2)
+	require.Equal(t, []string{"chain-a", "chain-c"}, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+
+	// deleting the addresses of a chain removes its entries from the index
+	keeper.DeleteConsumerAddrsToPruneV2(ctx, "chain-a", ts1)
+	require.Equal(t, []string{"chain-c"}, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+
+	// EndBlockCIS prunes only the chains that are due
+	keeper.SetValidatorByConsumerAddr(ctx, "chain-c", consumerAddr3, types.NewProviderConsAddress([]byte("providerAddr")))
+	keeper.EndBlockCIS(ctx.WithBlockTime(ts1))
+	_, found := keeper.GetValidatorByConsumerAddr(ctx, "chain-c", consumerAddr3)
+	require.True(t, found)
+	keeper.EndBlockCIS(ctx.WithBlockTime(ts2))
+	_, found = keeper.GetValidatorByConsumerAddr(ctx, "chain-c", consumerAddr3)
+	require.False(t, found)
+	require.Empty(t, keeper.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+}
+
 func TestGetAllConsumerAddrsToPrune(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -632,6 +678,115 @@ func TestCannotReassignDefaultKeyAssignment(t *testing.T) {
 	require.Equal(t, "a validator cannot assign the default key assignment unless its key on that consumer has already been assigned: cannot re-assign default key assignment", err.Error())
 }
 
+// TestAssignConsumerKeyAllowedKeyTypes tests that secp256k1 consumer keys can be parsed and assigned
+// on the consumer chains that allow them, and only on those
+func TestAssignConsumerKeyAllowedKeyTypes(t *testing.T) {
+	providerCId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	secp256k1PubKey := secp256k1.GenPrivKey().PubKey()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerKey, err := providerKeeper.ParseConsumerKey(fmt.Sprintf(`{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"%s"}`,
+		base64.StdEncoding.EncodeToString(secp256k1PubKey.Bytes())))
+	require.NoError(t, err)
+	require.Equal(t, tmprotocrypto.PublicKey{
+		Sum: &tmprotocrypto.PublicKey_Secp256K1{Secp256K1: secp256k1PubKey.Bytes()},
+	}, consumerKey)
+	_, err = providerKeeper.ParseConsumerKey(`{"@type":"/cosmos.crypto.sr25519.PubKey","key":"AAAA"}`)
+	require.Error(t, err)
+	// BLS12-381 keys are not supported
+	_, err = providerKeeper.ParseConsumerKey(`{"@type":"/cosmos.crypto.bls12_381.PubKey","key":"AAAA"}`)
+	require.ErrorIs(t, err, stakingtypes.ErrValidatorPubKeyTypeNotSupported)
+
+	// a chain that does not declare key types only allows ed25519 keys
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{ChainId: "ed25519Chain"})
+	err = providerKeeper.AssignConsumerKey(ctx, "ed25519Chain", providerCId.SDKStakingValidator(), consumerKey)
+	require.ErrorIs(t, err, types.ErrConsumerKeyTypeNotAllowed)
+
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{
+		ChainId:         "secp256k1Chain",
+		AllowedKeyTypes: []string{"secp256k1"},
+	})
+	err = providerKeeper.AssignConsumerKey(ctx, "secp256k1Chain", providerCId.SDKStakingValidator(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey())
+	require.ErrorIs(t, err, types.ErrConsumerKeyTypeNotAllowed)
+
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consumerAddr).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound)
+	require.NoError(t, providerKeeper.AssignConsumerKey(ctx, "secp256k1Chain", providerCId.SDKStakingValidator(), consumerKey))
+	assignedKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "secp256k1Chain", providerCId.ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, consumerKey, assignedKey)
+}
+
+// TestVerifyConsumerKeyPossession tests that a consumer key can only be assigned with a signature made by
+// its private key over the payload of the chain, the provider validator and a nonce that was not used yet.
+func TestVerifyConsumerKeyPossession(t *testing.T) {
+	providerCId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerCId := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerValAddr := providerCId.SDKValOpAddressString()
+	providerAddr := providerCId.ProviderConsAddress()
+	consumerKey := consumerCId.TMProtoCryptoPublicKey()
+
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetKeyAssignmentNonce(ctx, "chain", providerAddr)
+	require.False(t, found)
+
+	// signature made by a key other than the consumer key
+	err := providerKeeper.VerifyConsumerKeyPossession(ctx, "chain", providerValAddr, providerAddr, consumerKey,
+		1, providerCId.ConsumerKeyPossessionSignature("chain", providerValAddr, 1))
+	require.ErrorIs(t, err, types.ErrInvalidConsumerKeyPossession)
+
+	// signature over the payload of another chain
+	err = providerKeeper.VerifyConsumerKeyPossession(ctx, "chain", providerValAddr, providerAddr, consumerKey,
+		1, consumerCId.ConsumerKeyPossessionSignature("otherChain", providerValAddr, 1))
+	require.ErrorIs(t, err, types.ErrInvalidConsumerKeyPossession)
+
+	// signature over the payload of another validator
+	err = providerKeeper.VerifyConsumerKeyPossession(ctx, "chain", providerValAddr, providerAddr, consumerKey,
+		1, consumerCId.ConsumerKeyPossessionSignature("chain", consumerCId.SDKValOpAddressString(), 1))
+	require.ErrorIs(t, err, types.ErrInvalidConsumerKeyPossession)
+
+	// signature over the payload of another nonce
+	err = providerKeeper.VerifyConsumerKeyPossession(ctx, "chain", providerValAddr, providerAddr, consumerKey,
+		1, consumerCId.ConsumerKeyPossessionSignature("chain", providerValAddr, 2))
+	require.ErrorIs(t, err, types.ErrInvalidConsumerKeyPossession)
+
+	// none of the failed attempts recorded a nonce
+	_, found = providerKeeper.GetKeyAssignmentNonce(ctx, "chain", providerAddr)
+	require.False(t, found)
+
+	// valid signature
+	err = providerKeeper.VerifyConsumerKeyPossession(ctx, "chain", providerValAddr, providerAddr, consumerKey,
+		5, consumerCId.ConsumerKeyPossessionSignature("chain", providerValAddr, 5))
+	require.NoError(t, err)
+	nonce, found := providerKeeper.GetKeyAssignmentNonce(ctx, "chain", providerAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(5), nonce)
+
+	// the signature cannot be replayed, nor can a lower nonce be used
+	for _, n := range []uint64{5, 4} {
+		err = providerKeeper.VerifyConsumerKeyPossession(ctx, "chain", providerValAddr, providerAddr, consumerKey,
+			n, consumerCId.ConsumerKeyPossessionSignature("chain", providerValAddr, n))
+		require.ErrorIs(t, err, types.ErrInvalidConsumerKeyPossession)
+	}
+
+	// the nonces are tracked per chain
+	err = providerKeeper.VerifyConsumerKeyPossession(ctx, "otherChain", providerValAddr, providerAddr, consumerKey,
+		1, consumerCId.ConsumerKeyPossessionSignature("otherChain", providerValAddr, 1))
+	require.NoError(t, err)
+
+	require.Equal(t, []types.KeyAssignmentNonce{
+		{ChainId: "chain", ProviderAddr: providerAddr.ToSdkConsAddr(), Nonce: 5},
+		{ChainId: "otherChain", ProviderAddr: providerAddr.ToSdkConsAddr(), Nonce: 1},
+	}, providerKeeper.GetAllKeyAssignmentNonces(ctx))
+}
+
 // Represents the validator set of a chain
 type ValSet struct {
 	identities []*cryptotestutil.CryptoIdentity
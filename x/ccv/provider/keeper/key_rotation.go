@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// SetConsumerKeyRotation sets the consumer key rotation scheduled by a validator on a consumer chain
+func (k Keeper) SetConsumerKeyRotation(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	rotation types.ConsumerKeyRotation,
+) {
+	if err := k.consumerKeyRotations.Set(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()), rotation); err != nil {
+		panic(fmt.Errorf("failed to set consumer key rotation: %w", err))
+	}
+}
+
+// GetConsumerKeyRotation returns the consumer key rotation scheduled by a validator on a consumer chain, if any
+func (k Keeper) GetConsumerKeyRotation(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) (types.ConsumerKeyRotation, bool) {
+	return mustGet(ctx, k.consumerKeyRotations.Get, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+}
+
+// DeleteConsumerKeyRotation deletes the consumer key rotation scheduled by a validator on a consumer chain
+func (k Keeper) DeleteConsumerKeyRotation(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	err := k.consumerKeyRotations.Remove(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete consumer key rotation: %w", err))
+	}
+}
+
+// GetAllConsumerKeyRotations returns the consumer key rotations scheduled on all consumer chains,
+// or only on chainID if it is not nil, ordered by chain ID length, chain ID and provider address
+func (k Keeper) GetAllConsumerKeyRotations(ctx sdk.Context, chainID *string) (rotations []types.ScheduledConsumerKeyRotation) {
+	var ranger collections.Ranger[collections.Pair[string, sdk.ConsAddress]]
+	if chainID != nil {
+		ranger = collections.NewPrefixedPairRange[string, sdk.ConsAddress](*chainID)
+	}
+	err := k.consumerKeyRotations.Walk(ctx, ranger,
+		func(key collections.Pair[string, sdk.ConsAddress], rotation types.ConsumerKeyRotation) (bool, error) {
+			rotations = append(rotations, types.ScheduledConsumerKeyRotation{
+				ChainId:      key.K1(),
+				ProviderAddr: key.K2(),
+				Rotation:     rotation,
+			})
+			return false, nil
+		})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer key rotations: %w", err))
+	}
+	return rotations
+}
+
+// DeleteAllConsumerKeyRotations deletes the consumer key rotations scheduled on the given consumer chain
+func (k Keeper) DeleteAllConsumerKeyRotations(ctx sdk.Context, chainID string) {
+	for _, r := range k.GetAllConsumerKeyRotations(ctx, &chainID) {
+		k.DeleteConsumerKeyRotation(ctx, chainID, types.NewProviderConsAddress(r.ProviderAddr))
+	}
+}
+
+// GetConsumerKeyRotationApplyHeight returns the height at which a consumer key rotation scheduled
+// on consumer chain `chainID` for the given epoch, or else for the given height, is applied. The rotation
+// is applied at the end of an epoch of the chain, and at least one full epoch after the current one,
+// so that the operator can start the node that uses the new key while the validator still uses its current key.
+func (k Keeper) GetConsumerKeyRotationApplyHeight(ctx sdk.Context, chainID string, height int64, epoch uint64) (int64, error) {
+	if (height == 0) == (epoch == 0) {
+		return 0, errorsmod.Wrap(types.ErrInvalidConsumerKeyRotation, "exactly one of height and epoch must be set")
+	}
+
+	if k.IsTimeBasedEpoch(ctx, chainID) {
+		// the heights at which the epochs based on block time end are not known in advance, so
+		// the rotation is applied at the end of the epoch that contains the given height
+		if epoch != 0 {
+			return 0, errorsmod.Wrapf(types.ErrInvalidConsumerKeyRotation,
+				"the epochs of consumer chain %s are based on block time, the rotation must be scheduled by height", chainID)
+		}
+		if height <= ctx.BlockHeight() {
+			return 0, errorsmod.Wrapf(types.ErrInvalidConsumerKeyRotation,
+				"the rotation must be applied after the current height %d, got height %d", ctx.BlockHeight(), height)
+		}
+		return height, nil
+	}
+
+	blocksPerEpoch := k.GetConsumerBlocksPerEpoch(ctx, chainID)
+	var applyHeight int64
+	if epoch != 0 {
+		if epoch > uint64(math.MaxInt64/blocksPerEpoch) {
+			return 0, errorsmod.Wrapf(types.ErrInvalidConsumerKeyRotation, "epoch %d is too large", epoch)
+		}
+		applyHeight = int64(epoch) * blocksPerEpoch
+	} else {
+		if height < 0 || height > math.MaxInt64-blocksPerEpoch {
+			return 0, errorsmod.Wrapf(types.ErrInvalidConsumerKeyRotation, "invalid height %d", height)
+		}
+		applyHeight = k.GetEpochEndHeight(ctx, chainID, height)
+	}
+
+	if currentEpochEnd := k.GetEpochEndHeight(ctx, chainID, ctx.BlockHeight()); applyHeight <= currentEpochEnd {
+		return 0, errorsmod.Wrapf(types.ErrInvalidConsumerKeyRotation,
+			"the rotation must be applied after the end of the current epoch at height %d, got height %d",
+			currentEpochEnd, applyHeight)
+	}
+	return applyHeight, nil
+}
+
+// ScheduleConsumerKeyRotation schedules the assignment of consumerKey to the given validator
+// on a running consumer chain, at the end of the given epoch or of the epoch that contains
+// the given height. A rotation that is already scheduled by the validator on the chain is
+// replaced. It returns the height at which the rotation is applied.
+func (k Keeper) ScheduleConsumerKeyRotation(
+	ctx sdk.Context,
+	chainID string,
+	validator stakingtypes.Validator,
+	consumerKey tmprotocrypto.PublicKey,
+	height int64,
+	epoch uint64,
+) (int64, error) {
+	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+		return 0, errorsmod.Wrapf(types.ErrUnknownConsumerChainId,
+			"consumer key rotations can only be scheduled on running consumer chains: %s", chainID)
+	}
+
+	applyHeight, err := k.GetConsumerKeyRotationApplyHeight(ctx, chainID, height, epoch)
+	if err != nil {
+		return 0, err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return 0, err
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	// another validator cannot schedule a rotation to the same consumer key
+	for _, r := range k.GetAllConsumerKeyRotations(ctx, &chainID) {
+		if !bytes.Equal(r.ProviderAddr, providerAddr.ToSdkConsAddr()) && r.Rotation.ConsumerKey.Equal(consumerKey) {
+			return 0, errorsmod.Wrap(types.ErrConsumerKeyInUse, "a different validator already scheduled a rotation to the consumer key")
+		}
+	}
+
+	// check that the consumer key can be assigned, without assigning it
+	cachedCtx, _ := ctx.CacheContext()
+	if err := k.assignConsumerKey(cachedCtx, chainID, validator, consumerKey); err != nil {
+		return 0, err
+	}
+
+	k.SetConsumerKeyRotation(ctx, chainID, providerAddr, types.ConsumerKeyRotation{
+		ConsumerKey: consumerKey,
+		ApplyHeight: applyHeight,
+	})
+	return applyHeight, nil
+}
+
+// CancelConsumerKeyRotation cancels the consumer key rotation scheduled by a validator on a consumer chain
+func (k Keeper) CancelConsumerKeyRotation(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) error {
+	if _, found := k.GetConsumerKeyRotation(ctx, chainID, providerAddr); !found {
+		return errorsmod.Wrapf(types.ErrNoConsumerKeyRotation,
+			"validator %s on consumer chain %s", providerAddr.String(), chainID)
+	}
+	k.DeleteConsumerKeyRotation(ctx, chainID, providerAddr)
+	return nil
+}
+
+// ApplyConsumerKeyRotations assigns the consumer keys of the rotations on the given consumer chains
+// that are due at the current height. It is called at the end of an epoch of the chains, before the
+// validator updates of the epoch are queued, so that the new consumer keys are sent in the VSC packets
+// of the epoch. A rotation whose key can no longer be assigned, e.g., because the key is now used by
+// another validator, is dropped.
+func (k Keeper) ApplyConsumerKeyRotations(ctx sdk.Context, chainIDs []string) {
+	for _, chainID := range chainIDs {
+		k.applyConsumerKeyRotationsToChain(ctx, chainID)
+	}
+}
+
+// applyConsumerKeyRotationsToChain assigns the consumer keys of the rotations on consumer chain
+// `chainID` that are due at the current height
+func (k Keeper) applyConsumerKeyRotationsToChain(ctx sdk.Context, chainID string) {
+	for _, r := range k.GetAllConsumerKeyRotations(ctx, &chainID) {
+		if r.Rotation.ApplyHeight > ctx.BlockHeight() {
+			continue
+		}
+		providerAddr := types.NewProviderConsAddress(r.ProviderAddr)
+		k.DeleteConsumerKeyRotation(ctx, r.ChainId, providerAddr)
+
+		event := types.EventConsumerKeyRotationApplied{
+			ChainId:          r.ChainId,
+			ProviderConsAddr: providerAddr.String(),
+		}
+		if consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(r.Rotation.ConsumerKey); err == nil {
+			event.ConsumerConsAddr = consumerAddr.String()
+		}
+		err := k.applyConsumerKeyRotation(ctx, r.ChainId, providerAddr, r.Rotation.ConsumerKey)
+		event.Success = err == nil
+		if err != nil {
+			event.Error = err.Error()
+			k.Logger(ctx).Error("consumer key rotation dropped",
+				"chainID", r.ChainId,
+				"provider addr", providerAddr.String(),
+				"error", err.Error(),
+			)
+		} else {
+			k.Logger(ctx).Info("consumer key rotation applied",
+				"chainID", r.ChainId,
+				"provider addr", providerAddr.String(),
+			)
+		}
+		k.emitTypedEvent(ctx, &event)
+	}
+}
+
+// applyConsumerKeyRotation assigns the consumer key of a due rotation. The state is
+// only written if the key is assigned.
+func (k Keeper) applyConsumerKeyRotation(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	consumerKey tmprotocrypto.PublicKey,
+) error {
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if err != nil {
+		return err
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := k.AssignConsumerKey(cachedCtx, chainID, validator, consumerKey); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
+
+// GetConsumerKeyRotationInfo returns the description of a scheduled consumer key rotation, as returned by queries
+func (k Keeper) GetConsumerKeyRotationInfo(ctx sdk.Context, r types.ScheduledConsumerKeyRotation) (types.ConsumerKeyRotationInfo, error) {
+	providerAddr := types.NewProviderConsAddress(r.ProviderAddr)
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(r.Rotation.ConsumerKey)
+	if err != nil {
+		return types.ConsumerKeyRotationInfo{}, err
+	}
+
+	info := types.ConsumerKeyRotationInfo{
+		ProviderAddress:        providerAddr.String(),
+		CurrentConsumerAddress: providerAddr.String(),
+		ConsumerAddress:        consumerAddr.String(),
+		ConsumerKey:            &r.Rotation.ConsumerKey,
+		ApplyHeight:            r.Rotation.ApplyHeight,
+	}
+	if !k.IsTimeBasedEpoch(ctx, r.ChainId) {
+		// the rotation is applied at the end of the epoch that contains the apply height,
+		// also when the epoch length changed after the rotation was scheduled
+		info.ApplyEpoch = uint64(k.GetEpochEndHeight(ctx, r.ChainId, r.Rotation.ApplyHeight) / k.GetConsumerBlocksPerEpoch(ctx, r.ChainId))
+	}
+	if currentKey, found := k.GetValidatorConsumerPubKey(ctx, r.ChainId, providerAddr); found {
+		currentAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(currentKey)
+		if err != nil {
+			return types.ConsumerKeyRotationInfo{}, err
+		}
+		info.CurrentConsumerAddress = currentAddr.String()
+	}
+	return info, nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestConsumerKeyRotationCRUD(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	rotation := providertypes.ConsumerKeyRotation{
+		ConsumerKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(),
+		ApplyHeight: 20,
+	}
+
+	_, found := providerKeeper.GetConsumerKeyRotation(ctx, "chain-1", providerAddr)
+	require.False(t, found)
+
+	providerKeeper.SetConsumerKeyRotation(ctx, "chain-1", providerAddr, rotation)
+	providerKeeper.SetConsumerKeyRotation(ctx, "chain-2", providerAddr, rotation)
+	got, found := providerKeeper.GetConsumerKeyRotation(ctx, "chain-1", providerAddr)
+	require.True(t, found)
+	require.Equal(t, rotation, got)
+
+	chainID := "chain-1"
+	require.Len(t, providerKeeper.GetAllConsumerKeyRotations(ctx, nil), 2)
+	require.Equal(t, []providertypes.ScheduledConsumerKeyRotation{
+		{ChainId: chainID, ProviderAddr: providerAddr.ToSdkConsAddr(), Rotation: rotation},
+	}, providerKeeper.GetAllConsumerKeyRotations(ctx, &chainID))
+
+	providerKeeper.DeleteAllConsumerKeyRotations(ctx, chainID)
+	require.Empty(t, providerKeeper.GetAllConsumerKeyRotations(ctx, &chainID))
+	require.Len(t, providerKeeper.GetAllConsumerKeyRotations(ctx, nil), 1)
+}
+
+func TestGetConsumerKeyRotationApplyHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+	// the current epoch ends at height 20
+	ctx = ctx.WithBlockHeight(15)
+
+	testCases := []struct {
+		name        string
+		height      int64
+		epoch       uint64
+		applyHeight int64
+		expPass     bool
+	}{
+		{"next epoch", 0, 3, 30, true},
+		{"later epoch", 0, 7, 70, true},
+		{"current epoch", 0, 2, 0, false},
+		{"past epoch", 0, 1, 0, false},
+		{"height rounded up to the end of its epoch", 21, 0, 30, true},
+		{"last height of an epoch", 30, 0, 30, true},
+		{"height in the current epoch", 20, 0, 0, false},
+		{"height and epoch", 30, 3, 0, false},
+		{"neither height nor epoch", 0, 0, 0, false},
+		{"negative height", -30, 0, 0, false},
+	}
+
+	for _, tc := range testCases {
+		applyHeight, err := providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", tc.height, tc.epoch)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.applyHeight, applyHeight, tc.name)
+		} else {
+			require.ErrorIs(t, err, providertypes.ErrInvalidConsumerKeyRotation, tc.name)
+		}
+	}
+
+	// the current epoch ends at the current height at the boundary of an epoch
+	ctx = ctx.WithBlockHeight(20)
+	_, err := providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 20, 0)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerKeyRotation)
+	applyHeight, err := providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 0, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), applyHeight)
+
+	// the epochs of a consumer chain with an epoch length of its own are used instead
+	providerKeeper.SetConsumerEpochParams(ctx, "chainID", providertypes.ConsumerEpochParams{BlocksPerEpoch: 4})
+	applyHeight, err = providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 21, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(24), applyHeight)
+	applyHeight, err = providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 0, 7)
+	require.NoError(t, err)
+	require.Equal(t, int64(28), applyHeight)
+
+	// with epochs based on block time, the rotation can only be scheduled by a height after the current one
+	params.EpochDuration = time.Hour
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.DeleteConsumerEpochParams(ctx, "chainID")
+	_, err = providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 0, 3)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerKeyRotation)
+	_, err = providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 20, 0)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerKeyRotation)
+	applyHeight, err = providerKeeper.GetConsumerKeyRotationApplyHeight(ctx, "chainID", 25, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(25), applyHeight)
+}
+
+func TestScheduleAndCancelConsumerKeyRotation(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(15)
+
+	state := newStakingState(mocks, 2)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(10).TMProtoCryptoPublicKey()
+
+	// rotations can only be scheduled on running consumer chains
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &providertypes.ConsumerAdditionProposal{ChainId: "chain"})
+	_, err := providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[0], consumerKey, 0, 3)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerChainId)
+
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	applyHeight, err := providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[0], consumerKey, 25, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), applyHeight)
+
+	// the key is not assigned before the rotation is applied
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "chain", state.providerAddr(0))
+	require.False(t, found)
+	rotation, found := providerKeeper.GetConsumerKeyRotation(ctx, "chain", state.providerAddr(0))
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerKeyRotation{ConsumerKey: consumerKey, ApplyHeight: 30}, rotation)
+
+	// another validator cannot schedule a rotation to the same key
+	_, err = providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[1], consumerKey, 0, 4)
+	require.ErrorIs(t, err, providertypes.ErrConsumerKeyInUse)
+
+	// a validator cannot schedule a rotation to the provider key of another validator
+	_, err = providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[1],
+		cryptotestutil.NewCryptoIdentityFromIntSeed(0).TMProtoCryptoPublicKey(), 0, 4)
+	require.ErrorIs(t, err, providertypes.ErrConsumerKeyInUse)
+
+	// the rotation is replaced when it is scheduled again
+	applyHeight, err = providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[0], consumerKey, 0, 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), applyHeight)
+	require.Len(t, providerKeeper.GetAllConsumerKeyRotations(ctx, nil), 1)
+
+	require.NoError(t, providerKeeper.CancelConsumerKeyRotation(ctx, "chain", state.providerAddr(0)))
+	_, found = providerKeeper.GetConsumerKeyRotation(ctx, "chain", state.providerAddr(0))
+	require.False(t, found)
+	err = providerKeeper.CancelConsumerKeyRotation(ctx, "chain", state.providerAddr(0))
+	require.ErrorIs(t, err, providertypes.ErrNoConsumerKeyRotation)
+}
+
+// TestApplyConsumerKeyRotations tests that the scheduled consumer keys are assigned at the end of
+// their epoch and sent in the VSC packet of the epoch, and that the rotations whose key can no longer
+// be assigned are dropped
+func TestApplyConsumerKeyRotations(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	state := newStakingState(mocks, 3)
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	for i := range state.validators {
+		providerKeeper.SetOptedIn(ctx, "chain", state.providerAddr(i))
+	}
+	ctx = ctx.WithBlockHeight(10)
+	providerKeeper.EndBlockVSU(ctx)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, "chain"), 1)
+
+	consumerKey0 := cryptotestutil.NewCryptoIdentityFromIntSeed(10).TMProtoCryptoPublicKey()
+	consumerKey1 := cryptotestutil.NewCryptoIdentityFromIntSeed(11).TMProtoCryptoPublicKey()
+	ctx = ctx.WithBlockHeight(11)
+	_, err := providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[0], consumerKey0, 0, 3)
+	require.NoError(t, err)
+	_, err = providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[1], consumerKey1, 0, 3)
+	require.NoError(t, err)
+	_, err = providerKeeper.ScheduleConsumerKeyRotation(ctx, "chain", state.validators[2],
+		cryptotestutil.NewCryptoIdentityFromIntSeed(12).TMProtoCryptoPublicKey(), 0, 4)
+	require.NoError(t, err)
+
+	// the rotations are not applied before their epoch ends
+	ctx = ctx.WithBlockHeight(20)
+	providerKeeper.EndBlockVSU(ctx)
+	require.Len(t, providerKeeper.GetAllConsumerKeyRotations(ctx, nil), 3)
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "chain", state.providerAddr(0))
+	require.False(t, found)
+
+	// the key of the second rotation is assigned to another validator in the meantime
+	ctx = ctx.WithBlockHeight(21)
+	require.NoError(t, providerKeeper.AssignConsumerKey(ctx, "chain", state.validators[2], consumerKey1))
+
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	providerKeeper.EndBlockVSU(ctx)
+
+	assignedKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "chain", state.providerAddr(0))
+	require.True(t, found)
+	require.Equal(t, consumerKey0, assignedKey)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, "chain", state.providerAddr(1))
+	require.False(t, found)
+
+	// only the rotation of the next epoch is left
+	rotations := providerKeeper.GetAllConsumerKeyRotations(ctx, nil)
+	require.Len(t, rotations, 1)
+	providerAddr2 := state.providerAddr(2)
+	require.Equal(t, []byte(providerAddr2.ToSdkConsAddr()), rotations[0].ProviderAddr)
+
+	// the new key of the first validator is sent in the VSC packet of the epoch
+	pending := providerKeeper.GetPendingVSCPackets(ctx, "chain")
+	require.Len(t, pending, 2)
+	var updatedKeys []tmprotocrypto.PublicKey
+	for _, update := range pending[1].ValidatorUpdates {
+		updatedKeys = append(updatedKeys, update.PubKey)
+	}
+	require.Contains(t, updatedKeys, consumerKey0)
+
+	applied := map[string]*providertypes.EventConsumerKeyRotationApplied{}
+	for _, ev := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*providertypes.EventConsumerKeyRotationApplied); ok {
+			applied[e.ProviderConsAddr] = e
+		}
+	}
+	providerAddr0, providerAddr1 := state.providerAddr(0), state.providerAddr(1)
+	require.Len(t, applied, 2)
+	require.True(t, applied[providerAddr0.String()].Success)
+	require.False(t, applied[providerAddr1.String()].Success)
+	require.NotEmpty(t, applied[providerAddr1.String()].Error)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// checkLaunchThresholds checks that the initial validator set of the consumer chain of the given
+// proposal, as set when the consumer genesis is made, meets the launch thresholds of the chain.
+// It returns the threshold that is not met, or an empty string if they are all met. The initial
+// validator set cannot be empty in any case.
+func (k Keeper) checkLaunchThresholds(ctx sdk.Context, prop types.ConsumerAdditionProposal) (string, error) {
+	thresholds := *prop.LaunchThresholds
+	validators := k.GetConsumerValSet(ctx, prop.ChainId)
+	if minValidators := max(thresholds.MinValidators, 1); len(validators) < int(minValidators) {
+		return fmt.Sprintf("the initial validator set has %d validators, fewer than the minimum of %d",
+			len(validators), minValidators), nil
+	}
+
+	if thresholds.MinReadyPowerPercentage > 0 {
+		readyPower, consumerPower := k.GetReadyPower(ctx, prop, validators)
+		if readyPower*100 < consumerPower*int64(thresholds.MinReadyPowerPercentage) {
+			return fmt.Sprintf("the validators that signalled their readiness have %d of the %d voting power of the initial validator set, less than the minimum of %d%%",
+				readyPower, consumerPower, thresholds.MinReadyPowerPercentage), nil
+		}
+	}
+
+	if thresholds.MinPowerPercentage == 0 {
+		return "", nil
+	}
+	totalPower, err := k.stakingKeeper.GetLastTotalPower(ctx)
+	if err != nil {
+		return "", err
+	}
+	var power int64
+	for _, v := range validators {
+		validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, v.ProviderConsAddr)
+		if err != nil {
+			return "", err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return "", err
+		}
+		validatorPower, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return "", err
+		}
+		power += validatorPower
+	}
+	if math.NewInt(power).MulRaw(100).LT(totalPower.MulRaw(int64(thresholds.MinPowerPercentage))) {
+		return fmt.Sprintf("the initial validator set has %d of the %s provider voting power, less than the minimum of %d%%",
+			power, totalPower, thresholds.MinPowerPercentage), nil
+	}
+	return "", nil
+}
+
+// postponeOrDropLaunch postpones the launch of a consumer chain whose initial validator set does
+// not meet its launch thresholds, by storing its consumer addition proposal again with a later
+// spawn time. The proposal is dropped instead if the launch was already postponed the maximum
+// number of times.
+func (k Keeper) postponeOrDropLaunch(ctx sdk.Context, prop types.ConsumerAdditionProposal, reason string) {
+	thresholds := prop.LaunchThresholds
+	if prop.LaunchPostponements >= thresholds.MaxPostponements {
+		k.Logger(ctx).Info("consumer addition proposal dropped: launch thresholds not met",
+			"chainID", prop.ChainId,
+			"postponements", prop.LaunchPostponements,
+			"reason", reason,
+		)
+		k.deleteDroppedConsumerInfo(ctx, prop.ChainId)
+		k.emitTypedEvent(ctx, &types.EventConsumerLaunchDropped{ChainId: prop.ChainId, Reason: reason})
+		return
+	}
+
+	prop.SpawnTime = ctx.BlockTime().Add(thresholds.PostponementInterval)
+	prop.LaunchPostponements++
+	k.SetPendingConsumerAdditionProp(ctx, &prop)
+
+	k.Logger(ctx).Info("consumer chain launch postponed: launch thresholds not met",
+		"chainID", prop.ChainId,
+		"spawn time", prop.SpawnTime.UTC(),
+		"reason", reason,
+	)
+	k.emitTypedEvent(ctx, &types.EventConsumerLaunchPostponed{
+		ChainId:       prop.ChainId,
+		SpawnTime:     prop.SpawnTime,
+		Postponements: prop.LaunchPostponements,
+		Reason:        reason,
+	})
+}
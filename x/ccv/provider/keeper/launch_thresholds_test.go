@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// TestBeginBlockInitLaunchThresholds tests that the launch of a consumer chain whose initial validator
+// set does not meet its launch thresholds is postponed, and that its proposal is dropped after the
+// maximum number of postponements
+func TestBeginBlockInitLaunchThresholds(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// the validators have 1, 2, 3 and 4 of the 10 provider voting power
+	state := newStakingState(mocks, 4)
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(10), nil).AnyTimes()
+	for i := 0; i < 4; i++ {
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "", clienttypes.NewHeight(4, 5))
+	}
+
+	thresholds := providertypes.LaunchThresholds{
+		MinValidators:        2,
+		MinPowerPercentage:   50,
+		PostponementInterval: time.Hour,
+		MaxPostponements:     1,
+	}
+	for _, chainID := range []string{"chain1", "chain2"} {
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.ChainId = chainID
+		prop.SpawnTime = now
+		prop.LaunchThresholds = &thresholds
+		providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+		providerKeeper.SetConsumerMetadata(ctx, chainID, providertypes.ConsumerMetadata{Name: chainID})
+	}
+	// a single validator opts in to chain1 before its spawn time
+	providerKeeper.SetOptedIn(ctx, "chain1", state.providerAddr(3))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockInit(ctx)
+
+	// both launches are postponed
+	for _, chainID := range []string{"chain1", "chain2"} {
+		_, found := providerKeeper.GetConsumerClientId(ctx, chainID)
+		require.False(t, found)
+		prop, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, now.Add(time.Hour), chainID)
+		require.True(t, found)
+		require.Equal(t, uint32(1), prop.LaunchPostponements)
+		_, found = providerKeeper.GetPendingConsumerAdditionProp(ctx, now, chainID)
+		require.False(t, found)
+	}
+	postponed := launchEvents[*providertypes.EventConsumerLaunchPostponed](ctx)
+	require.Len(t, postponed, 2)
+	require.Equal(t, now.Add(time.Hour), postponed[0].SpawnTime)
+	require.Contains(t, postponed[0].Reason, "fewer than the minimum of 2")
+
+	// another validator opts in to chain1, so that it has 2 validators with 50% of the voting power
+	providerKeeper.SetOptedIn(ctx, "chain1", state.providerAddr(0))
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockInit(ctx)
+
+	// chain1 is launched and the proposal of chain2 is dropped
+	_, found := providerKeeper.GetConsumerClientId(ctx, "chain1")
+	require.True(t, found)
+	_, found = providerKeeper.GetConsumerClientId(ctx, "chain2")
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+	_, found = providerKeeper.GetConsumerMetadata(ctx, "chain2")
+	require.False(t, found)
+
+	dropped := launchEvents[*providertypes.EventConsumerLaunchDropped](ctx)
+	require.Len(t, dropped, 1)
+	require.Equal(t, "chain2", dropped[0].ChainId)
+	launched := launchEvents[*providertypes.EventConsumerLaunched](ctx)
+	require.NotEmpty(t, launched)
+	require.Equal(t, "chain1", launched[0].ChainId)
+}
+
+// TestCheckLaunchThresholdsPower tests that a launch is dropped when the opted-in validators do not
+// have the minimum percentage of the provider voting power and no postponement is allowed
+func TestCheckLaunchThresholdsPower(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	// the validators have 1, 2, 3 and 4 of the 10 provider voting power
+	state := newStakingState(mocks, 4)
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(10), nil).AnyTimes()
+	testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "", clienttypes.NewHeight(4, 5))
+
+	prop := testkeeper.GetTestConsumerAdditionProp()
+	prop.SpawnTime = ctx.BlockTime()
+	prop.LaunchThresholds = &providertypes.LaunchThresholds{MinPowerPercentage: 60}
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, prop)
+	providerKeeper.SetOptedIn(ctx, prop.ChainId, state.providerAddr(1))
+	providerKeeper.SetOptedIn(ctx, prop.ChainId, state.providerAddr(2))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockInit(ctx)
+
+	// the launch is not postponed, as max postponements is zero
+	dropped := launchEvents[*providertypes.EventConsumerLaunchDropped](ctx)
+	require.Len(t, dropped, 1)
+	require.Contains(t, dropped[0].Reason, "has 5 of the 10 provider voting power")
+	require.Empty(t, providerKeeper.GetAllPendingConsumerAdditionProps(ctx))
+}
+
+// launchEvents returns the typed events of type T emitted in the given context
+func launchEvents[T any](ctx sdk.Context) (events []T) {
+	for _, ev := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(T); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
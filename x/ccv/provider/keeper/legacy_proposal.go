@@ -8,6 +8,7 @@ import (
 )
 
 func (k Keeper) HandleLegacyConsumerRewardDenomProposal(ctx sdk.Context, p *types.ChangeRewardDenomsProposal) error {
+	event := types.EventConsumerRewardDenomsChanged{}
 	for _, denomToAdd := range p.DenomsToAdd {
 		// Log error and move on if one of the denoms is already registered
 		if k.ConsumerRewardDenomExists(ctx, denomToAdd) {
@@ -19,6 +20,7 @@ func (k Keeper) HandleLegacyConsumerRewardDenomProposal(ctx sdk.Context, p *type
 			types.EventTypeAddConsumerRewardDenom,
 			sdk.NewAttribute(types.AttributeConsumerRewardDenom, denomToAdd),
 		))
+		event.DenomsAdded = append(event.DenomsAdded, denomToAdd)
 	}
 	for _, denomToRemove := range p.DenomsToRemove {
 		// Log error and move on if one of the denoms is not registered
@@ -31,6 +33,10 @@ func (k Keeper) HandleLegacyConsumerRewardDenomProposal(ctx sdk.Context, p *type
 			types.EventTypeRemoveConsumerRewardDenom,
 			sdk.NewAttribute(types.AttributeConsumerRewardDenom, denomToRemove),
 		))
+		event.DenomsRemoved = append(event.DenomsRemoved, denomToRemove)
+	}
+	if len(event.DenomsAdded) != 0 || len(event.DenomsRemoved) != 0 {
+		k.emitTypedEvent(ctx, &event)
 	}
 	return nil
 }
@@ -48,7 +54,27 @@ func (k Keeper) HandleLegacyConsumerAdditionProposal(ctx sdk.Context, p *types.C
 		return err
 	}
 
+	var owner sdk.AccAddress
+	if p.Owner != "" {
+		var err error
+		if owner, err = sdk.AccAddressFromBech32(p.Owner); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
 	k.SetPendingConsumerAdditionProp(ctx, p)
+	if p.Metadata != nil {
+		k.SetConsumerMetadata(ctx, p.ChainId, *p.Metadata)
+	}
+	if owner != nil {
+		k.SetConsumerOwner(ctx, p.ChainId, owner)
+	}
+	if p.OptInPolicy != nil {
+		k.SetOptInPolicy(ctx, p.ChainId, *p.OptInPolicy)
+	}
+	if p.EpochParams != nil {
+		k.SetConsumerEpochParams(ctx, p.ChainId, *p.EpochParams)
+	}
 
 	k.Logger(ctx).Info("consumer addition proposal enqueued",
 		"chainID", p.ChainId,
@@ -67,6 +67,16 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 		return nil, err
 	}
 
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	// the validator must prove that it controls the consumer key before it is assigned
+	if err := k.Keeper.VerifyConsumerKeyPossession(ctx, msg.ChainId, msg.ProviderAddr, types.NewProviderConsAddress(consAddr),
+		consumerTMPublicKey, msg.Nonce, msg.ConsumerKeySignature); err != nil {
+		return nil, err
+	}
+
 	if err := k.Keeper.AssignConsumerKey(ctx, msg.ChainId, validator, consumerTMPublicKey); err != nil {
 		return nil, err
 	}
@@ -83,10 +93,132 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 			sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, msg.ConsumerKey),
 		),
 	})
+	k.emitTypedEvent(ctx, &types.EventConsumerKeyAssigned{
+		ChainId:         msg.ChainId,
+		ProviderValAddr: msg.ProviderAddr,
+		ConsumerKey:     msg.ConsumerKey,
+	})
 
 	return &types.MsgAssignConsumerKeyResponse{}, nil
 }
 
+// ScheduleConsumerKeyRotation defines a method to schedule the assignment of a consensus key
+// on a running consumer chain for a given validator on the provider
+func (k msgServer) ScheduleConsumerKeyRotation(goCtx context.Context, msg *types.MsgScheduleConsumerKeyRotation) (*types.MsgScheduleConsumerKeyRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// validator must already be registered
+	validator, err := k.stakingKeeper.GetValidator(ctx, providerValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerTMPublicKey, err := k.ParseConsumerKey(msg.ConsumerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	// the validator must prove that it controls the consumer key before the rotation is scheduled
+	if err := k.Keeper.VerifyConsumerKeyPossession(ctx, msg.ChainId, msg.ProviderAddr, types.NewProviderConsAddress(consAddr),
+		consumerTMPublicKey, msg.Nonce, msg.ConsumerKeySignature); err != nil {
+		return nil, err
+	}
+
+	applyHeight, err := k.Keeper.ScheduleConsumerKeyRotation(ctx, msg.ChainId, validator, consumerTMPublicKey, msg.Height, msg.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	k.Logger(ctx).Info("scheduled consumer key rotation",
+		"consumer chainID", msg.ChainId,
+		"validator operator addr", msg.ProviderAddr,
+		"consumer public key", msg.ConsumerKey,
+		"apply height", applyHeight,
+	)
+
+	k.emitTypedEvent(ctx, &types.EventConsumerKeyRotationScheduled{
+		ChainId:         msg.ChainId,
+		ProviderValAddr: msg.ProviderAddr,
+		ConsumerKey:     msg.ConsumerKey,
+		ApplyHeight:     applyHeight,
+	})
+
+	return &types.MsgScheduleConsumerKeyRotationResponse{ApplyHeight: applyHeight}, nil
+}
+
+// CancelConsumerKeyRotation defines a method to cancel the consumer key rotation
+// scheduled by a given validator on a consumer chain
+func (k msgServer) CancelConsumerKeyRotation(goCtx context.Context, msg *types.MsgCancelConsumerKeyRotation) (*types.MsgCancelConsumerKeyRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, providerValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelConsumerKeyRotation(ctx, msg.ChainId, types.NewProviderConsAddress(consAddr)); err != nil {
+		return nil, err
+	}
+
+	k.emitTypedEvent(ctx, &types.EventConsumerKeyRotationCancelled{
+		ChainId:         msg.ChainId,
+		ProviderValAddr: msg.ProviderAddr,
+	})
+
+	return &types.MsgCancelConsumerKeyRotationResponse{}, nil
+}
+
+// SignalConsumerReady defines a method to signal that a validator is ready to validate
+// a consumer chain that is waiting for its launch
+func (k msgServer) SignalConsumerReady(goCtx context.Context, msg *types.MsgSignalConsumerReady) (*types.MsgSignalConsumerReadyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, providerValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.HandleSignalConsumerReady(ctx, msg.ChainId, types.NewProviderConsAddress(consAddr), msg.GenesisHash, msg.BinaryHash)
+	if err != nil {
+		return nil, err
+	}
+
+	k.emitTypedEvent(ctx, &types.EventValidatorSignalledReady{
+		ChainId:         msg.ChainId,
+		ProviderValAddr: msg.ProviderAddr,
+	})
+
+	return &types.MsgSignalConsumerReadyResponse{}, nil
+}
+
 // ConsumerAddition defines an RPC handler method for MsgConsumerAddition
 func (k msgServer) ConsumerAddition(goCtx context.Context, msg *types.MsgConsumerAddition) (*types.MsgConsumerAdditionResponse, error) {
 	if k.GetAuthority() != msg.Authority {
@@ -217,6 +349,83 @@ func (k msgServer) ConsumerModification(goCtx context.Context, msg *types.MsgCon
 	return &types.MsgConsumerModificationResponse{}, nil
 }
 
+// UpdateConsumer defines an RPC handler method for MsgUpdateConsumer
+func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateConsumer) (*types.MsgUpdateConsumerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, found := k.GetConsumerOwner(ctx, msg.ChainId)
+	if !found || owner.String() != msg.Owner {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of consumer chain %s", msg.Owner, msg.ChainId)
+	}
+
+	if err := k.Keeper.HandleConsumerUpdate(ctx, msg); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed handling UpdateConsumer message")
+	}
+
+	return &types.MsgUpdateConsumerResponse{}, nil
+}
+
+// EmergencyPauseConsumer defines an RPC handler method for MsgEmergencyPauseConsumer
+func (k msgServer) EmergencyPauseConsumer(goCtx context.Context, msg *types.MsgEmergencyPauseConsumer) (*types.MsgEmergencyPauseConsumerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	emergencyAuthority := k.GetEmergencyAuthority(ctx)
+	if emergencyAuthority == "" {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "no emergency authority is set")
+	}
+	if emergencyAuthority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", emergencyAuthority, msg.Authority)
+	}
+
+	if err := k.Keeper.PauseConsumerChain(ctx, msg.ChainId, msg.Authority, true, msg.Reason); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed handling EmergencyPauseConsumer message")
+	}
+
+	return &types.MsgEmergencyPauseConsumerResponse{}, nil
+}
+
+// PauseConsumer defines an RPC handler method for MsgPauseConsumer
+func (k msgServer) PauseConsumer(goCtx context.Context, msg *types.MsgPauseConsumer) (*types.MsgPauseConsumerResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.PauseConsumerChain(ctx, msg.ChainId, msg.Authority, false, msg.Reason); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed handling PauseConsumer message")
+	}
+
+	return &types.MsgPauseConsumerResponse{}, nil
+}
+
+// ResumeConsumer defines an RPC handler method for MsgResumeConsumer
+func (k msgServer) ResumeConsumer(goCtx context.Context, msg *types.MsgResumeConsumer) (*types.MsgResumeConsumerResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ResumeConsumerChain(ctx, msg.ChainId); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed handling ResumeConsumer message")
+	}
+
+	return &types.MsgResumeConsumerResponse{}, nil
+}
+
+// ConsumerRelaunch defines an RPC handler method for MsgConsumerRelaunch
+func (k msgServer) ConsumerRelaunch(goCtx context.Context, msg *types.MsgConsumerRelaunch) (*types.MsgConsumerRelaunchResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.HandleConsumerRelaunchProposal(ctx, msg); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed handling ConsumerRelaunch proposal")
+	}
+
+	return &types.MsgConsumerRelaunchResponse{}, nil
+}
+
 func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.MsgOptInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -237,6 +446,18 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 	}
 	providerConsAddr := types.NewProviderConsAddress(consAddrTmp)
 
+	if msg.ConsumerKey != "" {
+		consumerTMPublicKey, err := k.ParseConsumerKey(msg.ConsumerKey)
+		if err != nil {
+			return nil, err
+		}
+		// the validator must prove that it controls the consumer key before it is assigned
+		if err := k.Keeper.VerifyConsumerKeyPossession(ctx, msg.ChainId, msg.ProviderAddr, providerConsAddr,
+			consumerTMPublicKey, msg.Nonce, msg.ConsumerKeySignature); err != nil {
+			return nil, err
+		}
+	}
+
 	err = k.Keeper.HandleOptIn(ctx, msg.ChainId, providerConsAddr, msg.ConsumerKey)
 	if err != nil {
 		return nil, err
@@ -249,6 +470,11 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 			sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, msg.ConsumerKey),
 		),
 	})
+	k.emitTypedEvent(ctx, &types.EventValidatorOptedIn{
+		ChainId:         msg.ChainId,
+		ProviderValAddr: msg.ProviderAddr,
+		ConsumerKey:     msg.ConsumerKey,
+	})
 
 	return &types.MsgOptInResponse{}, nil
 }
@@ -284,6 +510,10 @@ func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.M
 			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
 		),
 	})
+	k.emitTypedEvent(ctx, &types.EventValidatorOptedOut{
+		ChainId:         msg.ChainId,
+		ProviderValAddr: msg.ProviderAddr,
+	})
 
 	return &types.MsgOptOutResponse{}, nil
 }
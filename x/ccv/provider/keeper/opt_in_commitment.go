@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// SetOptInPolicy sets the opt-in policy of the given consumer chain
+func (k Keeper) SetOptInPolicy(ctx sdk.Context, chainID string, policy types.OptInPolicy) {
+	if err := k.optInPolicies.Set(ctx, chainID, policy); err != nil {
+		panic(fmt.Errorf("failed to set opt-in policy for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetOptInPolicy returns the opt-in policy of the given consumer chain. If the chain has no
+// opt-in policy, the empty policy is returned, i.e., validators can opt in and out at any time.
+func (k Keeper) GetOptInPolicy(ctx sdk.Context, chainID string) types.OptInPolicy {
+	policy, _ := mustGet(ctx, k.optInPolicies.Get, chainID)
+	return policy
+}
+
+// DeleteOptInPolicy deletes the opt-in policy of the given consumer chain
+func (k Keeper) DeleteOptInPolicy(ctx sdk.Context, chainID string) {
+	if err := k.optInPolicies.Remove(ctx, chainID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(fmt.Errorf("failed to delete opt-in policy for consumer chain %s: %w", chainID, err))
+	}
+}
+
+// GetAllOptInPolicies returns the opt-in policies of all the consumer chains, ordered by chain ID length and then by chain ID
+func (k Keeper) GetAllOptInPolicies(ctx sdk.Context) (policies []types.ConsumerOptInPolicy) {
+	err := k.optInPolicies.Walk(ctx, nil, func(chainID string, policy types.OptInPolicy) (bool, error) {
+		policies = append(policies, types.ConsumerOptInPolicy{ChainId: chainID, Policy: policy})
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate opt-in policies: %w", err))
+	}
+	return policies
+}
+
+// SetOptInRecord sets when a validator last opted in to or out of a consumer chain
+func (k Keeper) SetOptInRecord(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+	record types.OptInRecord,
+) {
+	if err := k.optInRecords.Set(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()), record); err != nil {
+		panic(fmt.Errorf("failed to set opt-in record: %w", err))
+	}
+}
+
+// GetOptInRecord returns when a validator last opted in to or out of a consumer chain, if it did
+func (k Keeper) GetOptInRecord(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) (types.OptInRecord, bool) {
+	return mustGet(ctx, k.optInRecords.Get, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+}
+
+// GetAllOptInRecords returns the opt-in records of the validators on all consumer chains,
+// or only on chainID if it is not nil, ordered by chain ID length, chain ID and provider address
+func (k Keeper) GetAllOptInRecords(ctx sdk.Context, chainID *string) (records []types.ValidatorOptInRecord) {
+	var ranger collections.Ranger[collections.Pair[string, sdk.ConsAddress]]
+	if chainID != nil {
+		ranger = collections.NewPrefixedPairRange[string, sdk.ConsAddress](*chainID)
+	}
+	err := k.optInRecords.Walk(ctx, ranger,
+		func(key collections.Pair[string, sdk.ConsAddress], record types.OptInRecord) (bool, error) {
+			records = append(records, types.ValidatorOptInRecord{
+				ChainId:      key.K1(),
+				ProviderAddr: key.K2(),
+				Record:       record,
+			})
+			return false, nil
+		})
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate opt-in records: %w", err))
+	}
+	return records
+}
+
+// DeleteAllOptInRecords deletes the opt-in records of all the validators on the given consumer chain
+func (k Keeper) DeleteAllOptInRecords(ctx sdk.Context, chainID string) {
+	err := k.optInRecords.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.ConsAddress](chainID))
+	if err != nil {
+		panic(fmt.Errorf("failed to delete opt-in records: %w", err))
+	}
+}
+
+// getOptInEpoch returns the number of the current provider epoch if the epochs of consumer chain
+// `chainID` are based on block time, or 0 if they are based on block height
+func (k Keeper) getOptInEpoch(ctx sdk.Context, chainID string) uint64 {
+	if !k.IsTimeBasedEpoch(ctx, chainID) {
+		return 0
+	}
+	return k.GetCurrentEpochState(ctx).Number
+}
+
+// isCountedInTimeBasedEpochs returns whether a commitment or a cooldown that starts with the
+// given epoch number is counted in epochs based on block time. This is the case if the epochs of
+// consumer chain `chainID` were based on block time when the validator opted in or out, and still are.
+func (k Keeper) isCountedInTimeBasedEpochs(ctx sdk.Context, chainID string, epoch uint64) bool {
+	return epoch != 0 && k.IsTimeBasedEpoch(ctx, chainID)
+}
+
+// startOptInCommitments counts the commitments of the validators that opted in to consumer chain
+// `chainID` before the establishment of its CCV channel from the current epoch, if the epochs of
+// the chain are based on block time. With epochs of blocks, the commitments are counted from the
+// init chain height instead.
+func (k Keeper) startOptInCommitments(ctx sdk.Context, chainID string) {
+	epoch := k.getOptInEpoch(ctx, chainID)
+	if epoch == 0 {
+		return
+	}
+	for _, r := range k.GetAllOptInRecords(ctx, &chainID) {
+		if r.Record.OptInHeight != 0 && r.Record.OptInEpoch < epoch {
+			r.Record.OptInEpoch = epoch
+			k.SetOptInRecord(ctx, chainID, types.NewProviderConsAddress(r.ProviderAddr), r.Record)
+		}
+	}
+}
+
+// GetOptInCommitmentEndHeight returns the last height of the epoch at the end of which the
+// commitment of a validator that opted in to a consumer chain ends, or 0 if the validator opted out
+// or if the commitment is counted in epochs based on block time, see GetOptInCommitmentEndEpoch.
+// The commitment is counted from the end of the epoch in which the validator opted in or, if later,
+// in which the CCV channel to the consumer chain was established.
+func (k Keeper) GetOptInCommitmentEndHeight(ctx sdk.Context, chainID string, record types.OptInRecord) int64 {
+	if record.OptInHeight == 0 || k.isCountedInTimeBasedEpochs(ctx, chainID, record.OptInEpoch) {
+		return 0
+	}
+	start := k.GetEpochEndHeight(ctx, chainID, record.OptInHeight)
+	if initHeight, found := k.GetInitChainHeight(ctx, chainID); found {
+		start = max(start, k.GetEpochEndHeight(ctx, chainID, int64(initHeight)))
+	}
+	return start + int64(k.GetOptInPolicy(ctx, chainID).MinCommitmentEpochs)*k.GetConsumerBlocksPerEpoch(ctx, chainID)
+}
+
+// GetOptInCommitmentEndEpoch returns the number of the epoch based on block time at the end of which
+// the commitment of a validator that opted in to a consumer chain ends, or 0 if the validator opted
+// out or if the commitment is counted in epochs of blocks, see GetOptInCommitmentEndHeight
+func (k Keeper) GetOptInCommitmentEndEpoch(ctx sdk.Context, chainID string, record types.OptInRecord) uint64 {
+	if record.OptInHeight == 0 || !k.isCountedInTimeBasedEpochs(ctx, chainID, record.OptInEpoch) {
+		return 0
+	}
+	return record.OptInEpoch + uint64(k.GetOptInPolicy(ctx, chainID).MinCommitmentEpochs)
+}
+
+// GetOptInCooldownEndHeight returns the last height of the epoch at the end of which the cooldown
+// of a validator that opted out of a consumer chain ends, or 0 if the validator opted in or if the
+// cooldown is counted in epochs based on block time, see GetOptInCooldownEndEpoch
+func (k Keeper) GetOptInCooldownEndHeight(ctx sdk.Context, chainID string, record types.OptInRecord) int64 {
+	if record.OptOutHeight == 0 || k.isCountedInTimeBasedEpochs(ctx, chainID, record.OptOutEpoch) {
+		return 0
+	}
+	return k.GetEpochEndHeight(ctx, chainID, record.OptOutHeight) +
+		int64(k.GetOptInPolicy(ctx, chainID).OptInCooldownEpochs)*k.GetConsumerBlocksPerEpoch(ctx, chainID)
+}
+
+// GetOptInCooldownEndEpoch returns the number of the epoch based on block time at the end of which
+// the cooldown of a validator that opted out of a consumer chain ends, or 0 if the validator opted in
+// or if the cooldown is counted in epochs of blocks, see GetOptInCooldownEndHeight
+func (k Keeper) GetOptInCooldownEndEpoch(ctx sdk.Context, chainID string, record types.OptInRecord) uint64 {
+	if record.OptOutHeight == 0 || !k.isCountedInTimeBasedEpochs(ctx, chainID, record.OptOutEpoch) {
+		return 0
+	}
+	return record.OptOutEpoch + uint64(k.GetOptInPolicy(ctx, chainID).OptInCooldownEpochs)
+}
+
+// checkOptInCommitmentEnded returns an error if the commitment of a validator to a consumer chain
+// does not end by the end of the current epoch, at which an opt-out would take effect
+func (k Keeper) checkOptInCommitmentEnded(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) error {
+	record, found := k.GetOptInRecord(ctx, chainID, providerAddr)
+	if !found || k.GetOptInPolicy(ctx, chainID).MinCommitmentEpochs == 0 {
+		return nil
+	}
+	if endEpoch := k.GetOptInCommitmentEndEpoch(ctx, chainID, record); endEpoch != 0 {
+		if k.GetCurrentEpochState(ctx).Number < endEpoch {
+			return errorsmod.Wrapf(types.ErrOptInCommitmentNotEnded,
+				"validator %s can opt out of consumer chain %s from epoch %d",
+				providerAddr.String(), chainID, endEpoch)
+		}
+		return nil
+	}
+	if endHeight := k.GetOptInCommitmentEndHeight(ctx, chainID, record); k.GetEpochEndHeight(ctx, chainID, ctx.BlockHeight()) < endHeight {
+		return errorsmod.Wrapf(types.ErrOptInCommitmentNotEnded,
+			"validator %s can opt out of consumer chain %s from the epoch that ends at height %d",
+			providerAddr.String(), chainID, endHeight)
+	}
+	return nil
+}
+
+// checkOptInCooldownEnded returns an error if the cooldown of a validator that opted out of a
+// consumer chain does not end by the end of the current epoch, at which an opt-in would take effect
+func (k Keeper) checkOptInCooldownEnded(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) error {
+	record, found := k.GetOptInRecord(ctx, chainID, providerAddr)
+	if !found || k.GetOptInPolicy(ctx, chainID).OptInCooldownEpochs == 0 {
+		return nil
+	}
+	if endEpoch := k.GetOptInCooldownEndEpoch(ctx, chainID, record); endEpoch != 0 {
+		if k.GetCurrentEpochState(ctx).Number < endEpoch {
+			return errorsmod.Wrapf(types.ErrOptInCooldownNotEnded,
+				"validator %s can opt in to consumer chain %s again from epoch %d",
+				providerAddr.String(), chainID, endEpoch)
+		}
+		return nil
+	}
+	if endHeight := k.GetOptInCooldownEndHeight(ctx, chainID, record); k.GetEpochEndHeight(ctx, chainID, ctx.BlockHeight()) < endHeight {
+		return errorsmod.Wrapf(types.ErrOptInCooldownNotEnded,
+			"validator %s can opt in to consumer chain %s again from the epoch that ends at height %d",
+			providerAddr.String(), chainID, endHeight)
+	}
+	return nil
+}
+
+// GetOptInCommitment returns the commitment or the cooldown of a validator on a consumer chain
+func (k Keeper) GetOptInCommitment(ctx sdk.Context, r types.ValidatorOptInRecord) types.OptInCommitment {
+	providerAddr := types.NewProviderConsAddress(r.ProviderAddr)
+	return types.OptInCommitment{
+		ProviderAddress:     providerAddr.String(),
+		OptedIn:             k.IsOptedIn(ctx, r.ChainId, providerAddr),
+		OptInHeight:         r.Record.OptInHeight,
+		CommitmentEndHeight: k.GetOptInCommitmentEndHeight(ctx, r.ChainId, r.Record),
+		OptOutHeight:        r.Record.OptOutHeight,
+		CooldownEndHeight:   k.GetOptInCooldownEndHeight(ctx, r.ChainId, r.Record),
+		CommitmentEndEpoch:  k.GetOptInCommitmentEndEpoch(ctx, r.ChainId, r.Record),
+		CooldownEndEpoch:    k.GetOptInCooldownEndEpoch(ctx, r.ChainId, r.Record),
+	}
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// TestOptInCommitmentAndCooldown tests that a validator cannot opt out of a consumer chain before
+// the end of its commitment, nor opt in again before the end of its cooldown
+func TestOptInCommitmentAndCooldown(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	state := newStakingState(mocks, 2)
+	providerAddr := state.providerAddr(0)
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	providerKeeper.SetInitChainHeight(ctx, "chain", 5)
+	providerKeeper.SetOptInPolicy(ctx, "chain", providertypes.OptInPolicy{MinCommitmentEpochs: 3, OptInCooldownEpochs: 2})
+
+	// the validator starts validating at the end of the epoch that ends at height 20,
+	// so that its commitment ends at the end of the epoch that ends at height 50
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chain", providerAddr, ""))
+	record, found := providerKeeper.GetOptInRecord(ctx, "chain", providerAddr)
+	require.True(t, found)
+	require.Equal(t, int64(50), providerKeeper.GetOptInCommitmentEndHeight(ctx, "chain", record))
+
+	// opting in again does not extend the commitment
+	ctx = ctx.WithBlockHeight(25)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chain", providerAddr, ""))
+	require.Equal(t, int64(50), providerKeeper.GetOptInCommitmentEndHeight(ctx, "chain", record))
+
+	ctx = ctx.WithBlockHeight(40)
+	err := providerKeeper.HandleOptOut(ctx, "chain", providerAddr)
+	require.ErrorIs(t, err, providertypes.ErrOptInCommitmentNotEnded)
+	require.True(t, providerKeeper.IsOptedIn(ctx, "chain", providerAddr))
+
+	// the opt-out takes effect at the end of the last epoch of the commitment
+	ctx = ctx.WithBlockHeight(41)
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "chain", providerAddr))
+	require.False(t, providerKeeper.IsOptedIn(ctx, "chain", providerAddr))
+
+	// the validator stops validating at the end of the epoch that ends at height 50,
+	// so that its cooldown ends at the end of the epoch that ends at height 70
+	ctx = ctx.WithBlockHeight(60)
+	err = providerKeeper.HandleOptIn(ctx, "chain", providerAddr, "")
+	require.ErrorIs(t, err, providertypes.ErrOptInCooldownNotEnded)
+	require.False(t, providerKeeper.IsOptedIn(ctx, "chain", providerAddr))
+
+	res, err := providerKeeper.QueryOptInCommitments(ctx, &providertypes.QueryOptInCommitmentsRequest{
+		ChainId:         "chain",
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.OptInPolicy{MinCommitmentEpochs: 3, OptInCooldownEpochs: 2}, res.Policy)
+	require.Equal(t, []*providertypes.OptInCommitment{{
+		ProviderAddress:   providerAddr.String(),
+		OptOutHeight:      41,
+		CooldownEndHeight: 70,
+	}}, res.Commitments)
+
+	ctx = ctx.WithBlockHeight(61)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chain", providerAddr, ""))
+	require.True(t, providerKeeper.IsOptedIn(ctx, "chain", providerAddr))
+
+	// the commitment of a validator that opts in before the consumer chain is launched
+	// is counted from the establishment of the CCV channel
+	providerKeeper.SetProposedConsumerChain(ctx, "pending-chain", 1)
+	providerKeeper.SetOptInPolicy(ctx, "pending-chain", providertypes.OptInPolicy{MinCommitmentEpochs: 1})
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "pending-chain", providerAddr, ""))
+	providerKeeper.SetInitChainHeight(ctx, "pending-chain", 95)
+	record, _ = providerKeeper.GetOptInRecord(ctx, "pending-chain", providerAddr)
+	require.Equal(t, int64(110), providerKeeper.GetOptInCommitmentEndHeight(ctx, "pending-chain", record))
+
+	// validators can opt in and out at any time on chains without an opt-in policy
+	providerKeeper.SetConsumerClientId(ctx, "other-chain", "clientID")
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "other-chain", providerAddr, ""))
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "other-chain", providerAddr))
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "other-chain", providerAddr, ""))
+
+	providerKeeper.DeleteAllOptInRecords(ctx, "chain")
+	require.Len(t, providerKeeper.GetAllOptInRecords(ctx, nil), 2)
+}
+
+// TestOptInCommitmentAndCooldownTimeBasedEpochs tests that the commitments and the cooldowns are
+// counted in epochs based on block time on the consumer chains that have them
+func TestOptInCommitmentAndCooldownTimeBasedEpochs(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.EpochDuration = time.Hour
+	providerKeeper.SetParams(ctx, params)
+	start := time.Unix(3600, 0).UTC()
+	setEpoch := func(number uint64) {
+		providerKeeper.SetEpochState(ctx, providertypes.EpochState{
+			Number:    number,
+			StartTime: start.Add(time.Duration(number) * time.Hour),
+		})
+	}
+
+	state := newStakingState(mocks, 2)
+	providerAddr := state.providerAddr(0)
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	providerKeeper.SetInitChainHeight(ctx, "chain", 5)
+	providerKeeper.SetOptInPolicy(ctx, "chain", providertypes.OptInPolicy{MinCommitmentEpochs: 2, OptInCooldownEpochs: 1})
+
+	// the validator starts validating at the end of epoch 3, so that its commitment ends at the end of epoch 5
+	setEpoch(3)
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chain", providerAddr, ""))
+	record, found := providerKeeper.GetOptInRecord(ctx, "chain", providerAddr)
+	require.True(t, found)
+	require.Equal(t, uint64(3), record.OptInEpoch)
+	require.Equal(t, uint64(5), providerKeeper.GetOptInCommitmentEndEpoch(ctx, "chain", record))
+	require.Zero(t, providerKeeper.GetOptInCommitmentEndHeight(ctx, "chain", record))
+
+	// the epochs do not depend on the block heights
+	setEpoch(4)
+	ctx = ctx.WithBlockHeight(1000)
+	err := providerKeeper.HandleOptOut(ctx, "chain", providerAddr)
+	require.ErrorIs(t, err, providertypes.ErrOptInCommitmentNotEnded)
+
+	// the opt-out takes effect at the end of the last epoch of the commitment
+	setEpoch(5)
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "chain", providerAddr))
+	err = providerKeeper.HandleOptIn(ctx, "chain", providerAddr, "")
+	require.ErrorIs(t, err, providertypes.ErrOptInCooldownNotEnded)
+
+	res, err := providerKeeper.QueryOptInCommitments(ctx, &providertypes.QueryOptInCommitmentsRequest{
+		ChainId:         "chain",
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*providertypes.OptInCommitment{{
+		ProviderAddress:  providerAddr.String(),
+		OptOutHeight:     1000,
+		CooldownEndEpoch: 6,
+	}}, res.Commitments)
+
+	setEpoch(6)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "chain", providerAddr, ""))
+
+	// the commitment of a validator that opts in before the consumer chain is launched
+	// is counted from the epoch in which the CCV channel is established
+	providerKeeper.SetProposedConsumerChain(ctx, "pending-chain", 1)
+	providerKeeper.SetOptInPolicy(ctx, "pending-chain", providertypes.OptInPolicy{MinCommitmentEpochs: 1})
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "pending-chain", providerAddr, ""))
+	setEpoch(8)
+	gomock.InOrder(testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, "pending-chain")...)
+	require.NoError(t, providerKeeper.SetConsumerChain(ctx, "channelID"))
+	record, _ = providerKeeper.GetOptInRecord(ctx, "pending-chain", providerAddr)
+	require.Equal(t, uint64(9), providerKeeper.GetOptInCommitmentEndEpoch(ctx, "pending-chain", record))
+
+	// the commitments made with epochs of blocks are still counted in blocks
+	record = providertypes.OptInRecord{OptInHeight: 15}
+	require.Zero(t, providerKeeper.GetOptInCommitmentEndEpoch(ctx, "chain", record))
+	require.Equal(t, int64(40), providerKeeper.GetOptInCommitmentEndHeight(ctx, "chain", record))
+
+	// the consumer chains with an epoch length of their own count the commitments in epochs of blocks
+	providerKeeper.SetConsumerEpochParams(ctx, "chain", providertypes.ConsumerEpochParams{BlocksPerEpoch: 20})
+	record = providertypes.OptInRecord{OptInHeight: 15, OptInEpoch: 3}
+	require.Zero(t, providerKeeper.GetOptInCommitmentEndEpoch(ctx, "chain", record))
+	require.Equal(t, int64(60), providerKeeper.GetOptInCommitmentEndHeight(ctx, "chain", record))
+}
+
+// TestModifyOptInPolicy tests that governance can replace the opt-in policy of a consumer chain
+func TestModifyOptInPolicy(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	policy := providertypes.OptInPolicy{MinCommitmentEpochs: 4, OptInCooldownEpochs: 1}
+	err := providerKeeper.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+		ChainId:     "chain",
+		OptInPolicy: &policy,
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerModificationProposal)
+
+	providerKeeper.SetConsumerClientId(ctx, "chain", "clientID")
+	require.NoError(t, providerKeeper.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+		ChainId:     "chain",
+		OptInPolicy: &policy,
+	}))
+	require.Equal(t, policy, providerKeeper.GetOptInPolicy(ctx, "chain"))
+
+	// the policy is kept when a modification does not set it
+	require.NoError(t, providerKeeper.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{ChainId: "chain"}))
+	require.Equal(t, []providertypes.ConsumerOptInPolicy{{ChainId: "chain", Policy: policy}}, providerKeeper.GetAllOptInPolicies(ctx))
+}
@@ -66,6 +66,41 @@ func (k Keeper) GetNumberOfEpochsToStartReceivingRewards(ctx sdk.Context) int64
 	return params.NumberOfEpochsToStartReceivingRewards
 }
 
+// GetMaxVSCPacketSize returns the maximum number of validator updates and slash acks
+// that can be sent in a single VSC packet; zero means no limit
+func (k Keeper) GetMaxVSCPacketSize(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxVscPacketSize
+}
+
+// GetEmergencyAuthority returns the account that can pause consumer chains with
+// MsgEmergencyPauseConsumer, or an empty string if the emergency pause is disabled
+func (k Keeper) GetEmergencyAuthority(ctx sdk.Context) string {
+	params := k.GetParams(ctx)
+	return params.EmergencyAuthority
+}
+
+// GetKeyAssignmentHistorySize returns the number of consumer keys kept in the key assignment
+// history of a validator on a consumer chain; zero means that no history is kept
+func (k Keeper) GetKeyAssignmentHistorySize(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.KeyAssignmentHistorySize
+}
+
+// GetEpochDuration returns the duration of an epoch when the epochs are based on block time;
+// zero means that the epochs are based on block height
+func (k Keeper) GetEpochDuration(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.EpochDuration
+}
+
+// GetStoppedConsumerRetentionPeriod returns the duration for which a stopped consumer chain
+// can be relaunched; zero means that stopped consumer chains cannot be relaunched
+func (k Keeper) GetStoppedConsumerRetentionPeriod(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.StoppedConsumerRetentionPeriod
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
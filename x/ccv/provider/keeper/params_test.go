@@ -49,6 +49,11 @@ func TestParams(t *testing.T) {
 		},
 		600,
 		24,
+		100,
+		sdk.AccAddress([]byte("emergency")).String(),
+		10,
+		6*time.Hour,
+		2*7*24*time.Hour,
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
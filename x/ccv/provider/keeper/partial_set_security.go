@@ -14,7 +14,8 @@ import (
 )
 
 // HandleOptIn prepares validator `providerAddr` to opt in to `chainID` with an optional `consumerKey` consumer public key.
-// Note that the validator only opts in at the end of an epoch.
+// Note that the validator only opts in at the end of an epoch, which has to be after the
+// end of its cooldown if it opted out of the chain before.
 func (k Keeper) HandleOptIn(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress, consumerKey string) error {
 	if !k.IsConsumerProposedOrRegistered(ctx, chainID) {
 		return errorsmod.Wrapf(
@@ -22,7 +23,20 @@ func (k Keeper) HandleOptIn(ctx sdk.Context, chainID string, providerAddr types.
 			"opting in to an unknown consumer chain, with id: %s", chainID)
 	}
 
+	if !k.IsOptedIn(ctx, chainID, providerAddr) {
+		// a validator that opted out can only opt in again after its cooldown, while
+		// a validator that is already opted in keeps its commitment
+		if err := k.checkOptInCooldownEnded(ctx, chainID, providerAddr); err != nil {
+			return err
+		}
+		k.SetOptInRecord(ctx, chainID, providerAddr, types.OptInRecord{
+			OptInHeight: ctx.BlockHeight(),
+			OptInEpoch:  k.getOptInEpoch(ctx, chainID),
+		})
+	}
+
 	k.SetOptedIn(ctx, chainID, providerAddr)
+	k.MarkConsumerValidatorDirty(ctx, chainID, providerAddr)
 
 	if consumerKey != "" {
 		consumerTMPublicKey, err := k.ParseConsumerKey(consumerKey)
@@ -41,11 +55,17 @@ func (k Keeper) HandleOptIn(ctx sdk.Context, chainID string, providerAddr types.
 		}
 	}
 
+	if k.hooks != nil {
+		k.callHookInCachedCtx(ctx, "AfterValidatorOptedIn", func(cachedCtx sdk.Context) error {
+			return k.hooks.AfterValidatorOptedIn(cachedCtx, chainID, providerAddr.ToSdkConsAddr())
+		})
+	}
 	return nil
 }
 
 // HandleOptOut prepares validator `providerAddr` to opt out from running `chainID`.
-// Note that the validator only opts out at the end of an epoch.
+// Note that the validator only opts out at the end of an epoch, which has to be after the
+// end of its commitment to the chain.
 func (k Keeper) HandleOptOut(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) error {
 	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
 		// A validator can only opt out from a running chain. We check this by checking the consumer client id, because
@@ -84,7 +104,22 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, chainID string, providerAddr types
 		}
 	}
 
+	if err := k.checkOptInCommitmentEnded(ctx, chainID, providerAddr); err != nil {
+		return err
+	}
+
 	k.DeleteOptedIn(ctx, chainID, providerAddr)
+	k.SetOptInRecord(ctx, chainID, providerAddr, types.OptInRecord{
+		OptOutHeight: ctx.BlockHeight(),
+		OptOutEpoch:  k.getOptInEpoch(ctx, chainID),
+	})
+	k.MarkConsumerValidatorDirty(ctx, chainID, providerAddr)
+
+	if k.hooks != nil {
+		k.callHookInCachedCtx(ctx, "AfterValidatorOptedOut", func(cachedCtx sdk.Context) error {
+			return k.hooks.AfterValidatorOptedOut(cachedCtx, chainID, providerAddr.ToSdkConsAddr())
+		})
+	}
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
@@ -47,6 +48,12 @@ func (k Keeper) HandleConsumerAdditionProposal(ctx sdk.Context, proposal *types.
 		Allowlist:                         proposal.Allowlist,
 		Denylist:                          proposal.Denylist,
 		ConnectionId:                      proposal.ConnectionId,
+		Metadata:                          proposal.Metadata,
+		Owner:                             proposal.Owner,
+		AllowedKeyTypes:                   proposal.AllowedKeyTypes,
+		OptInPolicy:                       proposal.OptInPolicy,
+		LaunchThresholds:                  proposal.LaunchThresholds,
+		EpochParams:                       proposal.EpochParams,
 	}
 
 	return k.HandleLegacyConsumerAdditionProposal(ctx, &p)
@@ -82,36 +89,46 @@ func (k Keeper) HandleConsumerModificationProposal(ctx sdk.Context, proposal *ty
 
 	// Optional pre-launch rename
 	if s := strings.TrimSpace(proposal.NewChainId); s != "" && s != chainID {
-		if err := types.ValidateChainId("NewChainId", s); err != nil {
-			return errorsmod.Wrapf(types.ErrInvalidConsumerModificationProposal,
-				"invalid new chain id: %s", err.Error())
+		if err := k.updatePrelaunchChainID(ctx, chainID, s); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidConsumerModificationProposal, err.Error())
 		}
+		chainID = s
+	}
 
-		// launched == has client-id
-		if _, launched := k.GetConsumerClientId(ctx, chainID); launched {
+	if proposal.Metadata != nil {
+		if _, found := k.GetConsumerMetadata(ctx, chainID); !found && !k.isConsumerPendingOrRegistered(ctx, chainID) {
 			return errorsmod.Wrapf(types.ErrInvalidConsumerModificationProposal,
-				"cannot update chain id of a launched chain: %s", chainID)
+				"cannot update the metadata of an unknown consumer chain: %s", chainID)
 		}
+		k.SetConsumerMetadata(ctx, chainID, *proposal.Metadata)
+	}
 
-		// ensure target not taken (taken == has client-id)
-		if _, taken := k.GetConsumerClientId(ctx, s); taken {
+	if proposal.Owner != "" {
+		if !k.isConsumerPendingOrRegistered(ctx, chainID) {
 			return errorsmod.Wrapf(types.ErrInvalidConsumerModificationProposal,
-				"target chain id already exists: %s", s)
+				"cannot set the owner of an unknown consumer chain: %s", chainID)
 		}
-
-		// Move any chain-scoped state you’re tracking prelaunch
-		if err := k.renameConsumerChain(ctx, chainID, s); err != nil {
-			return err
+		owner, err := sdk.AccAddressFromBech32(proposal.Owner)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidConsumerModificationProposal, err.Error())
 		}
+		k.SetConsumerOwner(ctx, chainID, owner)
+	}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent("consumer_chain_renamed",
-				sdk.NewAttribute("old_chain_id", chainID),
-				sdk.NewAttribute("new_chain_id", s),
-			),
-		)
+	if proposal.OptInPolicy != nil {
+		if !k.isConsumerPendingOrRegistered(ctx, chainID) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerModificationProposal,
+				"cannot set the opt-in policy of an unknown consumer chain: %s", chainID)
+		}
+		k.SetOptInPolicy(ctx, chainID, *proposal.OptInPolicy)
+	}
 
-		chainID = s
+	if proposal.EpochParams != nil {
+		if !k.isConsumerPendingOrRegistered(ctx, chainID) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerModificationProposal,
+				"cannot set the epoch params of an unknown consumer chain: %s", chainID)
+		}
+		k.SetConsumerEpochParams(ctx, chainID, *proposal.EpochParams)
 	}
 
 	// Only call legacy path if the chain is actually running (has client-id).
@@ -132,6 +149,38 @@ func (k Keeper) HandleConsumerModificationProposal(ctx sdk.Context, proposal *ty
 	return k.HandleLegacyConsumerModificationProposal(ctx, &legacy)
 }
 
+// updatePrelaunchChainID validates and applies the rename of a consumer chain that
+// is not launched yet, emitting the corresponding events.
+func (k Keeper) updatePrelaunchChainID(ctx sdk.Context, oldID, newID string) error {
+	if err := types.ValidateChainId("NewChainId", newID); err != nil {
+		return fmt.Errorf("invalid new chain id: %w", err)
+	}
+
+	// launched == has client-id
+	if _, launched := k.GetConsumerClientId(ctx, oldID); launched {
+		return fmt.Errorf("cannot update chain id of a launched chain: %s", oldID)
+	}
+
+	// ensure target not taken, either by a running chain or by a chain waiting for its spawn time
+	if k.isConsumerPendingOrRegistered(ctx, newID) {
+		return fmt.Errorf("target chain id already exists: %s", newID)
+	}
+
+	// Move any chain-scoped state you’re tracking prelaunch
+	if err := k.renameConsumerChain(ctx, oldID, newID); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("consumer_chain_renamed",
+			sdk.NewAttribute("old_chain_id", oldID),
+			sdk.NewAttribute("new_chain_id", newID),
+		),
+	)
+	k.emitTypedEvent(ctx, &types.EventConsumerRenamed{OldChainId: oldID, NewChainId: newID})
+	return nil
+}
+
 // renameConsumerChain moves all chain-scoped state from oldID -> newID.
 // It handles exact keys (singletons) and prefixed collections that include the chain-id in the key.
 func (k Keeper) renameConsumerChain(ctx sdk.Context, oldID, newID string) error {
@@ -185,6 +234,12 @@ func (k Keeper) renameConsumerChain(ctx sdk.Context, oldID, newID string) error
 	moveIf(types.ValidatorSetCapKey(oldID), types.ValidatorSetCapKey(newID))
 	moveIf(types.ValidatorsPowerCapKey(oldID), types.ValidatorsPowerCapKey(newID))
 
+	// --- cross-chain prune time index keyed by (pruneTs + chain-id) ---
+	for _, addrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, oldID) {
+		moveIf(types.ConsumerAddrsToPruneTimeIndexKey(addrsToPrune.PruneTs, oldID),
+			types.ConsumerAddrsToPruneTimeIndexKey(addrsToPrune.PruneTs, newID))
+	}
+
 	// --- collections prefixed by (prefixByte + chain-id + suffix) ---
 	migrateByPrefixByte(types.ConsumerValidatorBytePrefix)
 	migrateByPrefixByte(types.ConsumerValidatorsBytePrefix)
@@ -194,6 +249,25 @@ func (k Keeper) renameConsumerChain(ctx sdk.Context, oldID, newID string) error
 	migrateByPrefixByte(types.DenylistPrefix)
 	migrateByPrefixByte(types.ConsumerAddrsToPruneV2BytePrefix)
 	migrateByPrefixByte(types.ThrottledPacketDataBytePrefix)
+	migrateByPrefixByte(types.ConsumerMetadataBytePrefix)
+	migrateByPrefixByte(types.ConsumerOwnerBytePrefix)
+	migrateByPrefixByte(types.KeyAssignmentNonceBytePrefix)
+	migrateByPrefixByte(types.ConsumerKeyRotationBytePrefix)
+	migrateByPrefixByte(types.KeyAssignmentHistoryBytePrefix)
+	migrateByPrefixByte(types.OptInPolicyBytePrefix)
+	migrateByPrefixByte(types.OptInRecordBytePrefix)
+	migrateByPrefixByte(types.ReadinessSignalBytePrefix)
+	migrateByPrefixByte(types.ConsumerEpochParamsBytePrefix)
+
+	// --- pending addition proposals keyed by (spawnTime + chain-id), with the chain-id also in the VALUE ---
+	for _, prop := range k.GetAllPendingConsumerAdditionProps(ctx) {
+		if prop.ChainId != oldID {
+			continue
+		}
+		k.DeletePendingConsumerAdditionProps(ctx, prop)
+		prop.ChainId = newID
+		k.SetPendingConsumerAdditionProp(ctx, &prop)
+	}
 
 	// --- proposal side-table where VALUE == chain-id (rewrite values) ---
 	it := storetypes.KVStorePrefixIterator(kv, []byte{types.ProposedConsumerChainByteKey})
@@ -263,6 +337,8 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 		return err
 	}
 	k.SetConsumerClientId(ctx, chainID, clientID)
+	// a relaunched chain is no longer stopped
+	k.DeleteStoppedConsumer(ctx, chainID)
 
 	k.Logger(ctx).Info("consumer chain registered (client created)",
 		"chainID", chainID,
@@ -292,16 +368,23 @@ func (k Keeper) CreateConsumerClient(ctx sdk.Context, prop *types.ConsumerAdditi
 // Spec tag: [CCV-PCF-STCC.1]
 func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan bool) (err error) {
 	// check that a client for chainID exists
-	if _, found := k.GetConsumerClientId(ctx, chainID); !found {
+	clientID, found := k.GetConsumerClientId(ctx, chainID)
+	if !found {
 		return errorsmod.Wrap(types.ErrConsumerChainNotFound,
 			fmt.Sprintf("cannot stop non-existent consumer chain: %s", chainID))
 	}
 
+	// keep the launch parameters, so that the chain can be relaunched
+	k.recordStoppedConsumer(ctx, chainID, clientID)
+
 	// clean up states
 	k.DeleteConsumerClientId(ctx, chainID)
 	k.DeleteConsumerGenesis(ctx, chainID)
 	// Note: this call panics if the key assignment state is invalid
 	k.DeleteKeyAssignments(ctx, chainID)
+	k.DeleteAllConsumerKeyRotations(ctx, chainID)
+	// the key assignment history is kept, so that the keys used on the chain are known after
+	// it stopped, and the history size bounds it
 	k.DeleteMinimumPowerInTopN(ctx, chainID)
 	k.DeleteEquivocationEvidenceMinHeight(ctx, chainID)
 
@@ -323,7 +406,6 @@ func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan boo
 			}
 		}
 		k.DeleteChainToChannel(ctx, chainID)
-		k.DeleteChannelToChain(ctx, channelID)
 
 	}
 
@@ -338,9 +420,26 @@ func (k Keeper) StopConsumerChain(ctx sdk.Context, chainID string, closeChan boo
 	k.DeleteDenylist(ctx, chainID)
 
 	k.DeleteAllOptedIn(ctx, chainID)
+	k.DeleteAllOptInRecords(ctx, chainID)
+	k.DeleteOptInPolicy(ctx, chainID)
+	k.DeleteAllReadinessSignals(ctx, chainID)
+	k.DeleteConsumerEpochParams(ctx, chainID)
 	k.DeleteConsumerValSet(ctx, chainID)
+	k.DeleteConsumerValSetTracked(ctx, chainID)
+	k.DeleteAllDirtyConsumerValidators(ctx, chainID)
+	k.DeleteConsumerMetadata(ctx, chainID)
+	k.DeleteConsumerOwner(ctx, chainID)
+	k.DeleteConsumerPause(ctx, chainID)
+	k.DeleteConsumerToCatchUp(ctx, chainID)
 
 	k.Logger(ctx).Info("consumer chain removed from provider", "chainID", chainID)
+	k.emitTypedEvent(ctx, &types.EventConsumerStopped{ChainId: chainID})
+
+	if k.hooks != nil {
+		k.callHookInCachedCtx(ctx, "AfterConsumerStopped", func(cachedCtx sdk.Context) error {
+			return k.hooks.AfterConsumerStopped(cachedCtx, chainID)
+		})
+	}
 
 	return nil
 }
@@ -424,6 +523,7 @@ func (k Keeper) MakeConsumerGenesis(
 		[]string{},
 		ccv.DefaultRetryDelayPeriod,
 	)
+	consumerGenesisParams.AllowedKeyTypes = prop.AllowedKeyTypes
 
 	// Check if we're reusing an existing connection
 	var consumerConnectionId string
@@ -461,6 +561,7 @@ func (k Keeper) MakeConsumerGenesis(
 	// Set connection_id and preCCV fields if we're reusing an existing connection
 	gen.ConnectionId = consumerConnectionId
 	gen.PreCCV = preCCV
+	gen.Relaunch = prop.Relaunch
 
 	return gen, hash, nil
 }
@@ -472,13 +573,16 @@ func (k Keeper) MakeConsumerGenesis(
 // Thus, if multiple consumer addition proposal for the same chain will pass at
 // the same time, then only the last one will be stored.
 func (k Keeper) SetPendingConsumerAdditionProp(ctx sdk.Context, prop *types.ConsumerAdditionProposal) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := prop.Marshal()
-	if err != nil {
+	if err := k.pendingConsumerAdditionProps.Set(ctx, pendingPropKey(prop.SpawnTime, prop.ChainId), *prop); err != nil {
 		// An error here would indicate something is very wrong
 		panic(fmt.Errorf("failed to marshal consumer addition proposal: %w", err))
 	}
-	store.Set(types.PendingCAPKey(prop.SpawnTime, prop.ChainId), bz)
+}
+
+// pendingPropKey returns the collections key of a pending consumer addition or removal
+// proposal, i.e., the pair (timestamp.UnixNano(), chainID)
+func pendingPropKey(timestamp time.Time, chainID string) collections.Pair[uint64, string] {
+	return collections.Join(uint64(timestamp.UTC().UnixNano()), chainID)
 }
 
 // GetPendingConsumerAdditionProp retrieves a pending consumer addition proposal
@@ -488,19 +592,33 @@ func (k Keeper) SetPendingConsumerAdditionProp(ctx sdk.Context, prop *types.Cons
 func (k Keeper) GetPendingConsumerAdditionProp(ctx sdk.Context, spawnTime time.Time,
 	chainID string,
 ) (prop types.ConsumerAdditionProposal, found bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.PendingCAPKey(spawnTime, chainID))
-	if bz == nil {
-		return prop, false
-	}
-	err := prop.Unmarshal(bz)
-	if err != nil {
-		// An error here would indicate something is very wrong,
-		// the ConsumerAdditionProp is assumed to be correctly serialized in SetPendingConsumerAdditionProp.
-		panic(fmt.Errorf("failed to unmarshal consumer addition proposal: %w", err))
+	return mustGet(ctx, k.pendingConsumerAdditionProps.Get, pendingPropKey(spawnTime, chainID))
+}
+
+// setPowerShapingParams sets the power shaping parameters of a consumer chain, i.e., its Top N,
+// validator set cap, validators power cap, allowlist and denylist, as given by its consumer addition proposal
+func (k Keeper) setPowerShapingParams(ctx sdk.Context, prop types.ConsumerAdditionProposal) {
+	k.SetTopN(ctx, prop.ChainId, prop.Top_N)
+	k.SetValidatorSetCap(ctx, prop.ChainId, prop.ValidatorSetCap)
+	k.SetValidatorsPowerCap(ctx, prop.ChainId, prop.ValidatorsPowerCap)
+
+	for _, address := range prop.Allowlist {
+		consAddr, err := sdk.ConsAddressFromBech32(address)
+		if err != nil {
+			continue
+		}
+
+		k.SetAllowlist(ctx, prop.ChainId, types.NewProviderConsAddress(consAddr))
 	}
 
-	return prop, true
+	for _, address := range prop.Denylist {
+		consAddr, err := sdk.ConsAddressFromBech32(address)
+		if err != nil {
+			continue
+		}
+
+		k.SetDenylist(ctx, prop.ChainId, types.NewProviderConsAddress(consAddr))
+	}
 }
 
 // BeginBlockInit iterates over the pending consumer addition proposals in order, and creates
@@ -515,32 +633,13 @@ func (k Keeper) BeginBlockInit(ctx sdk.Context) {
 		// create consumer client in a cached context to handle errors
 		cachedCtx, writeFn := ctx.CacheContext()
 
-		k.SetTopN(cachedCtx, prop.ChainId, prop.Top_N)
-		k.SetValidatorSetCap(cachedCtx, prop.ChainId, prop.ValidatorSetCap)
-		k.SetValidatorsPowerCap(cachedCtx, prop.ChainId, prop.ValidatorsPowerCap)
-
-		for _, address := range prop.Allowlist {
-			consAddr, err := sdk.ConsAddressFromBech32(address)
-			if err != nil {
-				continue
-			}
-
-			k.SetAllowlist(cachedCtx, prop.ChainId, types.NewProviderConsAddress(consAddr))
-		}
-
-		for _, address := range prop.Denylist {
-			consAddr, err := sdk.ConsAddressFromBech32(address)
-			if err != nil {
-				continue
-			}
-
-			k.SetDenylist(cachedCtx, prop.ChainId, types.NewProviderConsAddress(consAddr))
-		}
+		k.setPowerShapingParams(cachedCtx, prop)
 
 		err := k.CreateConsumerClient(cachedCtx, &propsToExecute[i])
 		if err != nil {
 			// drop the proposal
 			ctx.Logger().Info("consumer client could not be created: %w", err)
+			k.deleteDroppedConsumerInfo(ctx, prop.ChainId)
 			continue
 		}
 
@@ -548,15 +647,50 @@ func (k Keeper) BeginBlockInit(ctx sdk.Context) {
 		if !found {
 			// drop the proposal
 			ctx.Logger().Info("consumer genesis could not be created")
+			k.deleteDroppedConsumerInfo(ctx, prop.ChainId)
 			continue
 		}
 
+		if prop.LaunchThresholds != nil {
+			reason, err := k.checkLaunchThresholds(cachedCtx, prop)
+			if err != nil {
+				// drop the proposal
+				ctx.Logger().Info("launch thresholds could not be checked", "chainID", prop.ChainId, "error", err)
+				k.deleteDroppedConsumerInfo(ctx, prop.ChainId)
+				continue
+			}
+			if reason != "" {
+				// the cached writes are discarded and the proposal is executed again later, or dropped
+				k.postponeOrDropLaunch(ctx, prop, reason)
+				continue
+			}
+		}
+
 		if len(consumerGenesis.Provider.InitialValSet) == 0 {
 			// drop the proposal
 			ctx.Logger().Info("consumer genesis initial validator set is empty - no validators opted in")
+			k.deleteDroppedConsumerInfo(ctx, prop.ChainId)
 			continue
 		}
 
+		clientID, _ := k.GetConsumerClientId(cachedCtx, prop.ChainId)
+		// the provider hooks cannot veto a launch approved by governance
+		if k.hooks != nil {
+			k.callHookInCachedCtx(cachedCtx, "AfterConsumerLaunched", func(hookCtx sdk.Context) error {
+				return k.hooks.AfterConsumerLaunched(hookCtx, prop.ChainId, clientID)
+			})
+		}
+
+		k.emitTypedEvent(cachedCtx, &types.EventConsumerLaunched{
+			ChainId:           prop.ChainId,
+			ClientId:          clientID,
+			InitialValSetSize: uint64(len(consumerGenesis.Provider.InitialValSet)),
+			Relaunch:          prop.Relaunch,
+		})
+
+		// the readiness signals are only needed until the launch
+		k.DeleteAllReadinessSignals(cachedCtx, prop.ChainId)
+
 		// The cached context is created with a new EventManager so we merge the event
 		// into the original context
 		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
@@ -579,25 +713,18 @@ func (k Keeper) BeginBlockInit(ctx sdk.Context) {
 //
 // Note: this method is split out from BeginBlockInit to be easily unit tested.
 func (k Keeper) GetConsumerAdditionPropsToExecute(ctx sdk.Context) (propsToExecute []types.ConsumerAdditionProposal) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingCAPBytePrefix})
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var prop types.ConsumerAdditionProposal
-		err := prop.Unmarshal(iterator.Value())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// the ConsumerAdditionProp is assumed to be correctly serialized in SetPendingConsumerAdditionProp.
-			panic(fmt.Errorf("failed to unmarshal consumer addition proposal: %w", err))
-		}
-
-		if !ctx.BlockTime().Before(prop.SpawnTime) {
+	err := k.pendingConsumerAdditionProps.Walk(ctx, nil,
+		func(_ collections.Pair[uint64, string], prop types.ConsumerAdditionProposal) (bool, error) {
+			if ctx.BlockTime().Before(prop.SpawnTime) {
+				return true, nil
+			}
 			propsToExecute = append(propsToExecute, prop)
-		} else {
-			break
-		}
+			return false, nil
+		})
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerAdditionProp is assumed to be correctly serialized in SetPendingConsumerAdditionProp.
+		panic(fmt.Errorf("failed to unmarshal consumer addition proposal: %w", err))
 	}
 
 	return propsToExecute
@@ -610,21 +737,15 @@ func (k Keeper) GetConsumerAdditionPropsToExecute(ctx sdk.Context) (propsToExecu
 // Thus, the returned array is in spawnTime order. If two proposals have the same spawnTime,
 // then they are ordered by chainID.
 func (k Keeper) GetAllPendingConsumerAdditionProps(ctx sdk.Context) (props []types.ConsumerAdditionProposal) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingCAPBytePrefix})
-
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var prop types.ConsumerAdditionProposal
-		err := prop.Unmarshal(iterator.Value())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// the ConsumerAdditionProp is assumed to be correctly serialized in SetPendingConsumerAdditionProp.
-			panic(fmt.Errorf("failed to unmarshal consumer addition proposal: %w", err))
-		}
-
-		props = append(props, prop)
+	iterator, err := k.pendingConsumerAdditionProps.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer addition proposals: %w", err))
+	}
+	props, err = iterator.Values()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerAdditionProp is assumed to be correctly serialized in SetPendingConsumerAdditionProp.
+		panic(fmt.Errorf("failed to unmarshal consumer addition proposal: %w", err))
 	}
 
 	return props
@@ -632,10 +753,10 @@ func (k Keeper) GetAllPendingConsumerAdditionProps(ctx sdk.Context) (props []typ
 
 // DeletePendingConsumerAdditionProps deletes the given consumer addition proposals
 func (k Keeper) DeletePendingConsumerAdditionProps(ctx sdk.Context, proposals ...types.ConsumerAdditionProposal) {
-	store := ctx.KVStore(k.storeKey)
-
 	for _, p := range proposals {
-		store.Delete(types.PendingCAPKey(p.SpawnTime, p.ChainId))
+		if err := k.pendingConsumerAdditionProps.Remove(ctx, pendingPropKey(p.SpawnTime, p.ChainId)); err != nil {
+			panic(fmt.Errorf("failed to delete consumer addition proposal: %w", err))
+		}
 	}
 }
 
@@ -646,13 +767,10 @@ func (k Keeper) DeletePendingConsumerAdditionProps(ctx sdk.Context, proposals ..
 // Thus, if multiple removal addition proposal for the same chain will pass at
 // the same time, then only the last one will be stored.
 func (k Keeper) SetPendingConsumerRemovalProp(ctx sdk.Context, prop *types.ConsumerRemovalProposal) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := prop.Marshal()
-	if err != nil {
+	if err := k.pendingConsumerRemovalProps.Set(ctx, pendingPropKey(prop.StopTime, prop.ChainId), *prop); err != nil {
 		// An error here would indicate something is very wrong
 		panic(fmt.Errorf("failed to marshal consumer removal proposal: %w", err))
 	}
-	store.Set(types.PendingCRPKey(prop.StopTime, prop.ChainId), bz)
 }
 
 // PendingConsumerRemovalPropExists checks whether a pending consumer removal proposal
@@ -660,19 +778,20 @@ func (k Keeper) SetPendingConsumerRemovalProp(ctx sdk.Context, prop *types.Consu
 //
 // Note: this method is only used in testing
 func (k Keeper) PendingConsumerRemovalPropExists(ctx sdk.Context, chainID string, timestamp time.Time) bool {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.PendingCRPKey(timestamp, chainID))
-
-	return bz != nil
+	found, err := k.pendingConsumerRemovalProps.Has(ctx, pendingPropKey(timestamp, chainID))
+	if err != nil {
+		panic(fmt.Errorf("failed to check consumer removal proposal: %w", err))
+	}
+	return found
 }
 
 // DeletePendingConsumerRemovalProps deletes the given pending consumer removal proposals.
 // This method should be called once the proposal has been acted upon.
 func (k Keeper) DeletePendingConsumerRemovalProps(ctx sdk.Context, proposals ...types.ConsumerRemovalProposal) {
-	store := ctx.KVStore(k.storeKey)
-
 	for _, p := range proposals {
-		store.Delete(types.PendingCRPKey(p.StopTime, p.ChainId))
+		if err := k.pendingConsumerRemovalProps.Remove(ctx, pendingPropKey(p.StopTime, p.ChainId)); err != nil {
+			panic(fmt.Errorf("failed to delete consumer removal proposal: %w", err))
+		}
 	}
 }
 
@@ -719,26 +838,20 @@ func (k Keeper) GetConsumerRemovalPropsToExecute(ctx sdk.Context) []types.Consum
 	// store the (to be) executed consumer removal proposals in order
 	propsToExecute := []types.ConsumerRemovalProposal{}
 
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingCRPBytePrefix})
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var prop types.ConsumerRemovalProposal
-		err := prop.Unmarshal(iterator.Value())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// the ConsumerRemovalProposal is assumed to be correctly serialized in SetPendingConsumerRemovalProp.
-			panic(fmt.Errorf("failed to unmarshal consumer removal proposal: %w", err))
-		}
-
-		// If current block time is equal to or after stop time, proposal is ready to be executed
-		if !ctx.BlockTime().Before(prop.StopTime) {
+	err := k.pendingConsumerRemovalProps.Walk(ctx, nil,
+		func(_ collections.Pair[uint64, string], prop types.ConsumerRemovalProposal) (bool, error) {
+			// If current block time is equal to or after stop time, proposal is ready to be executed
+			if ctx.BlockTime().Before(prop.StopTime) {
+				// No more proposals to check, since they're stored/ordered by timestamp.
+				return true, nil
+			}
 			propsToExecute = append(propsToExecute, prop)
-		} else {
-			// No more proposals to check, since they're stored/ordered by timestamp.
-			break
-		}
+			return false, nil
+		})
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerRemovalProposal is assumed to be correctly serialized in SetPendingConsumerRemovalProp.
+		panic(fmt.Errorf("failed to unmarshal consumer removal proposal: %w", err))
 	}
 
 	return propsToExecute
@@ -750,20 +863,15 @@ func (k Keeper) GetConsumerRemovalPropsToExecute(ctx sdk.Context) []types.Consum
 // PendingCRPBytePrefix | stopTime.UnixNano() | chainID
 // Thus, the returned array is in stopTime order.
 func (k Keeper) GetAllPendingConsumerRemovalProps(ctx sdk.Context) (props []types.ConsumerRemovalProposal) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PendingCRPBytePrefix})
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var prop types.ConsumerRemovalProposal
-		err := prop.Unmarshal(iterator.Value())
-		if err != nil {
-			// An error here would indicate something is very wrong,
-			// the ConsumerRemovalProposal is assumed to be correctly serialized in SetPendingConsumerRemovalProp.
-			panic(fmt.Errorf("failed to unmarshal consumer removal proposal: %w", err))
-		}
-
-		props = append(props, prop)
+	iterator, err := k.pendingConsumerRemovalProps.Iterate(ctx, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer removal proposals: %w", err))
+	}
+	props, err = iterator.Values()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the ConsumerRemovalProposal is assumed to be correctly serialized in SetPendingConsumerRemovalProp.
+		panic(fmt.Errorf("failed to unmarshal consumer removal proposal: %w", err))
 	}
 
 	return props
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/math"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	_go "github.com/cosmos/ics23/go"
 	"github.com/golang/mock/gomock"
@@ -19,6 +20,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
 	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
@@ -49,11 +51,20 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 	// Snapshot times asserted in tests
 	now := time.Now().UTC()
 
+	withMetadata := func(content govv1beta1.Content) *providertypes.ConsumerAdditionProposal {
+		prop := content.(*providertypes.ConsumerAdditionProposal)
+		prop.Metadata = &providertypes.ConsumerMetadata{
+			Name:    "Chain",
+			Website: "https://chain.zone",
+		}
+		return prop
+	}
+
 	tests := []testCase{
 		{
 			description: "expect to append valid proposal",
 			malleate:    func(ctx sdk.Context, k providerkeeper.Keeper, chainID string) {},
-			prop: providertypes.NewConsumerAdditionProposal(
+			prop: withMetadata(providertypes.NewConsumerAdditionProposal(
 				"title",
 				"description",
 				"chainID",
@@ -70,7 +81,7 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 				100000000000,
 				0,  // Opt-in chain - doesn't require validators at startup
 				"", // ConnectionId
-			).(*providertypes.ConsumerAdditionProposal),
+			)),
 			blockTime:     now,
 			expAppendProp: true,
 		},
@@ -128,11 +139,17 @@ func TestHandleConsumerAdditionProposal(t *testing.T) {
 			gotProposal, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, tc.prop.SpawnTime, tc.prop.ChainId)
 			require.True(t, found)
 			require.Equal(t, *tc.prop, gotProposal)
+			// check that the metadata was added to the consumer metadata registry
+			metadata, found := providerKeeper.GetConsumerMetadata(ctx, tc.prop.ChainId)
+			require.True(t, found)
+			require.Equal(t, *tc.prop.Metadata, metadata)
 		} else {
 			require.Error(t, err)
 			// check that prop wasn't added to the stored pending props
 			_, found := providerKeeper.GetPendingConsumerAdditionProp(ctx, tc.prop.SpawnTime, tc.prop.ChainId)
 			require.False(t, found)
+			_, found = providerKeeper.GetConsumerMetadata(ctx, tc.prop.ChainId)
+			require.False(t, found)
 		}
 
 		ctrl.Finish()
@@ -1074,6 +1091,10 @@ func TestBeginBlockInit(t *testing.T) {
 	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
 	providerKeeper.SetOptedIn(ctx, pendingProps[4].ChainId, providertypes.NewProviderConsAddress(consAddr))
 
+	// the metadata set when the proposals were handled
+	providerKeeper.SetConsumerMetadata(ctx, pendingProps[4].ChainId, providertypes.ConsumerMetadata{Name: "chain5"})
+	providerKeeper.SetConsumerMetadata(ctx, pendingProps[5].ChainId, providertypes.ConsumerMetadata{Name: "chain6"})
+
 	providerKeeper.BeginBlockInit(ctx)
 
 	// first proposal is not pending anymore because its spawn time already passed and was executed
@@ -1115,6 +1136,9 @@ func TestBeginBlockInit(t *testing.T) {
 	// fifth proposal was successfully executed and hence consumer genesis was created
 	_, found = providerKeeper.GetConsumerGenesis(ctx, pendingProps[4].ChainId)
 	require.True(t, found)
+	// the metadata of a launched chain is kept
+	_, found = providerKeeper.GetConsumerMetadata(ctx, pendingProps[4].ChainId)
+	require.True(t, found)
 
 	// sixth proposal corresponds to an Opt-In chain with no opted-in validators and hence the
 	// proposal is not successful
@@ -1135,6 +1159,9 @@ func TestBeginBlockInit(t *testing.T) {
 	require.False(t, found)
 	_, found = providerKeeper.GetValidatorSetCap(ctx, pendingProps[5].ChainId)
 	require.False(t, found)
+	// the metadata of the dropped proposal is deleted
+	_, found = providerKeeper.GetConsumerMetadata(ctx, pendingProps[5].ChainId)
+	require.False(t, found)
 
 	// test that Top N is set correctly
 	require.True(t, providerKeeper.IsTopN(ctx, "chain1"))
@@ -1182,8 +1209,14 @@ func TestBeginBlockCCR(t *testing.T) {
 		expectations = append(expectations, testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, prop.ChainId)...)
 	}
 	// Only first two consumer chains should be stopped
-	expectations = append(expectations, testkeeper.GetMocksForStopConsumerChainWithCloseChannel(ctx, &mocks)...)
-	expectations = append(expectations, testkeeper.GetMocksForStopConsumerChainWithCloseChannel(ctx, &mocks)...)
+	for _, prop := range pendingProps[:2] {
+		expectations = append(expectations,
+			mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channel-"+prop.ChainId).Return(
+				channeltypes.Channel{State: channeltypes.OPEN}, true,
+			).Times(1),
+			mocks.MockChannelKeeper.EXPECT().ChanCloseInit(gomock.Any(), ccvtypes.ProviderPortID, "channel-"+prop.ChainId).Times(1),
+		)
+	}
 
 	gomock.InOrder(expectations...)
 
@@ -1198,7 +1231,8 @@ func TestBeginBlockCCR(t *testing.T) {
 
 		err := providerKeeper.CreateConsumerClient(ctx, additionProp)
 		require.NoError(t, err)
-		err = providerKeeper.SetConsumerChain(ctx, "channelID")
+		// every consumer chain has its own CCV channel
+		err = providerKeeper.SetConsumerChain(ctx, "channel-"+prop.ChainId)
 		require.NoError(t, err)
 
 		// Set removal props for all consumer chains
@@ -1451,4 +1485,116 @@ func TestHandleConsumerModificationProposal_Flow(t *testing.T) {
 		}
 		require.True(t, found, "expected consumer_chain_renamed event")
 	})
+
+	t.Run("Event: emits typed EventConsumerRenamed", func(t *testing.T) {
+		pk, ctx, ctrl := newKeeper(t)
+		defer ctrl.Finish()
+
+		oldID, newID := "pre-e2", "pre-e2-new"
+		// prelaunch
+
+		err := pk.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+			Title:      "rename",
+			ChainId:    oldID,
+			NewChainId: newID,
+		})
+		require.NoError(t, err)
+
+		var renamed []*providertypes.EventConsumerRenamed
+		for _, ev := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+			if err != nil {
+				continue
+			}
+			if e, ok := msg.(*providertypes.EventConsumerRenamed); ok {
+				renamed = append(renamed, e)
+			}
+		}
+		require.Equal(t, []*providertypes.EventConsumerRenamed{{OldChainId: oldID, NewChainId: newID}}, renamed)
+	})
+
+	t.Run("Metadata: updates the metadata of a running chain", func(t *testing.T) {
+		pk, ctx, ctrl := newKeeper(t)
+		defer ctrl.Finish()
+
+		cid := "running-metadata"
+		pk.SetConsumerClientId(ctx, cid, "07-tendermint-43")
+		pk.SetConsumerMetadata(ctx, cid, providertypes.ConsumerMetadata{Name: "old"})
+
+		metadata := providertypes.ConsumerMetadata{Name: "new", GenesisUrl: "https://chain.zone/genesis.json"}
+		err := pk.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+			Title:    "update metadata",
+			ChainId:  cid,
+			Metadata: &metadata,
+		})
+		require.NoError(t, err)
+
+		got, found := pk.GetConsumerMetadata(ctx, cid)
+		require.True(t, found)
+		require.Equal(t, metadata, got)
+	})
+
+	t.Run("Metadata: is moved by a prelaunch rename", func(t *testing.T) {
+		pk, ctx, ctrl := newKeeper(t)
+		defer ctrl.Finish()
+
+		oldID, newID := "pre-m1", "pre-m1-new"
+		pk.SetConsumerMetadata(ctx, oldID, providertypes.ConsumerMetadata{Name: "chain"})
+
+		err := pk.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+			Title:      "rename",
+			ChainId:    oldID,
+			NewChainId: newID,
+		})
+		require.NoError(t, err)
+
+		_, found := pk.GetConsumerMetadata(ctx, oldID)
+		require.False(t, found)
+		got, found := pk.GetConsumerMetadata(ctx, newID)
+		require.True(t, found)
+		require.Equal(t, providertypes.ConsumerMetadata{Name: "chain"}, got)
+	})
+
+	t.Run("Metadata: fails for an unknown chain", func(t *testing.T) {
+		pk, ctx, ctrl := newKeeper(t)
+		defer ctrl.Finish()
+
+		err := pk.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+			Title:    "update metadata",
+			ChainId:  "unknown-1",
+			Metadata: &providertypes.ConsumerMetadata{Name: "chain"},
+		})
+		require.ErrorIs(t, err, providertypes.ErrInvalidConsumerModificationProposal)
+
+		_, found := pk.GetConsumerMetadata(ctx, "unknown-1")
+		require.False(t, found)
+	})
+
+	t.Run("Owner: governance sets the owner of a pending chain", func(t *testing.T) {
+		pk, ctx, ctrl := newKeeper(t)
+		defer ctrl.Finish()
+
+		prop := testkeeper.GetTestConsumerAdditionProp()
+		prop.ChainId = "pre-o1"
+		pk.SetPendingConsumerAdditionProp(ctx, prop)
+
+		owner := sdk.AccAddress([]byte("owner"))
+		err := pk.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+			Title:   "set owner",
+			ChainId: prop.ChainId,
+			Owner:   owner.String(),
+		})
+		require.NoError(t, err)
+		got, found := pk.GetConsumerOwner(ctx, prop.ChainId)
+		require.True(t, found)
+		require.Equal(t, owner, got)
+
+		// an unknown chain cannot get an owner
+		err = pk.HandleConsumerModificationProposal(ctx, &providertypes.MsgConsumerModification{
+			Title:   "set owner",
+			ChainId: "unknown-1",
+			Owner:   owner.String(),
+		})
+		require.ErrorIs(t, err, providertypes.ErrInvalidConsumerModificationProposal)
+	})
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strconv"
@@ -13,6 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
 	ccv "github.com/allinbits/interchain-security/x/ccv/types"
 )
@@ -56,17 +59,28 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) err
 // EndBlockVSU contains the EndBlock logic needed for
 // the Validator Set Update sub-protocol
 func (k Keeper) EndBlockVSU(ctx sdk.Context) {
-	if ctx.BlockHeight()%k.GetBlocksPerEpoch(ctx) == 0 {
-		// only queue and send VSCPackets at the boundaries of an epoch
+	// only queue VSCPackets to a consumer chain at the boundaries of its epochs;
+	// the valset update id is also incremented at the boundaries of the provider epochs
+	chainIDs := k.GetConsumerChainsAtEpochEnd(ctx)
+	if len(chainIDs) != 0 || k.isProviderEpochEnd(ctx) {
+		// assign the consumer keys of the rotations scheduled for this epoch,
+		// so that they are part of the validator updates of the epoch
+		k.ApplyConsumerKeyRotations(ctx, chainIDs)
 
 		// collect validator updates
-		k.QueueVSCPackets(ctx)
+		k.queueVSCPackets(ctx, chainIDs)
 
 		// try sending VSC packets to all registered consumer chains;
 		// if the CCV channel is not established for a consumer chain,
 		// the updates will remain queued until the channel is established
 		k.SendVSCPackets(ctx)
 	}
+
+	// the consumer chains resumed in this block do not wait for the end of their epoch
+	k.SendCatchUpVSCPackets(ctx)
+
+	// start the next epoch once the current one ended, if the epochs are based on block time
+	k.updateEpochState(ctx)
 }
 
 // SendVSCPackets iterates over all registered consumers and sends pending
@@ -75,10 +89,11 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) {
 // the updates will remain queued until the channel is established
 func (k Keeper) SendVSCPackets(ctx sdk.Context) {
 	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
-		// check if CCV channel is established and send
-		if channelID, found := k.GetChainToChannel(ctx, chainID); found {
+		// check if CCV channel is established and send, unless the chain is paused
+		if channelID, found := k.GetChainToChannel(ctx, chainID); found && !k.IsConsumerPaused(ctx, chainID) {
 			k.SendVSCPacketsToChain(ctx, chainID, channelID)
 		}
+		k.setPendingVSCPacketsGauge(ctx, chainID)
 	}
 }
 
@@ -113,42 +128,197 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, chainID, channelID string
 			}
 			return
 		}
+		incrConsumerCounter(providertypes.MetricKeyVSCPacketsSent, chainID, 1)
+		k.emitTypedEvent(ctx, &providertypes.EventVSCPacketSent{
+			ChainId:   chainID,
+			ChannelId: channelID,
+			VscId:     data.ValsetUpdateId,
+		})
 	}
 	k.DeletePendingVSCPackets(ctx, chainID)
 }
 
 // QueueVSCPackets queues latest validator updates for every registered consumer chain
 // failing to GetLastBondedValidators will cause a panic in EndBlock
+//
+// Consumer validator sets that are tracked incrementally are updated by only recomputing the
+// validators marked as dirty since the last epoch. All other consumer validator sets are computed
+// from scratch using the bonded validators, which are only loaded if needed.
 
 // TODO: decide if this func shouldn't return an error to be propagated to BeginBlocker
 func (k Keeper) QueueVSCPackets(ctx sdk.Context) {
+	k.queueVSCPackets(ctx, k.GetAllRegisteredConsumerChainIDs(ctx))
+}
+
+// queueVSCPackets queues the latest validator updates for the given registered consumer chains.
+// The updates of the dirty validators are held back for the other consumer chains, so that they
+// are sent at the end of the epochs of these chains.
+func (k Keeper) queueVSCPackets(ctx sdk.Context, chainIDs []string) {
 	valUpdateID := k.GetValidatorSetUpdateId(ctx) // current valset update ID
 
-	// get the bonded validators from the staking module
-	bondedValidators, err := k.GetLastBondedValidators(ctx)
-	if err != nil {
-		panic(fmt.Errorf("failed to get last validators: %w", err))
+	maxPacketSize := k.GetMaxVSCPacketSize(ctx)
+	dirtyValidators := k.GetAllDirtyValidators(ctx)
+	getBondedValidators := k.bondedValidatorsLoader(ctx)
+
+	queued := make(map[string]bool, len(chainIDs))
+	for _, chainID := range chainIDs {
+		queued[chainID] = true
 	}
 	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+		if !queued[chainID] || k.IsConsumerPaused(ctx, chainID) {
+			// the consumer validator set is not updated before the end of the epoch of
+			// the chain, nor while the chain is paused
+			k.holdBackValidatorUpdates(ctx, chainID, dirtyValidators)
+			continue
+		}
+		k.queueVSCPacketsToChain(ctx, chainID, valUpdateID, maxPacketSize, dirtyValidators, getBondedValidators)
+		// a consumer chain resumed in this block got its updates with the other chains
+		k.DeleteConsumerToCatchUp(ctx, chainID)
+	}
+	k.DeleteAllDirtyValidators(ctx)
+
+	k.setValsetUpdateBlockHeightAndIncrement(ctx, valUpdateID)
+}
+
+// SendCatchUpVSCPackets queues and sends a catch-up VSC packet to every consumer chain resumed
+// in this block, with the validator updates that were held back while the chain was paused.
+// The catch-up VSC packets get a valset update id of their own.
+func (k Keeper) SendCatchUpVSCPackets(ctx sdk.Context) {
+	chainIDs := k.GetAllConsumersToCatchUp(ctx)
+	if len(chainIDs) == 0 {
+		return
+	}
+
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	maxPacketSize := k.GetMaxVSCPacketSize(ctx)
+	// the dirty validators are kept, as the other consumer chains are updated at the end of the epoch
+	dirtyValidators := k.GetAllDirtyValidators(ctx)
+	getBondedValidators := k.bondedValidatorsLoader(ctx)
+
+	for _, chainID := range chainIDs {
+		// the chain might have been paused again in this block
+		if !k.IsConsumerPaused(ctx, chainID) {
+			k.queueVSCPacketsToChain(ctx, chainID, valUpdateID, maxPacketSize, dirtyValidators, getBondedValidators)
+			if channelID, found := k.GetChainToChannel(ctx, chainID); found {
+				k.SendVSCPacketsToChain(ctx, chainID, channelID)
+			}
+			k.setPendingVSCPacketsGauge(ctx, chainID)
+		}
+		k.DeleteConsumerToCatchUp(ctx, chainID)
+	}
+
+	k.setValsetUpdateBlockHeightAndIncrement(ctx, valUpdateID)
+}
+
+// setValsetUpdateBlockHeightAndIncrement maps the given valset update id, which was handed out to
+// VSC packets in this block, to the next block height, as EndBlockCIS does for the current valset
+// update id, and increments the valset update id. This way, the slash packets referring to the
+// catch-up VSC packets, which get a valset update id of their own, can be mapped to a height.
+func (k Keeper) setValsetUpdateBlockHeightAndIncrement(ctx sdk.Context, valUpdateID uint64) {
+	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, uint64(ctx.BlockHeight())+1)
+	k.IncrementValidatorSetUpdateId(ctx)
+}
+
+// queueVSCPacketsToChain queues the latest validator updates for consumer chain `chainID`
+// in VSC packets with valset update id `valUpdateID`
+func (k Keeper) queueVSCPacketsToChain(
+	ctx sdk.Context,
+	chainID string,
+	valUpdateID uint64,
+	maxPacketSize int64,
+	dirtyValidators []providertypes.ProviderConsAddress,
+	getBondedValidators func() []stakingtypes.Validator,
+) {
+	var valUpdates []abci.ValidatorUpdate
+	if k.CanUpdateConsumerValSetIncrementally(ctx, chainID) {
+		chainDirtyValidators := mergeProviderConsAddresses(dirtyValidators, k.GetAllDirtyConsumerValidators(ctx, chainID))
+		valUpdates = k.UpdateConsumerValSetIncrementally(ctx, chainID, chainDirtyValidators)
+	} else {
 		currentValidators := k.GetConsumerValSet(ctx, chainID)
 
 		// For Replicated Security, all bonded validators participate
 		// No TopN or opt-in logic needed
-		nextValidators := k.ComputeNextValidators(ctx, chainID, bondedValidators)
+		nextValidators := k.ComputeNextValidators(ctx, chainID, getBondedValidators())
 
-		valUpdates := DiffValidators(currentValidators, nextValidators)
+		valUpdates = DiffValidators(currentValidators, nextValidators)
 		k.SetConsumerValSet(ctx, chainID, nextValidators)
 
-		// check whether there are changes in the validator set;
-		if len(valUpdates) != 0 {
-			// construct validator set change packet data
-			packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, chainID))
-			k.AppendPendingVSCPackets(ctx, chainID, packet)
-			k.Logger(ctx).Info("VSCPacket enqueued:", "chainID", chainID, "vscID", valUpdateID, "len updates", len(valUpdates))
+		// from now on, only the dirty validators need to be recomputed
+		k.SetConsumerValSetTracked(ctx, chainID)
+	}
+	k.DeleteAllDirtyConsumerValidators(ctx, chainID)
+
+	// check whether there are changes in the validator set;
+	if len(valUpdates) != 0 {
+		// construct validator set change packet data, split into several packets
+		// with the same vscID if it exceeds the max packet size
+		packets := ccv.SplitValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, chainID), maxPacketSize)
+		k.AppendPendingVSCPackets(ctx, chainID, packets...)
+		k.Logger(ctx).Info("VSCPacket enqueued:", "chainID", chainID, "vscID", valUpdateID, "len updates", len(valUpdates), "parts", len(packets))
+		incrConsumerCounter(providertypes.MetricKeyVSCPacketsQueued, chainID, float32(len(packets)))
+		k.emitTypedEvent(ctx, &providertypes.EventVSCPacketQueued{
+			ChainId:    chainID,
+			VscId:      valUpdateID,
+			NumUpdates: uint64(len(valUpdates)),
+			NumParts:   uint64(len(packets)),
+		})
+
+		if k.hooks != nil {
+			k.callHookInCachedCtx(ctx, "AfterConsumerValidatorSetChanged", func(cachedCtx sdk.Context) error {
+				return k.hooks.AfterConsumerValidatorSetChanged(cachedCtx, chainID, valUpdateID, valUpdates)
+			})
 		}
 	}
+}
 
-	k.IncrementValidatorSetUpdateId(ctx)
+// bondedValidatorsLoader returns a function that gets the bonded validators from the staking
+// module on its first call, so that they are only loaded if needed
+func (k Keeper) bondedValidatorsLoader(ctx sdk.Context) func() []stakingtypes.Validator {
+	var bondedValidators []stakingtypes.Validator
+	loaded := false
+	return func() []stakingtypes.Validator {
+		if !loaded {
+			var err error
+			bondedValidators, err = k.GetLastBondedValidators(ctx)
+			if err != nil {
+				panic(fmt.Errorf("failed to get last validators: %w", err))
+			}
+			loaded = true
+		}
+		return bondedValidators
+	}
+}
+
+// mergeProviderConsAddresses merges two lists of provider consensus addresses that are
+// ordered by address into a single ordered list without duplicates
+func mergeProviderConsAddresses(a, b []providertypes.ProviderConsAddress) []providertypes.ProviderConsAddress {
+	merged := make([]providertypes.ProviderConsAddress, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var cmp int
+		switch {
+		case i == len(a):
+			cmp = 1
+		case j == len(b):
+			cmp = -1
+		default:
+			cmp = bytes.Compare(a[i].ToSdkConsAddr(), b[j].ToSdkConsAddr())
+		}
+
+		switch {
+		case cmp < 0:
+			merged = append(merged, a[i])
+			i++
+		case cmp > 0:
+			merged = append(merged, b[j])
+			j++
+		default:
+			merged = append(merged, a[i])
+			i++
+			j++
+		}
+	}
+	return merged
 }
 
 // BeginBlockCIS contains the BeginBlock logic needed for the Consumer Initiated Slashing sub-protocol.
@@ -175,8 +345,14 @@ func (k Keeper) EndBlockCIS(ctx sdk.Context) {
 	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
 	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
 
-	// prune previous consumer validator addresses that are no longer needed
-	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+	// prune previous consumer validator addresses that are no longer needed;
+	// only the consumer chains with addresses that are due are visited
+	for _, chainID := range k.GetConsumerChainsWithAddrsToPrune(ctx, ctx.BlockTime()) {
+		// the addresses of a paused consumer chain are pruned once it is resumed,
+		// as their pruning is then delayed by the duration of the pause
+		if k.IsConsumerPaused(ctx, chainID) {
+			continue
+		}
 		k.PruneKeyAssignments(ctx, chainID)
 	}
 }
@@ -199,6 +375,17 @@ func (k Keeper) OnRecvSlashPacket(
 		panic(fmt.Errorf("SlashPacket received on unknown channel %s", packet.DestinationChannel))
 	}
 
+	if k.IsConsumerPaused(ctx, chainID) {
+		// the slash packets of a paused chain are ignored; return a successful ack,
+		// as an error would result in the consumer closing the CCV channel
+		k.Logger(ctx).Info("SlashPacket received from paused consumer chain, ignoring it",
+			"chainID", chainID,
+			"vscID", data.ValsetUpdateId,
+		)
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
+		return ccv.SlashPacketHandledResult, nil
+	}
+
 	// validate packet data upon receiving
 	if err := data.Validate(); err != nil {
 		return nil, errorsmod.Wrapf(err, "error validating SlashPacket data")
@@ -245,6 +432,7 @@ func (k Keeper) OnRecvSlashPacket(
 		// drop packet but return a slash ack so that the consumer can send another slash packet
 		k.AppendSlashAck(ctx, chainID, consumerConsAddr.String())
 
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
 		return ccv.SlashPacketHandledResult, nil
 	}
 
@@ -258,6 +446,14 @@ func (k Keeper) OnRecvSlashPacket(
 			"vscID", data.ValsetUpdateId,
 			"infractionType", data.Infraction,
 		)
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsBounced, chainID, 1)
+		k.emitTypedEvent(ctx, &providertypes.EventSlashPacketBounced{
+			ChainId:          chainID,
+			ConsumerConsAddr: consumerConsAddr.String(),
+			ProviderConsAddr: providerConsAddr.String(),
+			VscId:            data.ValsetUpdateId,
+			Infraction:       data.Infraction,
+		})
 		return ccv.SlashPacketBouncedResult, nil
 	}
 
@@ -315,6 +511,7 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr())
 	if err != nil {
 		k.Logger(ctx).Error("validator not found", "validator", providerConsAddr.String(), "error", err)
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
 		return
 	}
 
@@ -325,6 +522,7 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 		k.Logger(ctx).Info(
 			"HandleSlashPacket - slash packet dropped because validator not found or is unbonded",
 			"provider cons addr", providerConsAddr.String())
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
 		return
 	}
 
@@ -335,6 +533,7 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 			"HandleSlashPacket - slash packet dropped because validator is already tombstoned",
 			"provider cons addr", providerConsAddr.String(),
 		)
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
 		return
 	}
 
@@ -344,6 +543,7 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 			"HandleSlashPacket - infraction height not found. But was found during slash packet validation",
 			"vscID", data.ValsetUpdateId)
 		// drop packet
+		incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
 		return
 	}
 
@@ -355,10 +555,12 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 	k.AppendSlashAck(ctx, chainID, consumerConsAddr.String())
 
 	// jail validator
-	if !validator.IsJailed() {
+	shouldJail := !validator.IsJailed()
+	if shouldJail {
 		err := k.stakingKeeper.Jail(ctx, providerConsAddr.ToSdkConsAddr())
 		if err != nil {
 			k.Logger(ctx).Error("failed to jail vaidator", providerConsAddr.ToSdkConsAddr().String(), "err", err.Error())
+			incrConsumerCounter(providertypes.MetricKeySlashPacketsDropped, chainID, 1)
 			return
 		}
 		k.Logger(ctx).Info("HandleSlashPacket - validator jailed", "provider cons addr", providerConsAddr.String())
@@ -385,6 +587,15 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, chainID string, data ccv.Slas
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
 		),
 	)
+	incrConsumerCounter(providertypes.MetricKeySlashPacketsHandled, chainID, 1)
+	k.emitTypedEvent(ctx, &providertypes.EventSlashPacketHandled{
+		ChainId:          chainID,
+		ConsumerConsAddr: consumerConsAddr.String(),
+		ProviderConsAddr: providerConsAddr.String(),
+		VscId:            data.ValsetUpdateId,
+		Infraction:       data.Infraction,
+		Jailed:           shouldJail,
+	})
 }
 
 // getMappedInfractionHeight gets the infraction height mapped from val set ID for the given chain ID
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
@@ -67,7 +69,8 @@ func TestQueueVSCPackets(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 		mocks := testkeeper.NewMockedKeepers(ctrl)
-		testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 0, []stakingtypes.Validator{}, []int64{}, 1)
+		// the bonded validators are not loaded as there are no registered consumer chains
+		testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 0, []stakingtypes.Validator{}, []int64{}, 0)
 
 		pk := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
 		// no-op if tc.packets is empty
@@ -149,8 +152,8 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	// Set channel to chain (faking multiple established channels)
-	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
-	providerKeeper.SetChannelToChain(ctx, "channel-2", "chain-2")
+	providerKeeper.SetChainToChannel(ctx, "chain-1", "channel-1")
+	providerKeeper.SetChainToChannel(ctx, "chain-2", "channel-2")
 
 	// Generate a new slash packet data instance with double sign infraction type
 	packetData := testkeeper.GetNewSlashPacketData()
@@ -222,8 +225,8 @@ func TestOnRecvDoubleSignSlashPacket(t *testing.T) {
 	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	// Set channel to chain (faking multiple established channels)
-	providerKeeper.SetChannelToChain(ctx, "channel-1", "chain-1")
-	providerKeeper.SetChannelToChain(ctx, "channel-2", "chain-2")
+	providerKeeper.SetChainToChannel(ctx, "chain-1", "channel-1")
+	providerKeeper.SetChainToChannel(ctx, "chain-2", "channel-2")
 
 	// Generate a new slash packet data instance with double sign infraction type
 	packetData := testkeeper.GetNewSlashPacketData()
@@ -304,7 +307,7 @@ func TestValidateSlashPacket(t *testing.T) {
 		packet := channeltypes.Packet{DestinationChannel: "channel-9"}
 
 		// Pseudo setup ccv channel for channel ID specified in packet.
-		providerKeeper.SetChannelToChain(ctx, "channel-9", "consumer-chain-id")
+		providerKeeper.SetChainToChannel(ctx, "consumer-chain-id", "channel-9")
 
 		// Setup init chain height for consumer (allowing 0 vscID to be valid).
 		providerKeeper.SetInitChainHeight(ctx, "consumer-chain-id", uint64(89))
@@ -524,7 +527,7 @@ func TestOnTimeoutPacketWithNoChainFound(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
 
-	// We do not `SetChannelToChain` for "channelID" and therefore `OnTimeoutPacket` fails
+	// We do not `SetChainToChannel` for "channelID" and therefore `OnTimeoutPacket` fails
 	packet := channeltypes.Packet{
 		SourceChannel: "channelID",
 	}
@@ -648,6 +651,202 @@ func TestEndBlockVSU(t *testing.T) {
 	require.Equal(t, 1, len(providerKeeper.GetPendingVSCPackets(ctx, chainID)))
 }
 
+// TestQueueVSCPacketsSplitsLargePackets tests that validator set changes exceeding
+// the max VSC packet size are queued as several packets with the same vscID
+func TestQueueVSCPacketsSplitsLargePackets(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	providerKeeper.SetTopN(ctx, chainID, 100)
+
+	// at most 3 validator updates and slash acks per packet
+	params := providertypes.DefaultParams()
+	params.MaxVscPacketSize = 3
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.SetValidatorSetUpdateId(ctx, 7)
+
+	// create 4 sample lastValidators
+	var lastValidators []stakingtypes.Validator
+	var powers []int64
+	for i := 0; i < 4; i++ {
+		validator := crypto.NewCryptoIdentityFromIntSeed(i).SDKStakingValidator()
+		lastValidators = append(lastValidators, validator)
+		valAdrr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		require.NoError(t, err)
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAdrr).Return(int64(i+1), nil).AnyTimes()
+		powers = append(powers, int64(i+1))
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 5, lastValidators, powers, -1)
+
+	minPower, err := providerKeeper.ComputeMinPowerInTopN(ctx, lastValidators, 100)
+	require.NoError(t, err)
+	providerKeeper.OptInTopNValidators(ctx, chainID, lastValidators, minPower)
+	providerKeeper.SetMinimumPowerInTopN(ctx, chainID, minPower)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.AppendSlashAck(ctx, chainID, "ack")
+
+	providerKeeper.QueueVSCPackets(ctx)
+
+	// 4 validator updates and 1 slash ack are split into 2 packets
+	pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+	require.Len(t, pending, 2)
+	require.Len(t, pending[0].ValidatorUpdates, 3)
+	require.Empty(t, pending[0].SlashAcks)
+	require.Len(t, pending[1].ValidatorUpdates, 1)
+	require.Equal(t, []string{"ack"}, pending[1].SlashAcks)
+	for i, packet := range pending {
+		require.Equal(t, uint64(7), packet.ValsetUpdateId)
+		require.Equal(t, uint32(i), packet.PartIndex)
+		require.Equal(t, uint32(2), packet.TotalParts)
+	}
+	require.True(t, pending[1].IsLastPart())
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, chainID))
+}
+
+// TestQueueVSCPacketsIncrementally tests that consumer validator sets are computed from scratch only
+// once and are afterwards updated by only recomputing the dirty validators
+func TestQueueVSCPacketsIncrementally(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	state := newStakingState(mocks, 3)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	for i := range state.validators {
+		providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+	}
+
+	// the consumer validator set is computed from scratch
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Equal(t, 1, state.iterations)
+	require.True(t, providerKeeper.IsConsumerValSetTracked(ctx, chainID))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1)
+	require.Len(t, providerKeeper.GetConsumerValSet(ctx, chainID), 3)
+
+	// without dirty validators, no packet is queued and the bonded validators are not loaded
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Equal(t, 1, state.iterations)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, chainID), 1)
+
+	// a delegation changes the power of a validator
+	state.powers[state.validators[1].GetOperator()] = 50
+	valAddr, err := sdk.ValAddressFromBech32(state.validators[1].GetOperator())
+	require.NoError(t, err)
+	require.NoError(t, providerKeeper.Hooks().AfterDelegationModified(ctx, sdk.AccAddress{}, valAddr))
+	// a validator opts out
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, chainID, state.providerAddr(2)))
+
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Equal(t, 1, state.iterations)
+	pending := providerKeeper.GetPendingVSCPackets(ctx, chainID)
+	require.Len(t, pending, 2)
+	require.Len(t, pending[1].ValidatorUpdates, 2)
+	require.Len(t, providerKeeper.GetConsumerValSet(ctx, chainID), 2)
+	require.Empty(t, providerKeeper.GetAllDirtyValidators(ctx))
+	require.Empty(t, providerKeeper.GetAllDirtyConsumerValidators(ctx, chainID))
+}
+
+// TestDirtyValidatorNotFound tests that a staking hook for a validator that cannot be marked as dirty
+// does not fail, but makes the consumer validator sets be computed from scratch at the next epoch
+func TestDirtyValidatorNotFound(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	state := newStakingState(mocks, 3)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	for i := range state.validators {
+		providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+	}
+	providerKeeper.QueueVSCPackets(ctx)
+	require.True(t, providerKeeper.IsConsumerValSetTracked(ctx, chainID))
+
+	unknownValAddr := sdk.ValAddress([]byte("unknown validator"))
+	require.NoError(t, providerKeeper.Hooks().AfterDelegationModified(ctx, sdk.AccAddress{}, unknownValAddr))
+	require.NoError(t, providerKeeper.Hooks().BeforeDelegationRemoved(ctx, sdk.AccAddress{}, unknownValAddr))
+	require.Empty(t, providerKeeper.GetAllDirtyValidators(ctx))
+	require.False(t, providerKeeper.IsConsumerValSetTracked(ctx, chainID))
+
+	// the consumer validator set is computed from scratch
+	providerKeeper.QueueVSCPackets(ctx)
+	require.Equal(t, 2, state.iterations)
+	require.True(t, providerKeeper.IsConsumerValSetTracked(ctx, chainID))
+}
+
+// BenchmarkQueueVSCPackets benchmarks queueing VSC packets for 50 consumer chains that are all validated by
+// 500 validators, when computing the consumer validator sets from scratch and when updating them incrementally
+func BenchmarkQueueVSCPackets(b *testing.B) {
+	const (
+		numConsumers  = 50
+		numValidators = 500
+	)
+
+	// setup returns a context in which the consumer validator sets of all consumer chains were already computed
+	// and `numDirty` validators changed their power afterwards
+	setup := func(b *testing.B, fromScratch bool, numDirty int) (keeper.Keeper, sdk.Context) {
+		b.Helper()
+		ctrl := gomock.NewController(b)
+		b.Cleanup(ctrl.Finish)
+		mocks := testkeeper.NewMockedKeepers(ctrl)
+		keeperParams := testkeeper.NewInMemKeeperParams(b)
+		providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+		ctx := keeperParams.Ctx
+		// commit the store and write through cache contexts as in block execution, because
+		// iterating over uncommitted IAVL state is disproportionately slow
+		commit := func() { ctx.MultiStore().(storetypes.CommitMultiStore).Commit() }
+
+		state := newStakingState(mocks, numValidators)
+		for c := 0; c < numConsumers; c++ {
+			chainID := fmt.Sprintf("chain-%d", c)
+			providerKeeper.SetConsumerClientId(ctx, chainID, fmt.Sprintf("client-%d", c))
+			for i := range state.validators {
+				providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+			}
+		}
+		commit()
+
+		cachedCtx, writeCache := ctx.CacheContext()
+		providerKeeper.QueueVSCPackets(cachedCtx)
+		writeCache()
+		if fromScratch {
+			for _, chainID := range providerKeeper.GetAllRegisteredConsumerChainIDs(ctx) {
+				providerKeeper.DeleteConsumerValSetTracked(ctx, chainID)
+			}
+		}
+		for i := 0; i < numDirty; i++ {
+			state.powers[state.validators[i].GetOperator()] += 1
+			providerKeeper.SetDirtyValidator(ctx, state.providerAddr(i))
+		}
+		commit()
+
+		return providerKeeper, ctx
+	}
+
+	testCases := []struct {
+		name        string
+		fromScratch bool
+		numDirty    int
+	}{
+		{"from scratch", true, 10},
+		{"incremental without changes", false, 0},
+		{"incremental with 10 dirty validators", false, 10},
+		{"incremental with 100 dirty validators", false, 100},
+	}
+
+	for _, tc := range testCases {
+		b.Run(tc.name, func(b *testing.B) {
+			providerKeeper, ctx := setup(b, tc.fromScratch, tc.numDirty)
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				// every iteration starts from the same state
+				cachedCtx, _ := ctx.CacheContext()
+				providerKeeper.QueueVSCPackets(cachedCtx)
+			}
+		})
+	}
+}
+
 // TestQueueVSCPacketsForReplicatedSecurity tests queueing validator set updates for replicated security
 func TestQueueVSCPacketsForReplicatedSecurity(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
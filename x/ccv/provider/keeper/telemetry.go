@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccv "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// incrConsumerCounter increments by val the provider counter with the given key
+// for the given consumer chain
+func incrConsumerCounter(key, chainID string, val float32) {
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, key}, val, ccv.ChainIDMetricLabels(chainID))
+}
+
+// setPendingVSCPacketsGauge sets the gauge of the VSC packets pending to be sent to the given consumer chain
+func (k Keeper) setPendingVSCPacketsGauge(ctx sdk.Context, chainID string) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, types.MetricKeyPendingVSCPackets},
+		float32(len(k.GetPendingVSCPackets(ctx, chainID))),
+		ccv.ChainIDMetricLabels(chainID),
+	)
+}
+
+// setSlashMeterGauge sets the gauge of the slash meter
+func setSlashMeterGauge(meter math.Int) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	value, err := meter.ToLegacyDec().Float64()
+	if err != nil {
+		return
+	}
+	telemetry.SetGauge(float32(value), types.ModuleName, types.MetricKeySlashMeter)
+}
+
+// incrRewardsAllocatedCounters increments the per-denom counters of the rewards
+// allocated by the given consumer chain
+func incrRewardsAllocatedCounters(chainID string, rewards sdk.DecCoins) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	for _, reward := range rewards {
+		amount, err := reward.Amount.Float64()
+		if err != nil {
+			continue
+		}
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.MetricKeyRewardsAllocated},
+			float32(amount),
+			ccv.DenomMetricLabels(chainID, reward.Denom),
+		)
+	}
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccv "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestProviderTelemetry(t *testing.T) {
+	sink := testkeeper.NewInmemTelemetrySink(t)
+
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "consumer"
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.AppendPendingVSCPackets(ctx, chainID,
+		ccv.NewValidatorSetChangePacketData(nil, 1, nil),
+		ccv.NewValidatorSetChangePacketData(nil, 2, nil),
+	)
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(42))
+
+	// the CCV channel is not established, so the VSC packets remain pending
+	providerKeeper.SendVSCPackets(ctx)
+
+	// prune the key assignments of two consumer addresses
+	for i := 0; i < 2; i++ {
+		consumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(i).ConsumerConsAddress()
+		providerKeeper.AppendConsumerAddrsToPrune(ctx, chainID, ctx.BlockTime(), consumerAddr)
+	}
+	providerKeeper.PruneKeyAssignments(ctx, chainID)
+
+	gauges, counters := testkeeper.GatherMetrics(t, sink)
+	require.ElementsMatch(t, []testkeeper.Metric{
+		{Name: types.ModuleName + "." + types.MetricKeyPendingVSCPackets, Value: 2, Labels: map[string]string{ccv.MetricLabelChainID: chainID}},
+		{Name: types.ModuleName + "." + types.MetricKeySlashMeter, Value: 42, Labels: map[string]string{}},
+	}, gauges)
+	require.Equal(t, []testkeeper.Metric{
+		{Name: types.ModuleName + "." + types.MetricKeyKeyAssignmentsPruned, Value: 2, Labels: map[string]string{ccv.MetricLabelChainID: chainID}},
+	}, counters)
+}
@@ -173,6 +173,7 @@ func (k Keeper) SetSlashMeter(ctx sdktypes.Context, value math.Int) {
 		panic(fmt.Sprintf("failed to marshal slash meter: %v", err))
 	}
 	store.Set(providertypes.SlashMeterKey(), bz)
+	setSlashMeterGauge(value)
 }
 
 // GetSlashMeterReplenishTimeCandidate returns the next UTC time the slash meter could potentially be replenished.
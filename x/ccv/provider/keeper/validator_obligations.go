@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// GetValidatorObligations returns the obligations of the given validator on every running consumer chain
+func (k Keeper) GetValidatorObligations(ctx sdk.Context, validator stakingtypes.Validator) ([]types.ValidatorObligation, error) {
+	getBondedValidators := k.bondedValidatorsLoader(ctx)
+
+	var obligations []types.ValidatorObligation
+	for _, chainID := range k.GetAllRegisteredConsumerChainIDs(ctx) {
+		obligation, err := k.getValidatorObligation(ctx, chainID, validator, getBondedValidators)
+		if err != nil {
+			return nil, err
+		}
+		obligations = append(obligations, obligation)
+	}
+	return obligations, nil
+}
+
+// getValidatorObligation returns the obligations of the given validator on consumer chain `chainID`.
+// The obligations after the current epoch are computed from the currently bonded validators, as
+// the validator updates queued at the end of the epoch, and take into account the consumer key
+// rotation of the validator if it is applied at the end of the epoch.
+func (k Keeper) getValidatorObligation(
+	ctx sdk.Context,
+	chainID string,
+	validator stakingtypes.Validator,
+	getBondedValidators func() []stakingtypes.Validator,
+) (types.ValidatorObligation, error) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.ValidatorObligation{}, err
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	consumerKey, err := k.getConsumerKeyOrProviderKey(ctx, chainID, validator)
+	if err != nil {
+		return types.ValidatorObligation{}, err
+	}
+	_, keyAssigned := k.GetValidatorConsumerPubKey(ctx, chainID, providerAddr)
+
+	obligation := types.ValidatorObligation{
+		ChainId:             chainID,
+		ConsumerKeyAssigned: keyAssigned,
+	}
+	timeBased := k.IsTimeBasedEpoch(ctx, chainID)
+	if consumerValidator, found := k.GetConsumerValidator(ctx, chainID, providerAddr); found {
+		// the consumer chain keeps using the previous consumer key until the end of the epoch
+		consumerKey = *consumerValidator.ConsumerPublicKey
+		obligation.IsConsumerValidator = true
+		obligation.Power = consumerValidator.Power
+		obligation.EligibleForRewards = k.IsEligibleForConsumerRewards(ctx, chainID, consumerValidator)
+		if timeBased {
+			eligibilityTime := k.GetRewardsEligibilityTime(ctx, consumerValidator)
+			obligation.RewardsEligibilityTime = &eligibilityTime
+		} else {
+			obligation.RewardsEligibilityHeight = consumerValidator.JoinHeight +
+				k.GetNumberOfEpochsToStartReceivingRewards(ctx)*k.GetConsumerBlocksPerEpoch(ctx, chainID)
+		}
+	}
+	obligation.ConsumerKey = &consumerKey
+	if obligation.ConsumerAddress, err = consumerKeyToAddress(consumerKey); err != nil {
+		return types.ValidatorObligation{}, err
+	}
+
+	// the latest block has been committed, so the epoch of the next block is considered
+	nextEpochHeight := k.GetEpochEndHeight(ctx, chainID, ctx.BlockHeight()+1)
+	if timeBased {
+		// the height at which an epoch based on block time ends is not known in advance,
+		// so the obligations are computed at the earliest height at which it can end
+		nextEpochHeight = ctx.BlockHeight() + 1
+	}
+	if k.IsConsumerPaused(ctx, chainID) {
+		// the consumer validator set is not updated while the chain is paused
+		obligation.NextEpoch = types.ValidatorObligationChange{
+			Height:              nextEpochHeight,
+			IsConsumerValidator: obligation.IsConsumerValidator,
+			Power:               obligation.Power,
+			ConsumerKey:         obligation.ConsumerKey,
+			ConsumerAddress:     obligation.ConsumerAddress,
+		}
+	} else {
+		obligation.NextEpoch, err = k.getNextEpochObligation(ctx, chainID, validator, nextEpochHeight, getBondedValidators)
+		if err != nil {
+			return types.ValidatorObligation{}, err
+		}
+	}
+	obligation.NextEpoch.ConsumerKeyChanges = obligation.NextEpoch.ConsumerAddress != obligation.ConsumerAddress
+	if timeBased {
+		endTime := k.GetEpochEndTime(ctx)
+		obligation.NextEpoch.Height = 0
+		obligation.NextEpoch.EndTime = &endTime
+	}
+
+	obligation.Membership, obligation.Reason, err = k.getConsumerMembership(ctx, chainID, validator, *obligation.NextEpoch.ConsumerKey)
+	if err != nil {
+		return types.ValidatorObligation{}, err
+	}
+	if (obligation.Membership == types.MembershipForcedTopN || obligation.Membership == types.MembershipOptedIn) &&
+		!obligation.NextEpoch.IsConsumerValidator {
+		obligation.Reason += ", but not in the next consumer validator set"
+	}
+	return obligation, nil
+}
+
+// getNextEpochObligation computes the obligations of the given validator on consumer chain `chainID`
+// after the validator updates of the current epoch are queued at `nextEpochHeight`. The state changes
+// made to compute them are discarded.
+func (k Keeper) getNextEpochObligation(
+	ctx sdk.Context,
+	chainID string,
+	validator stakingtypes.Validator,
+	nextEpochHeight int64,
+	getBondedValidators func() []stakingtypes.Validator,
+) (types.ValidatorObligationChange, error) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.ValidatorObligationChange{}, err
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	cachedCtx, _ := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithBlockHeight(nextEpochHeight)
+
+	if rotation, found := k.GetConsumerKeyRotation(cachedCtx, chainID, providerAddr); found &&
+		rotation.ApplyHeight <= nextEpochHeight {
+		// a rotation whose key can no longer be assigned is dropped, so the error is ignored;
+		// the provider hooks are not called, as the rotation is not applied yet
+		_ = k.assignConsumerKey(cachedCtx, chainID, validator, rotation.ConsumerKey)
+	}
+
+	next := types.ValidatorObligationChange{Height: nextEpochHeight}
+	consumerKey, err := k.getConsumerKeyOrProviderKey(cachedCtx, chainID, validator)
+	if err != nil {
+		return types.ValidatorObligationChange{}, err
+	}
+	for _, v := range k.ComputeNextValidators(cachedCtx, chainID, getBondedValidators()) {
+		if providerAddr.ToSdkConsAddr().Equals(sdk.ConsAddress(v.ProviderConsAddr)) {
+			next.IsConsumerValidator = true
+			next.Power = v.Power
+			consumerKey = *v.ConsumerPublicKey
+			break
+		}
+	}
+	next.ConsumerKey = &consumerKey
+	if next.ConsumerAddress, err = consumerKeyToAddress(consumerKey); err != nil {
+		return types.ValidatorObligationChange{}, err
+	}
+	return next, nil
+}
+
+// getConsumerMembership returns the membership of the given validator on consumer chain `chainID`
+// and the reason for it, given the consumer key that the validator uses after the current epoch
+func (k Keeper) getConsumerMembership(
+	ctx sdk.Context,
+	chainID string,
+	validator stakingtypes.Validator,
+	consumerKey tmprotocrypto.PublicKey,
+) (types.ConsumerMembership, string, error) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return types.MembershipUnspecified, "", err
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	if !k.IsAllowlistEmpty(ctx, chainID) && !k.IsAllowlisted(ctx, chainID, providerAddr) {
+		return types.MembershipExcluded, "not in the allowlist of the chain", nil
+	}
+	if k.IsDenylisted(ctx, chainID, providerAddr) {
+		return types.MembershipExcluded, "in the denylist of the chain", nil
+	}
+	if !k.IsKeyTypeAllowed(ctx, chainID, consumerKey) {
+		return types.MembershipExcluded, "consumer key type not allowed by the chain", nil
+	}
+
+	if minPower, found := k.GetMinimumPowerInTopN(ctx, chainID); found && k.IsTopN(ctx, chainID) {
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return types.MembershipUnspecified, "", err
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return types.MembershipUnspecified, "", err
+		}
+		if power >= minPower {
+			return types.MembershipForcedTopN, fmt.Sprintf("power %d is at least the minimum power %d in the top N", power, minPower), nil
+		}
+	}
+
+	if k.IsOptedIn(ctx, chainID, providerAddr) {
+		return types.MembershipOptedIn, "opted in", nil
+	}
+	return types.MembershipEligible, "can opt in", nil
+}
+
+// getConsumerKeyOrProviderKey returns the consumer key assigned by the given validator on consumer
+// chain `chainID`, or its provider key if it has no consumer key assigned
+func (k Keeper) getConsumerKeyOrProviderKey(
+	ctx sdk.Context,
+	chainID string,
+	validator stakingtypes.Validator,
+) (tmprotocrypto.PublicKey, error) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return tmprotocrypto.PublicKey{}, err
+	}
+	if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, chainID, types.NewProviderConsAddress(consAddr)); found {
+		return consumerKey, nil
+	}
+	return validator.TmConsPublicKey()
+}
+
+// consumerKeyToAddress returns the bech32 consensus address of a consumer key
+func consumerKeyToAddress(consumerKey tmprotocrypto.PublicKey) (string, error) {
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	if err != nil {
+		return "", err
+	}
+	return consumerAddr.String(), nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -19,13 +20,9 @@ func (k Keeper) SetConsumerValidator(
 	chainID string,
 	validator types.ConsumerValidator,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := validator.Marshal()
-	if err != nil {
+	if err := k.consumerValidators.Set(ctx, collections.Join(chainID, sdk.ConsAddress(validator.ProviderConsAddr)), validator); err != nil {
 		panic(fmt.Errorf("failed to marshal ConsumerValidator: %w", err))
 	}
-
-	store.Set(types.ConsumerValidatorKey(chainID, validator.ProviderConsAddr), bz)
 }
 
 // SetConsumerValSet resets the current consumer validators with the `nextValidators` computed by
@@ -43,8 +40,9 @@ func (k Keeper) DeleteConsumerValidator(
 	chainID string,
 	providerConsAddr types.ProviderConsAddress,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.ConsumerValidatorKey(chainID, providerConsAddr.ToSdkConsAddr()))
+	if err := k.consumerValidators.Remove(ctx, collections.Join(chainID, providerConsAddr.ToSdkConsAddr())); err != nil {
+		panic(fmt.Errorf("failed to delete ConsumerValidator: %w", err))
+	}
 }
 
 // DeleteConsumerValSet deletes all the stored consumer validators for chain `chainID`
@@ -52,42 +50,24 @@ func (k Keeper) DeleteConsumerValSet(
 	ctx sdk.Context,
 	chainID string,
 ) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.ChainIdWithLenKey(types.ConsumerValidatorBytePrefix, chainID)
-	iterator := storetypes.KVStorePrefixIterator(store, key)
-
-	var keysToDel [][]byte
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		keysToDel = append(keysToDel, iterator.Key())
-	}
-	for _, delKey := range keysToDel {
-		store.Delete(delKey)
+	if err := k.consumerValidators.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.ConsAddress](chainID)); err != nil {
+		panic(fmt.Errorf("failed to delete consumer validator set: %w", err))
 	}
 }
 
 // IsConsumerValidator returns `true` if the consumer validator with `providerAddr` exists for chain `chainID`
 // and `false` otherwise
 func (k Keeper) IsConsumerValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Get(types.ConsumerValidatorKey(chainID, providerAddr.ToSdkConsAddr())) != nil
+	found, err := k.consumerValidators.Has(ctx, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
+	if err != nil {
+		panic(fmt.Errorf("failed to check ConsumerValidator: %w", err))
+	}
+	return found
 }
 
 // GetConsumerValidator returns the consumer validator with `providerAddr` if it exists for chain `chainID`
 func (k Keeper) GetConsumerValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) (types.ConsumerValidator, bool) {
-	store := ctx.KVStore(k.storeKey)
-	marshalledConsumerValidator := store.Get(types.ConsumerValidatorKey(chainID, providerAddr.ToSdkConsAddr()))
-
-	if marshalledConsumerValidator == nil {
-		return types.ConsumerValidator{}, false
-	}
-
-	var validator types.ConsumerValidator
-	if err := validator.Unmarshal(marshalledConsumerValidator); err != nil {
-		panic(fmt.Errorf("failed to unmarshal ConsumerValidator: %w", err))
-	}
-
-	return validator, true
+	return mustGet(ctx, k.consumerValidators.Get, collections.Join(chainID, providerAddr.ToSdkConsAddr()))
 }
 
 // GetConsumerValSet returns all the consumer validators for chain `chainID`
@@ -95,18 +75,13 @@ func (k Keeper) GetConsumerValSet(
 	ctx sdk.Context,
 	chainID string,
 ) (validators []types.ConsumerValidator) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.ChainIdWithLenKey(types.ConsumerValidatorBytePrefix, chainID)
-	iterator := storetypes.KVStorePrefixIterator(store, key)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		iterator.Value()
-		var validator types.ConsumerValidator
-		if err := validator.Unmarshal(iterator.Value()); err != nil {
-			panic(fmt.Errorf("failed to unmarshal ConsumerValidator: %w", err))
-		}
-		validators = append(validators, validator)
+	iterator, err := k.consumerValidators.Iterate(ctx, collections.NewPrefixedPairRange[string, sdk.ConsAddress](chainID))
+	if err != nil {
+		panic(fmt.Errorf("failed to iterate consumer validators: %w", err))
+	}
+	validators, err = iterator.Values()
+	if err != nil {
+		panic(fmt.Errorf("failed to unmarshal ConsumerValidator: %w", err))
 	}
 
 	return validators
@@ -178,11 +153,11 @@ func (k Keeper) CreateConsumerValidator(ctx sdk.Context, chainID string, validat
 		}
 	}
 
-	height := ctx.BlockHeight()
+	height, joinTime := ctx.BlockHeight(), ctx.BlockTime()
 	if v, found := k.GetConsumerValidator(ctx, chainID, types.ProviderConsAddress{Address: consAddr}); found {
-		// if validator was already a consumer validator, then do not update the height set the first time
-		// the validator became a consumer validator
-		height = v.JoinHeight
+		// if validator was already a consumer validator, then do not update the height and time set the
+		// first time the validator became a consumer validator
+		height, joinTime = v.JoinHeight, v.JoinTime
 	}
 
 	return types.ConsumerValidator{
@@ -190,17 +165,21 @@ func (k Keeper) CreateConsumerValidator(ctx sdk.Context, chainID string, validat
 		Power:             power,
 		ConsumerPublicKey: &consumerPublicKey,
 		JoinHeight:        height,
+		JoinTime:          joinTime,
 	}, nil
 }
 
 // FilterValidators filters the provided `bondedValidators` according to `predicate` and returns
-// the filtered set.
+// the filtered set. Validators whose consumer public key is of a type that the consumer chain
+// does not allow are left out, as they could not validate the chain.
 func (k Keeper) FilterValidators(
 	ctx sdk.Context,
 	chainID string,
 	bondedValidators []stakingtypes.Validator,
 	predicate func(providerAddr types.ProviderConsAddress) bool,
 ) []types.ConsumerValidator {
+	allowedKeyTypes := k.GetAllowedKeyTypes(ctx, chainID)
+
 	var nextValidators []types.ConsumerValidator
 	for _, val := range bondedValidators {
 		consAddr, err := val.GetConsAddr()
@@ -218,6 +197,15 @@ func (k Keeper) FilterValidators(
 				continue
 			}
 
+			keyType, err := ccv.ConsensusKeyType(*nextValidator.ConsumerPublicKey)
+			if err != nil || !ccv.IsKeyTypeAllowed(allowedKeyTypes, keyType) {
+				k.Logger(ctx).Debug("validator excluded from the consumer validator set: consumer key type not allowed",
+					"chainID", chainID,
+					"validator", val.GetOperator(),
+					"key type", keyType)
+				continue
+			}
+
 			nextValidators = append(nextValidators, nextValidator)
 		}
 	}
@@ -225,6 +213,195 @@ func (k Keeper) FilterValidators(
 	return nextValidators
 }
 
+// SetDirtyValidator marks validator `providerAddr` as dirty, i.e., its staking power or bonded status
+// changed and hence it has to be recomputed in all the consumer validator sets at the end of the epoch
+func (k Keeper) SetDirtyValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DirtyValidatorKey(providerAddr), []byte{})
+}
+
+// GetAllDirtyValidators returns all the validators marked as dirty, ordered by their provider consensus address
+func (k Keeper) GetAllDirtyValidators(ctx sdk.Context) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.DirtyValidatorBytePrefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[1:]))
+	}
+
+	return providerAddrs
+}
+
+// DeleteAllDirtyValidators removes the dirty mark from all the validators
+func (k Keeper) DeleteAllDirtyValidators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.DirtyValidatorBytePrefix})
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// SetDirtyConsumerValidator marks validator `providerAddr` as dirty on chain `chainID`, i.e., its opt-in status
+// or its consumer key changed and hence it has to be recomputed in the consumer validator set at the end of the epoch
+func (k Keeper) SetDirtyConsumerValidator(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DirtyConsumerValidatorKey(chainID, providerAddr), []byte{})
+}
+
+// GetAllDirtyConsumerValidators returns all the validators marked as dirty on chain `chainID`,
+// ordered by their provider consensus address
+func (k Keeper) GetAllDirtyConsumerValidators(ctx sdk.Context, chainID string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChainIdWithLenKey(types.DirtyConsumerValidatorBytePrefix, chainID)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[len(key):]))
+	}
+
+	return providerAddrs
+}
+
+// DeleteAllDirtyConsumerValidators removes the dirty mark from all the validators on chain `chainID`
+func (k Keeper) DeleteAllDirtyConsumerValidators(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ChainIdWithLenKey(types.DirtyConsumerValidatorBytePrefix, chainID)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// SetConsumerValSetTracked marks that the consumer validator set of chain `chainID` is up to date
+// with respect to the dirty validators and can hence be updated incrementally
+func (k Keeper) SetConsumerValSetTracked(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerValSetTrackedKey(chainID), []byte{})
+}
+
+// IsConsumerValSetTracked returns `true` if the consumer validator set of chain `chainID`
+// can be updated incrementally and `false` otherwise
+func (k Keeper) IsConsumerValSetTracked(ctx sdk.Context, chainID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.ConsumerValSetTrackedKey(chainID)) != nil
+}
+
+// DeleteConsumerValSetTracked removes the mark that the consumer validator set of chain `chainID`
+// can be updated incrementally, so that it is computed from scratch at the end of the next epoch
+func (k Keeper) DeleteConsumerValSetTracked(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerValSetTrackedKey(chainID))
+}
+
+// MarkConsumerValidatorDirty marks validator `providerAddr` as dirty on chain `chainID` if the consumer
+// validator set of the chain is updated incrementally. Otherwise, the consumer validator set is computed
+// from scratch at the end of the epoch and there is no need to track the validator.
+func (k Keeper) MarkConsumerValidatorDirty(ctx sdk.Context, chainID string, providerAddr types.ProviderConsAddress) {
+	if k.IsConsumerValSetTracked(ctx, chainID) {
+		k.SetDirtyConsumerValidator(ctx, chainID, providerAddr)
+	}
+}
+
+// CanUpdateConsumerValSetIncrementally returns `true` if the consumer validator set of chain `chainID`
+// can be updated by only recomputing the dirty validators. This is not the case for chains with a
+// validator-set cap or a validators-power cap, because capping depends on all the consumer validators.
+func (k Keeper) CanUpdateConsumerValSetIncrementally(ctx sdk.Context, chainID string) bool {
+	if !k.IsConsumerValSetTracked(ctx, chainID) {
+		return false
+	}
+	if validatorSetCap, found := k.GetValidatorSetCap(ctx, chainID); found && validatorSetCap != 0 {
+		return false
+	}
+	if validatorsPowerCap, found := k.GetValidatorsPowerCap(ctx, chainID); found && validatorsPowerCap != 0 {
+		return false
+	}
+	return true
+}
+
+// UpdateConsumerValSetIncrementally recomputes the consumer validators of chain `chainID` that correspond to
+// the `dirtyValidators`, stores them, and returns the resulting `ValidatorUpdate`s. The result is the same as
+// computing the next validators with `ComputeNextValidators` and diffing them with `DiffValidators`, as long as
+// `CanUpdateConsumerValSetIncrementally` holds and `dirtyValidators` contains every validator whose power,
+// bonded status, opt-in status, or consumer key changed since the consumer validator set was last computed.
+func (k Keeper) UpdateConsumerValSetIncrementally(
+	ctx sdk.Context,
+	chainID string,
+	dirtyValidators []types.ProviderConsAddress,
+) []abci.ValidatorUpdate {
+	var updates []abci.ValidatorUpdate
+	for _, providerAddr := range dirtyValidators {
+		currentVal, isCurrent := k.GetConsumerValidator(ctx, chainID, providerAddr)
+		nextVal, isNext := k.computeNextConsumerValidator(ctx, chainID, providerAddr)
+
+		switch {
+		case !isCurrent && !isNext:
+			continue
+		case isCurrent && !isNext:
+			// the validator leaves the consumer validator set
+			updates = append(updates, abci.ValidatorUpdate{PubKey: *currentVal.ConsumerPublicKey, Power: 0})
+			k.DeleteConsumerValidator(ctx, chainID, providerAddr)
+			continue
+		case isCurrent && currentVal.ConsumerPublicKey.String() != nextVal.ConsumerPublicKey.String():
+			// the validator changed its consumer public key, so the old key is removed
+			updates = append(updates,
+				abci.ValidatorUpdate{PubKey: *currentVal.ConsumerPublicKey, Power: 0},
+				abci.ValidatorUpdate{PubKey: *nextVal.ConsumerPublicKey, Power: nextVal.Power})
+		case !isCurrent || currentVal.Power != nextVal.Power:
+			// the validator joins the consumer validator set or changed its voting power
+			updates = append(updates, abci.ValidatorUpdate{PubKey: *nextVal.ConsumerPublicKey, Power: nextVal.Power})
+		}
+		k.SetConsumerValidator(ctx, chainID, nextVal)
+	}
+
+	return updates
+}
+
+// computeNextConsumerValidator returns the consumer validator of `providerAddr` on chain `chainID` for the
+// upcoming epoch, and `false` if the validator does not belong to the next consumer validator set
+func (k Keeper) computeNextConsumerValidator(
+	ctx sdk.Context,
+	chainID string,
+	providerAddr types.ProviderConsAddress,
+) (types.ConsumerValidator, bool) {
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if err != nil || !validator.IsBonded() || !k.CanValidateChain(ctx, chainID, providerAddr) {
+		return types.ConsumerValidator{}, false
+	}
+
+	nextValidator, err := k.CreateConsumerValidator(ctx, chainID, validator)
+	if err != nil {
+		// this should never happen but is recoverable if we exclude this validator from the next validator set
+		k.Logger(ctx).Error("could not create consumer validator",
+			"validator", validator.GetOperator(),
+			"error", err)
+		return types.ConsumerValidator{}, false
+	}
+
+	// as in FilterValidators, a validator whose consumer key type is not allowed cannot validate the chain
+	if !k.IsKeyTypeAllowed(ctx, chainID, *nextValidator.ConsumerPublicKey) {
+		k.Logger(ctx).Debug("validator excluded from the consumer validator set: consumer key type not allowed",
+			"chainID", chainID,
+			"validator", validator.GetOperator())
+		return types.ConsumerValidator{}, false
+	}
+
+	return nextValidator, true
+}
+
 // GetLastBondedValidators iterates the last validator powers in the staking module
 // and returns the first MaxValidators many validators with the largest powers.
 func (k Keeper) GetLastBondedValidators(ctx sdk.Context) ([]stakingtypes.Validator, error) {
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"bytes"
+	"context"
 	"sort"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -13,6 +15,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
@@ -449,6 +452,41 @@ func TestFilterValidatorsWithConsumerKeys(t *testing.T) {
 	require.Equal(t, expectedValidators, actualValidators)
 }
 
+// TestFilterValidatorsWithAllowedKeyTypes tests that validators whose consumer key is of a type
+// that the consumer chain does not allow are left out of the consumer validator set
+func TestFilterValidatorsWithAllowedKeyTypes(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{
+		ChainId:         chainID,
+		AllowedKeyTypes: []string{"secp256k1"},
+	})
+	require.Equal(t, []string{"secp256k1"}, providerKeeper.GetAllowedKeyTypes(ctx, chainID))
+	// chains that do not declare key types only allow ed25519 keys
+	require.Equal(t, []string{"ed25519"}, providerKeeper.GetAllowedKeyTypes(ctx, "otherChainID"))
+
+	// validator A has no consumer key and validates with its ed25519 provider key
+	valA := createStakingValidator(ctx, mocks, 1, 1, 1)
+
+	// validator B has assigned a secp256k1 consumer key
+	valB := createStakingValidator(ctx, mocks, 2, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	valBConsumerKey := crypto.PublicKey{
+		Sum: &crypto.PublicKey_Secp256K1{Secp256K1: secp256k1.GenPrivKey().PubKey().Bytes()},
+	}
+	providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, types.NewProviderConsAddress(valBConsAddr), valBConsumerKey)
+
+	considerAll := func(providerAddr types.ProviderConsAddress) bool { return true }
+	actualValidators := providerKeeper.FilterValidators(ctx, chainID, []stakingtypes.Validator{valA, valB}, considerAll)
+	require.Equal(t, []types.ConsumerValidator{{
+		ProviderConsAddr:  types.NewProviderConsAddress(valBConsAddr).Address.Bytes(),
+		Power:             2,
+		ConsumerPublicKey: &valBConsumerKey,
+	}}, actualValidators)
+}
+
 func TestCreateConsumerValidator(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -484,3 +522,201 @@ func TestCreateConsumerValidator(t *testing.T) {
 	require.Equal(t, expectedConsumerValidatorB, actualConsumerValidatorB)
 	require.NoError(t, err)
 }
+
+// stakingState mocks the staking module for validators whose power and bonded status
+// can be modified during a test
+type stakingState struct {
+	validators []stakingtypes.Validator
+	powers     map[string]int64
+	// number of times the last validator powers were iterated, i.e., the bonded validators were loaded
+	iterations int
+}
+
+func newStakingState(mocks testkeeper.MockedKeepers, numValidators int) *stakingState {
+	state := &stakingState{powers: map[string]int64{}}
+	byConsAddr := map[string]int{}
+	byOperator := map[string]int{}
+	for i := 0; i < numValidators; i++ {
+		validator := cryptotestutil.NewCryptoIdentityFromIntSeed(i).SDKStakingValidator()
+		validator.Status = stakingtypes.Bonded
+		consAddr, _ := validator.GetConsAddr()
+		byConsAddr[sdk.ConsAddress(consAddr).String()] = i
+		byOperator[validator.GetOperator()] = i
+		state.validators = append(state.validators, validator)
+		state.powers[validator.GetOperator()] = int64(i + 1)
+	}
+
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+			if i, found := byConsAddr[consAddr.String()]; found {
+				return state.validators[i], nil
+			}
+			return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, error) {
+			if i, found := byOperator[valAddr.String()]; found {
+				return state.validators[i], nil
+			}
+			return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (int64, error) {
+			return state.powers[valAddr.String()], nil
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(uint32(numValidators), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().IterateLastValidatorPowers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, cb func(sdk.ValAddress, int64) bool) error {
+			state.iterations++
+			for _, validator := range state.validators {
+				if !validator.IsBonded() {
+					continue
+				}
+				valAddr, _ := sdk.ValAddressFromBech32(validator.GetOperator())
+				if stop := cb(valAddr, state.powers[validator.GetOperator()]); stop {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+
+	return state
+}
+
+func (s *stakingState) providerAddr(i int) types.ProviderConsAddress {
+	consAddr, _ := s.validators[i].GetConsAddr()
+	return types.NewProviderConsAddress(consAddr)
+}
+
+// TestUpdateConsumerValSetIncrementally tests that updating a consumer validator set by only recomputing
+// the dirty validators results in the same validator updates as computing the validator set from scratch
+func TestUpdateConsumerValSetIncrementally(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	state := newStakingState(mocks, 6)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+
+	// validators 0 to 3 are opted in and the consumer validator set is computed from scratch
+	for i := 0; i < 4; i++ {
+		providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+	}
+	bondedValidators, err := providerKeeper.GetLastBondedValidators(ctx)
+	require.NoError(t, err)
+	providerKeeper.SetConsumerValSet(ctx, chainID, providerKeeper.ComputeNextValidators(ctx, chainID, bondedValidators))
+	providerKeeper.SetConsumerValSetTracked(ctx, chainID)
+	currentValidators := providerKeeper.GetConsumerValSet(ctx, chainID)
+
+	// validator 0 changes its power
+	state.powers[state.validators[0].GetOperator()] = 100
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(0))
+	// validator 1 is unbonded
+	state.validators[1].Status = stakingtypes.Unbonding
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(1))
+	// validator 2 assigns a consumer key
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(100).TMProtoCryptoPublicKey()
+	providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, state.providerAddr(2), consumerKey)
+	providerKeeper.MarkConsumerValidatorDirty(ctx, chainID, state.providerAddr(2))
+	// validator 3 opts out and validator 4 opts in
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, chainID, state.providerAddr(3)))
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, chainID, state.providerAddr(4), ""))
+	// validator 5 changes its power but is not opted in
+	state.powers[state.validators[5].GetOperator()] = 200
+	providerKeeper.SetDirtyValidator(ctx, state.providerAddr(5))
+
+	// compute the expected updates from scratch
+	bondedValidators, err = providerKeeper.GetLastBondedValidators(ctx)
+	require.NoError(t, err)
+	nextValidators := providerKeeper.ComputeNextValidators(ctx, chainID, bondedValidators)
+	expectedUpdates := keeper.DiffValidators(currentValidators, nextValidators)
+	require.Len(t, expectedUpdates, 6)
+
+	dirtyValidators := append(providerKeeper.GetAllDirtyValidators(ctx), providerKeeper.GetAllDirtyConsumerValidators(ctx, chainID)...)
+	require.Len(t, dirtyValidators, 6)
+	updates := providerKeeper.UpdateConsumerValSetIncrementally(ctx, chainID, dirtyValidators)
+
+	require.ElementsMatch(t, expectedUpdates, updates)
+	require.ElementsMatch(t, nextValidators, providerKeeper.GetConsumerValSet(ctx, chainID))
+
+	// recomputing the same validators results in no updates
+	require.Empty(t, providerKeeper.UpdateConsumerValSetIncrementally(ctx, chainID, dirtyValidators))
+}
+
+// TestUpdateConsumerValSetIncrementallyWithAllowedKeyTypes tests that the validators whose consumer key
+// is of a type that the consumer chain does not allow are left out of the incremental updates as well
+func TestUpdateConsumerValSetIncrementallyWithAllowedKeyTypes(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	state := newStakingState(mocks, 3)
+	providerKeeper.SetConsumerClientId(ctx, chainID, "clientID")
+	providerKeeper.SetPendingConsumerAdditionProp(ctx, &types.ConsumerAdditionProposal{
+		ChainId:         chainID,
+		AllowedKeyTypes: []string{"secp256k1"},
+	})
+	for i := range state.validators {
+		providerKeeper.SetOptedIn(ctx, chainID, state.providerAddr(i))
+	}
+	// only validator 0 has a secp256k1 consumer key, the others validate with their ed25519 provider key
+	providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, state.providerAddr(0), crypto.PublicKey{
+		Sum: &crypto.PublicKey_Secp256K1{Secp256K1: secp256k1.GenPrivKey().PubKey().Bytes()},
+	})
+	bondedValidators, err := providerKeeper.GetLastBondedValidators(ctx)
+	require.NoError(t, err)
+	providerKeeper.SetConsumerValSet(ctx, chainID, providerKeeper.ComputeNextValidators(ctx, chainID, bondedValidators))
+	providerKeeper.SetConsumerValSetTracked(ctx, chainID)
+	require.Len(t, providerKeeper.GetConsumerValSet(ctx, chainID), 1)
+
+	// all the validators change their power, and validator 1 assigns a secp256k1 consumer key
+	var dirtyValidators []types.ProviderConsAddress
+	for i, val := range state.validators {
+		state.powers[val.GetOperator()] += 10
+		dirtyValidators = append(dirtyValidators, state.providerAddr(i))
+	}
+	providerKeeper.SetValidatorConsumerPubKey(ctx, chainID, state.providerAddr(1), crypto.PublicKey{
+		Sum: &crypto.PublicKey_Secp256K1{Secp256K1: secp256k1.GenPrivKey().PubKey().Bytes()},
+	})
+	providerKeeper.UpdateConsumerValSetIncrementally(ctx, chainID, dirtyValidators)
+
+	bondedValidators, err = providerKeeper.GetLastBondedValidators(ctx)
+	require.NoError(t, err)
+	nextValidators := providerKeeper.ComputeNextValidators(ctx, chainID, bondedValidators)
+	require.Len(t, nextValidators, 2)
+	require.ElementsMatch(t, nextValidators, providerKeeper.GetConsumerValSet(ctx, chainID))
+}
+
+// TestCanUpdateConsumerValSetIncrementally tests that only tracked consumer validator sets without caps
+// are updated incrementally
+func TestCanUpdateConsumerValSetIncrementally(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	chainID := "chainID"
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+
+	// validators are not marked as dirty on chains that are not tracked
+	require.False(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, chainID))
+	providerKeeper.MarkConsumerValidatorDirty(ctx, chainID, providerAddr)
+	require.Empty(t, providerKeeper.GetAllDirtyConsumerValidators(ctx, chainID))
+
+	providerKeeper.SetConsumerValSetTracked(ctx, chainID)
+	require.True(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, chainID))
+	providerKeeper.MarkConsumerValidatorDirty(ctx, chainID, providerAddr)
+	require.Equal(t, []types.ProviderConsAddress{providerAddr}, providerKeeper.GetAllDirtyConsumerValidators(ctx, chainID))
+	providerKeeper.DeleteAllDirtyConsumerValidators(ctx, chainID)
+	require.Empty(t, providerKeeper.GetAllDirtyConsumerValidators(ctx, chainID))
+
+	// capping depends on all the consumer validators
+	providerKeeper.SetValidatorSetCap(ctx, chainID, 10)
+	require.False(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, chainID))
+	providerKeeper.DeleteValidatorSetCap(ctx, chainID)
+	providerKeeper.SetValidatorsPowerCap(ctx, chainID, 10)
+	require.False(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, chainID))
+	providerKeeper.DeleteValidatorsPowerCap(ctx, chainID)
+	require.True(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, chainID))
+
+	providerKeeper.DeleteConsumerValSetTracked(ctx, chainID)
+	require.False(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, chainID))
+}
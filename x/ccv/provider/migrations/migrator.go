@@ -2,9 +2,13 @@ package migrations
 
 import (
 	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	providerkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	v10 "github.com/allinbits/interchain-security/x/ccv/provider/migrations/v10"
+	v11 "github.com/allinbits/interchain-security/x/ccv/provider/migrations/v11"
+	v9 "github.com/allinbits/interchain-security/x/ccv/provider/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,3 +30,24 @@ func NewMigrator(
 		storeKey:       storeKey,
 	}
 }
+
+// Migrate8to9 migrates x/ccvprovider state from consensus version 8 to 9.
+// The migration consists of populating the cross-chain time index of the
+// consumer addresses to prune.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateConsumerAddrsToPruneTimeIndex(ctx, m.storeKey)
+}
+
+// Migrate9to10 migrates x/ccvprovider state from consensus version 9 to 10.
+// The migration consists of rebuilding the channel to chain index used by the
+// collections based keeper and of removing the deprecated state.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateToCollections(ctx, m.storeKey)
+}
+
+// Migrate10to11 migrates x/ccvprovider state from consensus version 10 to 11.
+// The migration consists of setting the params added since version 10 to their
+// default values.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	return v11.MigrateParams(ctx, m.providerKeeper)
+}
@@ -0,0 +1,78 @@
+package v10
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// deprecatedPrefixes are the byte prefixes of the provider store that are no longer
+// written since the removal of the VSCMatured packets.
+var deprecatedPrefixes = []byte{
+	providertypes.DeprecatedMaturedUnbondingOpsByteKey,
+	providertypes.DeprecatedInitTimeoutTimestampBytePrefix,
+	providertypes.DeprecatedUnbondingOpBytePrefix,
+	providertypes.DeprecatedUnbondingOpIndexBytePrefix,
+	providertypes.DeprecatedVscSendTimestampBytePrefix,
+	providertypes.DeprecatedKeyAssignmentReplacementsBytePrefix,
+	providertypes.DeprecatedVSCMaturedHandledThisBlockBytePrefix,
+}
+
+// MigrateToCollections prepares the provider store for the collections based keeper.
+// The layout of the stored data does not change, but the channel to chain mapping
+// becomes a unique index of the chain to channel mapping and it is therefore rebuilt
+// from the latter, dropping the entries without a matching chain to channel entry.
+// The data stored under the deprecated prefixes is removed.
+func MigrateToCollections(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	removed := 0
+	for _, prefix := range deprecatedPrefixes {
+		removed += deletePrefix(store, []byte{prefix})
+	}
+	ctx.Logger().Info("deprecated provider state removed", "entries", removed)
+
+	deletePrefix(store, []byte{providertypes.ChannelToChainBytePrefix})
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.ChainToChannelBytePrefix})
+	var chainIDs, channelIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		chainIDs = append(chainIDs, string(iterator.Key()[1:]))
+		channelIDs = append(channelIDs, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	indexed := 0
+	for i, channelID := range channelIDs {
+		indexKey := providertypes.ChannelToChainKey(channelID)
+		if store.Has(indexKey) {
+			// a CCV channel is established for a single consumer chain, hence this
+			// would indicate that the store is corrupted
+			ctx.Logger().Error("CCV channel mapped to several consumer chains; keeping the first",
+				"channelID", channelID, "chainID", chainIDs[i])
+			continue
+		}
+		store.Set(indexKey, []byte(chainIDs[i]))
+		indexed++
+	}
+	ctx.Logger().Info("channel to chain index rebuilt", "entries", indexed)
+
+	return nil
+}
+
+// deletePrefix deletes all the entries stored under prefix and returns their number
+func deletePrefix(store storetypes.KVStore, prefix []byte) int {
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
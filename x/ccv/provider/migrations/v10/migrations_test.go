@@ -0,0 +1,49 @@
+package v10
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestMigrateToCollections(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+	k, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	k.SetChainToChannel(ctx, "chain-a", "channel-0")
+	k.SetChainToChannel(ctx, "chain-b", "channel-1")
+
+	// mimic the state before the migration: a missing index entry,
+	// a dangling index entry and some deprecated state
+	store := ctx.KVStore(inMemParams.StoreKey)
+	store.Delete(providertypes.ChannelToChainKey("channel-1"))
+	store.Set(providertypes.ChannelToChainKey("channel-7"), []byte("chain-z"))
+	for _, prefix := range deprecatedPrefixes {
+		store.Set([]byte{prefix, 0x01}, []byte("deprecated"))
+	}
+
+	err := MigrateToCollections(ctx, inMemParams.StoreKey)
+	require.NoError(t, err)
+
+	for _, prefix := range deprecatedPrefixes {
+		require.False(t, store.Has([]byte{prefix, 0x01}))
+	}
+
+	chainID, found := k.GetChannelToChain(ctx, "channel-0")
+	require.True(t, found)
+	require.Equal(t, "chain-a", chainID)
+	chainID, found = k.GetChannelToChain(ctx, "channel-1")
+	require.True(t, found)
+	require.Equal(t, "chain-b", chainID)
+	_, found = k.GetChannelToChain(ctx, "channel-7")
+	require.False(t, found)
+
+	// the index is kept consistent once rebuilt
+	k.DeleteChainToChannel(ctx, "chain-b")
+	_, found = k.GetChannelToChain(ctx, "channel-1")
+	require.False(t, found)
+}
@@ -0,0 +1,28 @@
+package v11
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// MigrateParams sets the provider params added since consensus version 10 to their
+// default values. The stored params lack these fields and would otherwise decode to
+// zero values, e.g., a key assignment history size of zero disables the history
+// and a zero retention period forgets stopped consumer chains right away.
+func MigrateParams(ctx sdk.Context, providerKeeper providerkeeper.Keeper) error {
+	params := providerKeeper.GetParams(ctx)
+	params.MaxVscPacketSize = providertypes.DefaultMaxVSCPacketSize
+	params.EmergencyAuthority = ""
+	params.KeyAssignmentHistorySize = providertypes.DefaultKeyAssignmentHistorySize
+	params.EpochDuration = providertypes.DefaultEpochDuration
+	params.StoppedConsumerRetentionPeriod = providertypes.DefaultStoppedConsumerRetentionPeriod
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	providerKeeper.SetParams(ctx, params)
+	ctx.Logger().Info("provider params migrated", "params", params.String())
+	return nil
+}
@@ -0,0 +1,40 @@
+package v11
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+	k, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// mimic the params stored at consensus version 10, which lack the new fields
+	params := providertypes.DefaultParams()
+	params.MaxVscPacketSize = 0
+	params.EmergencyAuthority = ""
+	params.KeyAssignmentHistorySize = 0
+	params.EpochDuration = 0
+	params.StoppedConsumerRetentionPeriod = 0
+	k.SetParams(ctx, params)
+
+	err := MigrateParams(ctx, k)
+	require.NoError(t, err)
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultMaxVSCPacketSize, migrated.MaxVscPacketSize)
+	require.Equal(t, "", migrated.EmergencyAuthority)
+	require.Equal(t, providertypes.DefaultKeyAssignmentHistorySize, migrated.KeyAssignmentHistorySize)
+	require.Equal(t, providertypes.DefaultEpochDuration, migrated.EpochDuration)
+	require.Equal(t, providertypes.DefaultStoppedConsumerRetentionPeriod, migrated.StoppedConsumerRetentionPeriod)
+
+	// the params that existed before are kept
+	require.Equal(t, params.BlocksPerEpoch, migrated.BlocksPerEpoch)
+	require.Equal(t, params.SlashMeterReplenishFraction, migrated.SlashMeterReplenishFraction)
+	require.Equal(t, params.ConsumerRewardDenomRegistrationFee, migrated.ConsumerRewardDenomRegistrationFee)
+}
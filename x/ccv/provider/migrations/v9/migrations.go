@@ -0,0 +1,33 @@
+package v9
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// MigrateConsumerAddrsToPruneTimeIndex populates the cross-chain time index of the consumer
+// addresses to prune from the per-chain ConsumerAddrsToPruneV2 entries.
+func MigrateConsumerAddrsToPruneTimeIndex(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.ConsumerAddrsToPruneV2BytePrefix})
+	defer iterator.Close()
+
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		chainID, pruneTs, err := providertypes.ParseChainIdAndTsKey(providertypes.ConsumerAddrsToPruneV2BytePrefix, iterator.Key())
+		if err != nil {
+			return err
+		}
+		indexKeys = append(indexKeys, providertypes.ConsumerAddrsToPruneTimeIndexKey(pruneTs, chainID))
+	}
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+
+	ctx.Logger().Info("consumer addresses to prune time index populated", "entries", len(indexKeys))
+	return nil
+}
@@ -0,0 +1,34 @@
+package v9
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestMigrateConsumerAddrsToPruneTimeIndex(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+	k, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	ts1 := time.Now().UTC()
+	ts2 := ts1.Add(time.Hour)
+	k.AppendConsumerAddrsToPrune(ctx, "chain-a", ts1, providertypes.NewConsumerConsAddress([]byte("consumerAddr1")))
+	k.AppendConsumerAddrsToPrune(ctx, "chain-b", ts2, providertypes.NewConsumerConsAddress([]byte("consumerAddr2")))
+
+	// remove the index entries to mimic the state before the migration
+	store := ctx.KVStore(inMemParams.StoreKey)
+	store.Delete(providertypes.ConsumerAddrsToPruneTimeIndexKey(ts1, "chain-a"))
+	store.Delete(providertypes.ConsumerAddrsToPruneTimeIndexKey(ts2, "chain-b"))
+	require.Empty(t, k.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+
+	err := MigrateConsumerAddrsToPruneTimeIndex(ctx, inMemParams.StoreKey)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"chain-a"}, k.GetConsumerChainsWithAddrsToPrune(ctx, ts1))
+	require.Equal(t, []string{"chain-a", "chain-b"}, k.GetConsumerChainsWithAddrsToPrune(ctx, ts2))
+}
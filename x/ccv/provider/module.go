@@ -21,13 +21,16 @@ import (
 	"github.com/allinbits/interchain-security/x/ccv/provider/client/cli"
 	"github.com/allinbits/interchain-security/x/ccv/provider/keeper"
 	"github.com/allinbits/interchain-security/x/ccv/provider/migrations"
+	"github.com/allinbits/interchain-security/x/ccv/provider/simulation"
 	providertypes "github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
 )
 
 var (
 	_ module.AppModule           = (*AppModule)(nil)
 	_ module.AppModuleBasic      = (*AppModuleBasic)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
+	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ module.HasGenesis          = (*AppModule)(nil)
 	_ module.HasName             = (*AppModule)(nil)
 	_ module.HasConsensusVersion = (*AppModule)(nil)
@@ -103,20 +106,32 @@ type AppModule struct {
 	keeper     *keeper.Keeper
 	paramSpace paramtypes.Subspace
 	storeKey   storetypes.StoreKey
+
+	// used for simulations only
+	accountKeeper ccvtypes.AccountKeeper
+	bankKeeper    ccvtypes.BankKeeper
 }
 
 // NewAppModule creates a new provider module
-func NewAppModule(k *keeper.Keeper, paramSpace paramtypes.Subspace, storeKey storetypes.StoreKey) AppModule {
+func NewAppModule(
+	k *keeper.Keeper,
+	paramSpace paramtypes.Subspace,
+	storeKey storetypes.StoreKey,
+	accountKeeper ccvtypes.AccountKeeper,
+	bankKeeper ccvtypes.BankKeeper,
+) AppModule {
 	return AppModule{
-		keeper:     k,
-		paramSpace: paramSpace,
-		storeKey:   storeKey,
+		keeper:        k,
+		paramSpace:    paramSpace,
+		storeKey:      storeKey,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 	}
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, *am.keeper)
 }
 
 // RegisterServices registers module services.
@@ -124,7 +139,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	providertypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	providertypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-	_ = migrations.NewMigrator(*am.keeper, am.paramSpace, am.storeKey)
+	migrator := migrations.NewMigrator(*am.keeper, am.paramSpace, am.storeKey)
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 8, migrator.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s", providertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 9, migrator.Migrate9to10); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s", providertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 10, migrator.Migrate10to11); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s", providertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the provider module. It returns no validator updates.
@@ -145,7 +169,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 8 }
+func (AppModule) ConsensusVersion() uint64 { return 11 }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -177,15 +201,22 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the transfer module.
+// GenerateGenesisState creates a randomized GenState of the provider module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(am.keeper)
 }
 
 // RegisterStoreDecoder registers a decoder for provider module's types
 func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[providertypes.StoreKey] = simulation.NewDecodeStore()
 }
 
 // WeightedOperations returns the all the provider module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
 }
@@ -79,7 +79,7 @@ func TestInitGenesis(t *testing.T) {
 		keeperParams := testkeeper.NewInMemKeeperParams(t)
 		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
 
-		appModule := provider.NewAppModule(&providerKeeper, *keeperParams.ParamsSubspace, keeperParams.StoreKey)
+		appModule := provider.NewAppModule(&providerKeeper, *keeperParams.ParamsSubspace, keeperParams.StoreKey, nil, nil)
 		genState := types.NewGenesisState(
 			providerKeeper.GetValidatorSetUpdateId(ctx),
 			nil,
@@ -0,0 +1,135 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/gogoproto/proto"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding provider type. The values of the key-only indexes
+// and of the deprecated prefixes are printed as raw bytes.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case types.ParametersByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &types.Params{}, &types.Params{})
+
+		case types.PortByteKey,
+			types.ChainToChannelBytePrefix,
+			types.ChannelToChainBytePrefix,
+			types.ChainToClientBytePrefix,
+			types.ProposedConsumerChainByteKey:
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case types.ValidatorSetUpdateIdByteKey,
+			types.ValsetUpdateBlockHeightBytePrefix,
+			types.InitChainHeightBytePrefix,
+			types.EquivocationEvidenceMinHeightBytePrefix,
+			types.MinimumPowerInTopNBytePrefix,
+			types.KeyAssignmentNonceBytePrefix:
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case types.TopNBytePrefix,
+			types.ValidatorsPowerCapPrefix,
+			types.ValidatorSetCapPrefix:
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint32(kvA.Value), binary.BigEndian.Uint32(kvB.Value))
+
+		case types.SlashMeterByteKey:
+			var meterA, meterB math.Int
+			mustUnmarshal(meterA.Unmarshal(kvA.Value))
+			mustUnmarshal(meterB.Unmarshal(kvB.Value))
+			return fmt.Sprintf("%v\n%v", meterA, meterB)
+
+		case types.SlashMeterReplenishTimeCandidateByteKey:
+			timeA, err := sdk.ParseTimeBytes(kvA.Value)
+			mustUnmarshal(err)
+			timeB, err := sdk.ParseTimeBytes(kvB.Value)
+			mustUnmarshal(err)
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
+		case types.PendingCAPBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerAdditionProposal{}, &types.ConsumerAdditionProposal{})
+
+		case types.PendingCRPBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerRemovalProposal{}, &types.ConsumerRemovalProposal{})
+
+		case types.ConsumerGenesisBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &ccvtypes.ConsumerGenesisState{}, &ccvtypes.ConsumerGenesisState{})
+
+		case types.SlashAcksBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.SlashAcks{}, &types.SlashAcks{})
+
+		case types.PendingVSCsBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ValidatorSetChangePackets{}, &types.ValidatorSetChangePackets{})
+
+		case types.ConsumerValidatorsBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &tmprotocrypto.PublicKey{}, &tmprotocrypto.PublicKey{})
+
+		case types.ValidatorsByConsumerAddrBytePrefix:
+			return fmt.Sprintf("%v\n%v", sdk.ConsAddress(kvA.Value), sdk.ConsAddress(kvB.Value))
+
+		case types.ConsumerAddrsToPruneV2BytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.AddressList{}, &types.AddressList{})
+
+		case types.ConsumerValidatorBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerValidator{}, &types.ConsumerValidator{})
+
+		case types.ConsumerRewardsAllocationBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerRewardsAllocation{}, &types.ConsumerRewardsAllocation{})
+
+		case types.ConsumerMetadataBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerMetadata{}, &types.ConsumerMetadata{})
+
+		case types.PausedConsumerBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerPause{}, &types.ConsumerPause{})
+
+		case types.StoppedConsumerBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.StoppedConsumer{}, &types.StoppedConsumer{})
+
+		case types.ConsumerKeyRotationBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerKeyRotation{}, &types.ConsumerKeyRotation{})
+		case types.KeyAssignmentHistoryBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.KeyAssignmentRecord{}, &types.KeyAssignmentRecord{})
+		case types.OptInPolicyBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.OptInPolicy{}, &types.OptInPolicy{})
+		case types.OptInRecordBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.OptInRecord{}, &types.OptInRecord{})
+		case types.ReadinessSignalBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ReadinessSignal{}, &types.ReadinessSignal{})
+		case types.ConsumerEpochParamsBytePrefix:
+			return decodeProto(kvA.Value, kvB.Value, &types.ConsumerEpochParams{}, &types.ConsumerEpochParams{})
+		case types.EpochStateByteKey:
+			return decodeProto(kvA.Value, kvB.Value, &types.EpochState{}, &types.EpochState{})
+
+		case types.ConsumerOwnerBytePrefix:
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		default:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
+}
+
+// decodeProto unmarshals bzA into a and bzB into b, and prints them
+func decodeProto(bzA, bzB []byte, a, b proto.Message) string {
+	mustUnmarshal(proto.Unmarshal(bzA, a))
+	mustUnmarshal(proto.Unmarshal(bzB, b))
+	return fmt.Sprintf("%v\n%v", a, b)
+}
+
+func mustUnmarshal(err error) {
+	if err != nil {
+		panic(fmt.Errorf("failed to unmarshal provider store value: %w", err))
+	}
+}
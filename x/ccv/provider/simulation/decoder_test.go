@@ -0,0 +1,127 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/gogoproto/proto"
+
+	cryptotestutil "github.com/allinbits/interchain-security/testutil/crypto"
+	"github.com/allinbits/interchain-security/x/ccv/provider/simulation"
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestDecodeProviderStore(t *testing.T) {
+	dec := simulation.NewDecodeStore()
+
+	chainID := "consumer"
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerKey := identity.TMProtoCryptoPublicKey()
+
+	params := types.DefaultParams()
+	vscPackets := types.ValidatorSetChangePackets{
+		List: []ccvtypes.ValidatorSetChangePacketData{ccvtypes.NewValidatorSetChangePacketData(nil, 1, nil)},
+	}
+	consumerValidator := types.ConsumerValidator{
+		ProviderConsAddr:  identity.SDKValConsAddress(),
+		Power:             100,
+		ConsumerPublicKey: &consumerKey,
+	}
+	metadata := types.ConsumerMetadata{Name: "Consumer", Website: "https://consumer.zone"}
+	pause := types.ConsumerPause{Emergency: true, Reason: "exploit", PauseHeight: 10}
+	stopped := types.StoppedConsumer{
+		LaunchParams: types.ConsumerAdditionProposal{ChainId: chainID, Top_N: 95},
+		ClientId:     "07-tendermint-0",
+	}
+	rotation := types.ConsumerKeyRotation{ConsumerKey: consumerKey, ApplyHeight: 600}
+	record := types.KeyAssignmentRecord{ConsumerKey: consumerKey, AssignedHeight: 10, EffectiveVscId: 2}
+	policy := types.OptInPolicy{MinCommitmentEpochs: 4, OptInCooldownEpochs: 2}
+	optInRecord := types.OptInRecord{OptInHeight: 12}
+	signal := types.ReadinessSignal{GenesisHash: []byte("gen_hash"), BinaryHash: []byte("bin_hash"), Height: 7}
+	epochParams := types.ConsumerEpochParams{BlocksPerEpoch: 100}
+	epochState := types.EpochState{Number: 3, StartHeight: 100, StartTime: time.Unix(21600, 0).UTC()}
+
+	mustMarshal := func(msg proto.Message) []byte {
+		bz, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		return bz
+	}
+
+	vscID := make([]byte, 8)
+	binary.BigEndian.PutUint64(vscID, 7)
+	topN := make([]byte, 4)
+	binary.BigEndian.PutUint32(topN, 95)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParametersKey(), Value: mustMarshal(&params)},
+			{Key: types.ChainToClientKey(chainID), Value: []byte("07-tendermint-0")},
+			{Key: types.ValidatorSetUpdateIdKey(), Value: vscID},
+			{Key: types.TopNKey(chainID), Value: topN},
+			{Key: types.PendingVSCsKey(chainID), Value: mustMarshal(&vscPackets)},
+			{Key: types.ConsumerValidatorsKey(chainID, identity.ProviderConsAddress()), Value: mustMarshal(&consumerKey)},
+			{Key: types.ValidatorsByConsumerAddrKey(chainID, identity.ConsumerConsAddress()), Value: identity.SDKValConsAddress()},
+			{Key: types.ConsumerValidatorKey(chainID, identity.SDKValConsAddress()), Value: mustMarshal(&consumerValidator)},
+			{Key: types.ConsumerMetadataKey(chainID), Value: mustMarshal(&metadata)},
+			{Key: types.ConsumerOwnerKey(chainID), Value: identity.SDKValOpAddress()},
+			{Key: types.PausedConsumerKey(chainID), Value: mustMarshal(&pause)},
+			{Key: types.StoppedConsumerKey(chainID), Value: mustMarshal(&stopped)},
+			{Key: types.KeyAssignmentNonceKey(chainID, identity.ProviderConsAddress()), Value: vscID},
+			{Key: types.ConsumerKeyRotationKey(chainID, identity.ProviderConsAddress()), Value: mustMarshal(&rotation)},
+			{Key: types.KeyAssignmentHistoryKey(chainID, identity.ProviderConsAddress(), 0), Value: mustMarshal(&record)},
+			{Key: types.OptInPolicyKey(chainID), Value: mustMarshal(&policy)},
+			{Key: types.OptInRecordKey(chainID, identity.ProviderConsAddress()), Value: mustMarshal(&optInRecord)},
+			{Key: types.ReadinessSignalKey(chainID, identity.ProviderConsAddress()), Value: mustMarshal(&signal)},
+			{Key: types.ConsumerEpochParamsKey(chainID), Value: mustMarshal(&epochParams)},
+			{Key: types.EpochStateKey(), Value: mustMarshal(&epochState)},
+			{Key: []byte{0xfe}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", &params, &params)},
+		{"ChainToClient", "07-tendermint-0\n07-tendermint-0"},
+		{"ValidatorSetUpdateId", "7\n7"},
+		{"TopN", "95\n95"},
+		{"PendingVSCs", fmt.Sprintf("%v\n%v", &vscPackets, &vscPackets)},
+		{"ConsumerValidators", fmt.Sprintf("%v\n%v", &consumerKey, &consumerKey)},
+		{"ValidatorsByConsumerAddr", fmt.Sprintf("%v\n%v", identity.SDKValConsAddress(), identity.SDKValConsAddress())},
+		{"ConsumerValidator", fmt.Sprintf("%v\n%v", &consumerValidator, &consumerValidator)},
+		{"ConsumerMetadata", fmt.Sprintf("%v\n%v", &metadata, &metadata)},
+		{"ConsumerOwner", fmt.Sprintf("%v\n%v", sdk.AccAddress(identity.SDKValOpAddress()), sdk.AccAddress(identity.SDKValOpAddress()))},
+		{"PausedConsumer", fmt.Sprintf("%v\n%v", &pause, &pause)},
+		{"StoppedConsumer", fmt.Sprintf("%v\n%v", &stopped, &stopped)},
+		{"KeyAssignmentNonce", "7\n7"},
+		{"ConsumerKeyRotation", fmt.Sprintf("%v\n%v", &rotation, &rotation)},
+		{"KeyAssignmentHistory", fmt.Sprintf("%v\n%v", &record, &record)},
+		{"OptInPolicy", fmt.Sprintf("%v\n%v", &policy, &policy)},
+		{"OptInRecord", fmt.Sprintf("%v\n%v", &optInRecord, &optInRecord)},
+		{"ReadinessSignal", fmt.Sprintf("%v\n%v", &signal, &signal)},
+		{"ConsumerEpochParams", fmt.Sprintf("%v\n%v", &epochParams, &epochParams)},
+		{"EpochState", fmt.Sprintf("%v\n%v", &epochState, &epochState)},
+		{"other", "99\n99"},
+	}
+	require.Len(t, tests, len(kvPairs.Pairs))
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]))
+		})
+	}
+
+	// values that cannot be unmarshalled make the decoder panic
+	require.Panics(t, func() {
+		pair := kv.Pair{Key: types.PendingVSCsKey(chainID), Value: []byte{0xff}}
+		dec(pair, pair)
+	})
+}
@@ -0,0 +1,193 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// Simulation parameter constants
+const (
+	TrustingPeriodFraction                = "trusting_period_fraction"
+	CcvTimeoutPeriod                      = "ccv_timeout_period"
+	SlashMeterReplenishPeriod             = "slash_meter_replenish_period"
+	SlashMeterReplenishFraction           = "slash_meter_replenish_fraction"
+	ConsumerRewardDenomRegistrationFee    = "consumer_reward_denom_registration_fee"
+	BlocksPerEpoch                        = "blocks_per_epoch"
+	NumberOfEpochsToStartReceivingRewards = "number_of_epochs_to_start_receiving_rewards"
+	MaxVscPacketSize                      = "max_vsc_packet_size"
+	GenesisConsumerChains                 = "genesis_consumer_chains"
+)
+
+// GenTrustingPeriodFraction returns a randomized TrustingPeriodFraction between 0.25 and 0.75
+func GenTrustingPeriodFraction(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 25, 76)), 2).String()
+}
+
+// GenCcvTimeoutPeriod returns a randomized CcvTimeoutPeriod between one and four weeks
+func GenCcvTimeoutPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 7, 29)) * 24 * time.Hour
+}
+
+// GenSlashMeterReplenishPeriod returns a randomized SlashMeterReplenishPeriod between one minute and two hours
+func GenSlashMeterReplenishPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 121)) * time.Minute
+}
+
+// GenSlashMeterReplenishFraction returns a randomized SlashMeterReplenishFraction between 0.01 and 0.20
+func GenSlashMeterReplenishFraction(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 21)), 2).String()
+}
+
+// GenConsumerRewardDenomRegistrationFee returns a randomized ConsumerRewardDenomRegistrationFee
+func GenConsumerRewardDenomRegistrationFee(r *rand.Rand, bondDenom string) sdk.Coin {
+	return sdk.NewInt64Coin(bondDenom, int64(simtypes.RandIntBetween(r, 1, 1000)))
+}
+
+// GenBlocksPerEpoch returns a randomized BlocksPerEpoch. Epochs are kept short,
+// so that the validator set updates are sent often during a simulation.
+func GenBlocksPerEpoch(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 11))
+}
+
+// GenNumberOfEpochsToStartReceivingRewards returns a randomized NumberOfEpochsToStartReceivingRewards
+func GenNumberOfEpochsToStartReceivingRewards(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 6))
+}
+
+// GenMaxVscPacketSize returns a randomized MaxVscPacketSize. With a 10% chance,
+// the size of the VSC packets is not bounded.
+func GenMaxVscPacketSize(r *rand.Rand) int64 {
+	if r.Intn(10) == 0 {
+		return 0
+	}
+	return int64(simtypes.RandIntBetween(r, 1, 101))
+}
+
+// GenGenesisConsumerChains returns a randomized number of consumer chains
+// that are pending to be launched at genesis. There is always at least one,
+// since consumer addition proposals rarely pass within a simulation.
+func GenGenesisConsumerChains(r *rand.Rand) int {
+	return simtypes.RandIntBetween(r, 1, 5)
+}
+
+// GenSpawnTimeOffset returns a randomized delay between one and 48 hours before a consumer chain
+// is launched. Since a simulated block lasts more than an hour, validators have a few blocks to
+// assign keys and opt in before the launch.
+func GenSpawnTimeOffset(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 49)) * time.Hour
+}
+
+// RandomConsumerAdditionProposal returns a random consumer addition proposal
+// for chainID that is due to be launched at spawnTime. The revision number of
+// the initial height matches the one of chainID, as otherwise the consumer
+// client cannot be created.
+func RandomConsumerAdditionProposal(r *rand.Rand, chainID string, spawnTime time.Time) types.ConsumerAdditionProposal {
+	return types.ConsumerAdditionProposal{
+		Title:                             simtypes.RandStringOfLength(r, 10),
+		Description:                       simtypes.RandStringOfLength(r, 50),
+		ChainId:                           chainID,
+		InitialHeight:                     clienttypes.NewHeight(clienttypes.ParseChainID(chainID), uint64(simtypes.RandIntBetween(r, 1, 100))),
+		GenesisHash:                       []byte(simtypes.RandStringOfLength(r, 32)),
+		BinaryHash:                        []byte(simtypes.RandStringOfLength(r, 32)),
+		SpawnTime:                         spawnTime,
+		UnbondingPeriod:                   time.Duration(simtypes.RandIntBetween(r, 7, 22)) * 24 * time.Hour,
+		CcvTimeoutPeriod:                  GenCcvTimeoutPeriod(r),
+		TransferTimeoutPeriod:             time.Duration(simtypes.RandIntBetween(r, 1, 25)) * time.Hour,
+		ConsumerRedistributionFraction:    math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2).String(),
+		BlocksPerDistributionTransmission: int64(simtypes.RandIntBetween(r, 1, 1001)),
+		HistoricalEntries:                 int64(simtypes.RandIntBetween(r, 1, 10001)),
+		Top_N:                             randomTopN(r),
+		ValidatorsPowerCap:                uint32(r.Intn(101)),
+		ValidatorSetCap:                   uint32(r.Intn(20)),
+	}
+}
+
+// randomTopN returns zero, i.e., an Opt In chain, with a 50% chance,
+// and otherwise a Top N chain with N in [50, 100]
+func randomTopN(r *rand.Rand) uint32 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint32(simtypes.RandIntBetween(r, 50, 101))
+}
+
+// RandomizedGenState generates a random GenesisState for the provider module
+func RandomizedGenState(simState *module.SimulationState) {
+	var trustingPeriodFraction string
+	simState.AppParams.GetOrGenerate(TrustingPeriodFraction, &trustingPeriodFraction, simState.Rand,
+		func(r *rand.Rand) { trustingPeriodFraction = GenTrustingPeriodFraction(r) })
+
+	var ccvTimeoutPeriod time.Duration
+	simState.AppParams.GetOrGenerate(CcvTimeoutPeriod, &ccvTimeoutPeriod, simState.Rand,
+		func(r *rand.Rand) { ccvTimeoutPeriod = GenCcvTimeoutPeriod(r) })
+
+	var slashMeterReplenishPeriod time.Duration
+	simState.AppParams.GetOrGenerate(SlashMeterReplenishPeriod, &slashMeterReplenishPeriod, simState.Rand,
+		func(r *rand.Rand) { slashMeterReplenishPeriod = GenSlashMeterReplenishPeriod(r) })
+
+	var slashMeterReplenishFraction string
+	simState.AppParams.GetOrGenerate(SlashMeterReplenishFraction, &slashMeterReplenishFraction, simState.Rand,
+		func(r *rand.Rand) { slashMeterReplenishFraction = GenSlashMeterReplenishFraction(r) })
+
+	var registrationFee sdk.Coin
+	simState.AppParams.GetOrGenerate(ConsumerRewardDenomRegistrationFee, &registrationFee, simState.Rand,
+		func(r *rand.Rand) { registrationFee = GenConsumerRewardDenomRegistrationFee(r, simState.BondDenom) })
+
+	var blocksPerEpoch int64
+	simState.AppParams.GetOrGenerate(BlocksPerEpoch, &blocksPerEpoch, simState.Rand,
+		func(r *rand.Rand) { blocksPerEpoch = GenBlocksPerEpoch(r) })
+
+	var numberOfEpochsToStartReceivingRewards int64
+	simState.AppParams.GetOrGenerate(NumberOfEpochsToStartReceivingRewards, &numberOfEpochsToStartReceivingRewards, simState.Rand,
+		func(r *rand.Rand) {
+			numberOfEpochsToStartReceivingRewards = GenNumberOfEpochsToStartReceivingRewards(r)
+		})
+
+	var maxVscPacketSize int64
+	simState.AppParams.GetOrGenerate(MaxVscPacketSize, &maxVscPacketSize, simState.Rand,
+		func(r *rand.Rand) { maxVscPacketSize = GenMaxVscPacketSize(r) })
+
+	var genesisConsumerChains int
+	simState.AppParams.GetOrGenerate(GenesisConsumerChains, &genesisConsumerChains, simState.Rand,
+		func(r *rand.Rand) { genesisConsumerChains = GenGenesisConsumerChains(r) })
+
+	params := types.DefaultParams()
+	params.TrustingPeriodFraction = trustingPeriodFraction
+	params.CcvTimeoutPeriod = ccvTimeoutPeriod
+	params.SlashMeterReplenishPeriod = slashMeterReplenishPeriod
+	params.SlashMeterReplenishFraction = slashMeterReplenishFraction
+	params.ConsumerRewardDenomRegistrationFee = registrationFee
+	params.BlocksPerEpoch = blocksPerEpoch
+	params.NumberOfEpochsToStartReceivingRewards = numberOfEpochsToStartReceivingRewards
+	params.MaxVscPacketSize = maxVscPacketSize
+
+	providerGenesis := types.DefaultGenesisState()
+	providerGenesis.Params = params
+
+	// consumer chains that are launched during the first blocks of the simulation,
+	// so that the key assignment and opt-in operations have chains to operate on
+	for i := 0; i < genesisConsumerChains; i++ {
+		spawnTime := simState.GenTimestamp.Add(GenSpawnTimeOffset(simState.Rand))
+		providerGenesis.ConsumerAdditionProposals = append(providerGenesis.ConsumerAdditionProposals,
+			RandomConsumerAdditionProposal(simState.Rand, fmt.Sprintf("consumer-%d", i), spawnTime))
+	}
+
+	bz, err := json.MarshalIndent(&providerGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated provider parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(providerGenesis)
+}
@@ -0,0 +1,390 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgAssignConsumerKey int = 100
+	DefaultWeightMsgOptIn             int = 50
+	DefaultWeightMsgOptOut            int = 30
+
+	OpWeightMsgAssignConsumerKey = "op_weight_msg_assign_consumer_key"
+	OpWeightMsgOptIn             = "op_weight_msg_opt_in"
+	OpWeightMsgOptOut            = "op_weight_msg_opt_out"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak ccvtypes.AccountKeeper,
+	bk ccvtypes.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgAssignConsumerKey int
+		weightMsgOptIn             int
+		weightMsgOptOut            int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgAssignConsumerKey, &weightMsgAssignConsumerKey, nil, func(_ *rand.Rand) {
+		weightMsgAssignConsumerKey = DefaultWeightMsgAssignConsumerKey
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgOptIn, &weightMsgOptIn, nil, func(_ *rand.Rand) {
+		weightMsgOptIn = DefaultWeightMsgOptIn
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgOptOut, &weightMsgOptOut, nil, func(_ *rand.Rand) {
+		weightMsgOptOut = DefaultWeightMsgOptOut
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgAssignConsumerKey,
+			SimulateMsgAssignConsumerKey(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOptIn,
+			SimulateMsgOptIn(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOptOut,
+			SimulateMsgOptOut(txGen, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAssignConsumerKey generates a MsgAssignConsumerKey with random values.
+// Besides fresh consumer keys, the operation also tries to assign keys that are
+// already in use on the consumer chain and the validator's own provider key, and
+// checks that the outcome of the message matches the key assignment rules.
+// Since the private keys of these keys are unknown to the simulation, their
+// possession cannot be proven and the assignment must be rejected.
+func SimulateMsgAssignConsumerKey(txGen client.TxConfig, ak ccvtypes.AccountKeeper, bk ccvtypes.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAssignConsumerKey{})
+
+		consumerChainID, ok := randomConsumerChain(r, k.GetAllRegisteredAndProposedChainIDs(ctx))
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no consumer chains"), nil, nil
+		}
+		validator, simAccount, ok := randomValidatorAccount(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to find validator account"), nil, nil
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator consensus key"), nil, err
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+
+		var (
+			consumerKey tmprotocrypto.PublicKey
+			expectedErr error
+		)
+		// the key used to sign the consumer key possession payload
+		signingKey := randomConsumerPrivKey(r)
+		inUseKeys := k.GetAllValidatorConsumerPubKeys(ctx, &consumerChainID)
+		switch n := r.Intn(10); {
+		case n == 0 && len(inUseKeys) > 0:
+			// a key that is currently assigned on the consumer chain, either by this validator or by another one
+			consumerKey = *inUseKeys[r.Intn(len(inUseKeys))].ConsumerKey
+			expectedErr = types.ErrInvalidConsumerKeyPossession
+		case n == 1:
+			// the validator's provider key
+			consumerKey, err = validator.CmtConsPublicKey()
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator consensus key"), nil, err
+			}
+			expectedErr = types.ErrInvalidConsumerKeyPossession
+		default:
+			consumerKey = consumerKeyFromPrivKey(signingKey)
+		}
+
+		ed25519Key, ok := consumerKey.Sum.(*tmprotocrypto.PublicKey_Ed25519)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "consumer key is not an ed25519 key"), nil, nil
+		}
+		providerValAddr := sdk.ValAddress(simAccount.Address).String()
+		nonce := nextKeyAssignmentNonce(ctx, k, consumerChainID, providerAddr)
+		signature, err := signingKey.Sign(types.ConsumerKeyPossessionBytes(consumerChainID, providerValAddr, nonce))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign consumer key possession"), nil, err
+		}
+		msg, err := types.NewMsgAssignConsumerKey(consumerChainID, sdk.ValAddress(simAccount.Address),
+			consumerKeyJSON(ed25519Key.Ed25519), simAccount.Address.String(), signature, nonce)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create msg"), nil, err
+		}
+
+		opMsg, fOps, err := deliverTx(r, app, ctx, txGen, ak, bk, msg, simAccount, expectedErr)
+		if err != nil || expectedErr != nil {
+			return opMsg, fOps, err
+		}
+
+		if assignedKey, found := k.GetValidatorConsumerPubKey(ctx, consumerChainID, providerAddr); !found || !assignedKey.Equal(consumerKey) {
+			return opMsg, fOps, fmt.Errorf("consumer key of validator %s on chain %s was not assigned",
+				providerAddr.String(), consumerChainID)
+		}
+		return opMsg, fOps, nil
+	}
+}
+
+// SimulateMsgOptIn generates a MsgOptIn with random values. With a 50% chance,
+// the validator also assigns a fresh consumer key when opting in.
+func SimulateMsgOptIn(txGen client.TxConfig, ak ccvtypes.AccountKeeper, bk ccvtypes.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOptIn{})
+
+		consumerChainID, ok := randomConsumerChain(r, k.GetAllRegisteredAndProposedChainIDs(ctx))
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no consumer chains"), nil, nil
+		}
+		validator, simAccount, ok := randomValidatorAccount(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to find validator account"), nil, nil
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator consensus key"), nil, err
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+
+		msg := &types.MsgOptIn{
+			ChainId:      consumerChainID,
+			ProviderAddr: sdk.ValAddress(simAccount.Address).String(),
+			Signer:       simAccount.Address.String(),
+		}
+		if r.Intn(2) == 0 {
+			consumerPrivKey := randomConsumerPrivKey(r)
+			msg.ConsumerKey = consumerKeyJSON(consumerPrivKey.PubKey().Bytes())
+			msg.Nonce = nextKeyAssignmentNonce(ctx, k, consumerChainID, providerAddr)
+			msg.ConsumerKeySignature, err = consumerPrivKey.Sign(
+				types.ConsumerKeyPossessionBytes(consumerChainID, msg.ProviderAddr, msg.Nonce))
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to sign consumer key possession"), nil, err
+			}
+		}
+
+		opMsg, fOps, err := deliverTx(r, app, ctx, txGen, ak, bk, msg, simAccount, nil)
+		if err != nil {
+			return opMsg, fOps, err
+		}
+
+		if !k.IsOptedIn(ctx, consumerChainID, providerAddr) {
+			return opMsg, fOps, fmt.Errorf("validator %s is not opted in on chain %s",
+				providerAddr.String(), consumerChainID)
+		}
+		return opMsg, fOps, nil
+	}
+}
+
+// SimulateMsgOptOut generates a MsgOptOut for a random validator that is opted in on
+// a running consumer chain, and checks that validators in the Top N cannot opt out
+func SimulateMsgOptOut(txGen client.TxConfig, ak ccvtypes.AccountKeeper, bk ccvtypes.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOptOut{})
+
+		consumerChainID, ok := randomConsumerChain(r, k.GetAllRegisteredConsumerChainIDs(ctx))
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no running consumer chains"), nil, nil
+		}
+
+		validators, err := k.GetLastBondedValidators(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get bonded validators"), nil, err
+		}
+		var optedIn []stakingtypes.Validator
+		for _, val := range validators {
+			consAddr, err := val.GetConsAddr()
+			if err != nil {
+				continue
+			}
+			// the power of jailed validators is not updated until the end of the block
+			if !val.IsJailed() && k.IsOptedIn(ctx, consumerChainID, types.NewProviderConsAddress(consAddr)) {
+				optedIn = append(optedIn, val)
+			}
+		}
+		if len(optedIn) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no opted in validators"), nil, nil
+		}
+		validator := optedIn[r.Intn(len(optedIn))]
+
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator operator address"), nil, err
+		}
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to find validator account"), nil, nil
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid validator consensus key"), nil, err
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+
+		var expectedErr error
+		if topN, found := k.GetTopN(ctx, consumerChainID); found && topN > 0 {
+			minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerChainID)
+			if !found {
+				expectedErr = types.ErrUnknownConsumerChainId
+			} else if k.GetEffectiveValPower(ctx, providerAddr).Int64() >= minPowerInTopN {
+				expectedErr = types.ErrCannotOptOutFromTopN
+			}
+		}
+
+		msg := &types.MsgOptOut{
+			ChainId:      consumerChainID,
+			ProviderAddr: valAddr.String(),
+			Signer:       simAccount.Address.String(),
+		}
+
+		opMsg, fOps, err := deliverTx(r, app, ctx, txGen, ak, bk, msg, simAccount, expectedErr)
+		if err != nil || expectedErr != nil {
+			return opMsg, fOps, err
+		}
+
+		if k.IsOptedIn(ctx, consumerChainID, providerAddr) {
+			return opMsg, fOps, fmt.Errorf("validator %s is still opted in on chain %s",
+				providerAddr.String(), consumerChainID)
+		}
+		return opMsg, fOps, nil
+	}
+}
+
+// deliverTx signs msg with the key of simAccount and delivers it. If expectedErr is nil,
+// the delivery must succeed, otherwise it must fail with expectedErr.
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, txGen client.TxConfig,
+	ak ccvtypes.AccountKeeper, bk ccvtypes.BankKeeper, msg sdk.Msg, simAccount simtypes.Account, expectedErr error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         txGen,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	}
+	if expectedErr == nil {
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	account := ak.GetAccount(ctx, simAccount.Address)
+	fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		ctx.ChainID(),
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if !errorsmod.IsOf(err, expectedErr) {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unexpected result"), nil,
+			fmt.Errorf("expected %s to fail with %q, got: %v", msgType, expectedErr, err)
+	}
+
+	return simtypes.NewOperationMsg(msg, false, expectedErr.Error()), nil, nil
+}
+
+// randomConsumerChain returns a random chain ID out of chainIDs
+func randomConsumerChain(r *rand.Rand, chainIDs []string) (string, bool) {
+	if len(chainIDs) == 0 {
+		return "", false
+	}
+	return chainIDs[r.Intn(len(chainIDs))], true
+}
+
+// randomValidatorAccount returns a random bonded validator together
+// with the simulation account of its operator
+func randomValidatorAccount(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account) (stakingtypes.Validator, simtypes.Account, bool) {
+	validators, err := k.GetLastBondedValidators(ctx)
+	if err != nil || len(validators) == 0 {
+		return stakingtypes.Validator{}, simtypes.Account{}, false
+	}
+	validator := validators[r.Intn(len(validators))]
+
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return stakingtypes.Validator{}, simtypes.Account{}, false
+	}
+	simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+	if !found {
+		return stakingtypes.Validator{}, simtypes.Account{}, false
+	}
+	return validator, simAccount, true
+}
+
+// randomConsumerPrivKey returns a fresh ed25519 consumer private key
+func randomConsumerPrivKey(r *rand.Rand) *ed25519.PrivKey {
+	seed := make([]byte, ed25519.SeedSize)
+	_, _ = r.Read(seed)
+	return ed25519.GenPrivKeyFromSecret(seed)
+}
+
+// consumerKeyFromPrivKey returns the consumer key of an ed25519 private key
+func consumerKeyFromPrivKey(privKey *ed25519.PrivKey) tmprotocrypto.PublicKey {
+	return tmprotocrypto.PublicKey{
+		Sum: &tmprotocrypto.PublicKey_Ed25519{
+			Ed25519: privKey.PubKey().Bytes(),
+		},
+	}
+}
+
+// nextKeyAssignmentNonce returns the nonce to prove the possession of a consumer key
+// by the given validator on the given consumer chain
+func nextKeyAssignmentNonce(ctx sdk.Context, k *keeper.Keeper, chainID string, providerAddr types.ProviderConsAddress) uint64 {
+	nonce, _ := k.GetKeyAssignmentNonce(ctx, chainID, providerAddr)
+	return nonce + 1
+}
+
+// consumerKeyJSON returns the JSON encoding of an ed25519 consumer key,
+// as expected by MsgAssignConsumerKey and MsgOptIn
+func consumerKeyJSON(pubKey []byte) string {
+	return fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`, base64.StdEncoding.EncodeToString(pubKey))
+}
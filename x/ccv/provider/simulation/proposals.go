@@ -0,0 +1,137 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgConsumerAddition     int = 20
+	DefaultWeightMsgConsumerRemoval      int = 5
+	DefaultWeightMsgConsumerModification int = 10
+	DefaultWeightMsgChangeRewardDenoms   int = 10
+
+	OpWeightMsgConsumerAddition     = "op_weight_msg_consumer_addition"
+	OpWeightMsgConsumerRemoval      = "op_weight_msg_consumer_removal"
+	OpWeightMsgConsumerModification = "op_weight_msg_consumer_modification"
+	OpWeightMsgChangeRewardDenoms   = "op_weight_msg_change_reward_denoms"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k *keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgConsumerAddition,
+			DefaultWeightMsgConsumerAddition,
+			SimulateMsgConsumerAddition(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgConsumerRemoval,
+			DefaultWeightMsgConsumerRemoval,
+			SimulateMsgConsumerRemoval(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgConsumerModification,
+			DefaultWeightMsgConsumerModification,
+			SimulateMsgConsumerModification(k),
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgChangeRewardDenoms,
+			DefaultWeightMsgChangeRewardDenoms,
+			SimulateMsgChangeRewardDenoms(k),
+		),
+	}
+}
+
+// SimulateMsgConsumerAddition returns a random MsgConsumerAddition for a new consumer chain
+func SimulateMsgConsumerAddition(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		chainID := fmt.Sprintf("consumer-%s", strings.ToLower(simtypes.RandStringOfLength(r, 6)))
+		spawnTime := ctx.BlockTime().Add(GenSpawnTimeOffset(r))
+		prop := RandomConsumerAdditionProposal(r, chainID, spawnTime)
+
+		return &types.MsgConsumerAddition{
+			ChainId:                           prop.ChainId,
+			InitialHeight:                     prop.InitialHeight,
+			GenesisHash:                       prop.GenesisHash,
+			BinaryHash:                        prop.BinaryHash,
+			SpawnTime:                         prop.SpawnTime,
+			UnbondingPeriod:                   prop.UnbondingPeriod,
+			CcvTimeoutPeriod:                  prop.CcvTimeoutPeriod,
+			TransferTimeoutPeriod:             prop.TransferTimeoutPeriod,
+			ConsumerRedistributionFraction:    prop.ConsumerRedistributionFraction,
+			BlocksPerDistributionTransmission: prop.BlocksPerDistributionTransmission,
+			HistoricalEntries:                 prop.HistoricalEntries,
+			Top_N:                             prop.Top_N,
+			ValidatorsPowerCap:                prop.ValidatorsPowerCap,
+			ValidatorSetCap:                   prop.ValidatorSetCap,
+			Authority:                         k.GetAuthority(),
+		}
+	}
+}
+
+// SimulateMsgConsumerRemoval returns a MsgConsumerRemoval for a random running consumer chain,
+// or nil if no consumer chain is running
+func SimulateMsgConsumerRemoval(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		chainID, ok := randomConsumerChain(r, k.GetAllRegisteredConsumerChainIDs(ctx))
+		if !ok {
+			return nil
+		}
+
+		return &types.MsgConsumerRemoval{
+			ChainId:   chainID,
+			StopTime:  ctx.BlockTime().Add(GenSpawnTimeOffset(r)),
+			Authority: k.GetAuthority(),
+		}
+	}
+}
+
+// SimulateMsgConsumerModification returns a MsgConsumerModification that changes the power shaping
+// parameters of a random running consumer chain, or nil if no consumer chain is running
+func SimulateMsgConsumerModification(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		chainID, ok := randomConsumerChain(r, k.GetAllRegisteredConsumerChainIDs(ctx))
+		if !ok {
+			return nil
+		}
+
+		return &types.MsgConsumerModification{
+			Title:              simtypes.RandStringOfLength(r, 10),
+			Description:        simtypes.RandStringOfLength(r, 50),
+			ChainId:            chainID,
+			Top_N:              randomTopN(r),
+			ValidatorsPowerCap: uint32(r.Intn(101)),
+			ValidatorSetCap:    uint32(r.Intn(20)),
+			Authority:          k.GetAuthority(),
+		}
+	}
+}
+
+// SimulateMsgChangeRewardDenoms returns a MsgChangeRewardDenoms that either registers
+// a new reward denom, or removes one of the registered reward denoms
+func SimulateMsgChangeRewardDenoms(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		msg := &types.MsgChangeRewardDenoms{
+			Authority: k.GetAuthority(),
+		}
+
+		denoms := k.GetAllConsumerRewardDenoms(ctx)
+		if len(denoms) > 0 && r.Intn(2) == 0 {
+			msg.DenomsToRemove = []string{denoms[r.Intn(len(denoms))]}
+		} else {
+			msg.DenomsToAdd = []string{"u" + strings.ToLower(simtypes.RandStringOfLength(r, 5))}
+		}
+
+		return msg
+	}
+}
@@ -35,6 +35,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConsumerModification{},
 		&MsgChangeRewardDenoms{},
 		&MsgUpdateParams{},
+		&MsgUpdateConsumer{},
+		&MsgEmergencyPauseConsumer{},
+		&MsgPauseConsumer{},
+		&MsgResumeConsumer{},
+		&MsgConsumerRelaunch{},
+		&MsgScheduleConsumerKeyRotation{},
+		&MsgCancelConsumerKeyRotation{},
+		&MsgSignalConsumerReady{},
 	)
 	// keep so existing proposals can be correctly deserialized
 	registry.RegisterImplementations(
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+)
+
+// Collection prefixes of the provider store. They reuse the byte prefixes
+// defined above so that the data written before the module moved to
+// collections is read without any change of layout.
+var (
+	ChainToChannelPrefix           = collections.NewPrefix(int(ChainToChannelBytePrefix))
+	ChannelToChainPrefix           = collections.NewPrefix(int(ChannelToChainBytePrefix))
+	ChainToClientPrefix            = collections.NewPrefix(int(ChainToClientBytePrefix))
+	PendingCAPPrefix               = collections.NewPrefix(int(PendingCAPBytePrefix))
+	PendingCRPPrefix               = collections.NewPrefix(int(PendingCRPBytePrefix))
+	ValidatorConsumerPubKeysPrefix = collections.NewPrefix(int(ConsumerValidatorsBytePrefix))
+	ValidatorsByConsumerAddrPrefix = collections.NewPrefix(int(ValidatorsByConsumerAddrBytePrefix))
+	ConsumerRewardDenomsPrefix     = collections.NewPrefix(int(ConsumerRewardDenomsBytePrefix))
+	ProposedConsumerChainPrefix    = collections.NewPrefix(int(ProposedConsumerChainByteKey))
+	ConsumerValidatorPrefix        = collections.NewPrefix(int(ConsumerValidatorBytePrefix))
+	OptedInPrefix                  = collections.NewPrefix(int(OptedInBytePrefix))
+	ConsumerMetadataPrefix         = collections.NewPrefix(int(ConsumerMetadataBytePrefix))
+	ConsumerOwnerPrefix            = collections.NewPrefix(int(ConsumerOwnerBytePrefix))
+	PausedConsumerPrefix           = collections.NewPrefix(int(PausedConsumerBytePrefix))
+	ConsumerToCatchUpPrefix        = collections.NewPrefix(int(ConsumerToCatchUpBytePrefix))
+	StoppedConsumerPrefix          = collections.NewPrefix(int(StoppedConsumerBytePrefix))
+	KeyAssignmentNoncePrefix       = collections.NewPrefix(int(KeyAssignmentNonceBytePrefix))
+	ConsumerKeyRotationPrefix      = collections.NewPrefix(int(ConsumerKeyRotationBytePrefix))
+	KeyAssignmentHistoryPrefix     = collections.NewPrefix(int(KeyAssignmentHistoryBytePrefix))
+	OptInPolicyPrefix              = collections.NewPrefix(int(OptInPolicyBytePrefix))
+	OptInRecordPrefix              = collections.NewPrefix(int(OptInRecordBytePrefix))
+	ReadinessSignalPrefix          = collections.NewPrefix(int(ReadinessSignalBytePrefix))
+	ConsumerEpochParamsPrefix      = collections.NewPrefix(int(ConsumerEpochParamsBytePrefix))
+	EpochStatePrefix               = collections.NewPrefix(int(EpochStateByteKey))
+)
+
+// ChainIDKey is the key codec for consumer chain IDs that are stored
+// with their length, i.e., len(chainID) | chainID, as done by ChainIdWithLenKey.
+// The length is written also when the chain ID is the last part of the key.
+var ChainIDKey collcodec.KeyCodec[string] = chainIDKey{}
+
+type chainIDKey struct{}
+
+func (chainIDKey) Encode(buffer []byte, chainID string) (int, error) {
+	binary.BigEndian.PutUint64(buffer, uint64(len(chainID)))
+	return 8 + copy(buffer[8:], chainID), nil
+}
+
+func (chainIDKey) Decode(buffer []byte) (int, string, error) {
+	if len(buffer) < 8 {
+		return 0, "", fmt.Errorf("%w: invalid buffer size for chain ID length: %d", collcodec.ErrEncoding, len(buffer))
+	}
+	chainIDL := binary.BigEndian.Uint64(buffer)
+	if uint64(len(buffer)-8) < chainIDL {
+		return 0, "", fmt.Errorf("%w: invalid buffer size for chain ID: expected %d, got %d", collcodec.ErrEncoding, chainIDL, len(buffer)-8)
+	}
+	return 8 + int(chainIDL), string(buffer[8 : 8+chainIDL]), nil
+}
+
+func (chainIDKey) Size(chainID string) int { return 8 + len(chainID) }
+
+func (chainIDKey) EncodeJSON(chainID string) ([]byte, error) { return json.Marshal(chainID) }
+
+func (chainIDKey) DecodeJSON(b []byte) (chainID string, err error) {
+	err = json.Unmarshal(b, &chainID)
+	return chainID, err
+}
+
+func (chainIDKey) Stringify(chainID string) string { return chainID }
+
+func (chainIDKey) KeyType() string { return "chain_id" }
+
+func (k chainIDKey) EncodeNonTerminal(buffer []byte, chainID string) (int, error) {
+	return k.Encode(buffer, chainID)
+}
+
+func (k chainIDKey) DecodeNonTerminal(buffer []byte) (int, string, error) {
+	return k.Decode(buffer)
+}
+
+func (k chainIDKey) SizeNonTerminal(chainID string) int { return k.Size(chainID) }
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestChainIDKey(t *testing.T) {
+	colltest.TestKeyCodec(t, types.ChainIDKey, "chain-1")
+	colltest.TestKeyCodec(t, types.ChainIDKey, "")
+}
+
+// TestCollectionsKeyLayout tests that the collections keys match the keys
+// written before the provider moved to collections
+func TestCollectionsKeyLayout(t *testing.T) {
+	chainID := "chain-1"
+	addr := sdk.ConsAddress([]byte("providerAddr"))
+
+	pairKey, err := collections.EncodeKeyWithPrefix(types.ConsumerValidatorPrefix.Bytes(),
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), collections.Join(chainID, addr))
+	require.NoError(t, err)
+	require.Equal(t, types.ChainIdAndConsAddrKey(types.ConsumerValidatorBytePrefix, chainID, addr), pairKey)
+
+	consumerKey, err := collections.EncodeKeyWithPrefix(types.ValidatorConsumerPubKeysPrefix.Bytes(),
+		collections.PairKeyCodec(types.ChainIDKey, sdk.ConsAddressKey), collections.Join(chainID, addr))
+	require.NoError(t, err)
+	require.Equal(t, types.ConsumerValidatorsKey(chainID, types.NewProviderConsAddress(addr)), consumerKey)
+
+	channelKey, err := collections.EncodeKeyWithPrefix(types.ChainToChannelPrefix.Bytes(), collections.StringKey, chainID)
+	require.NoError(t, err)
+	require.Equal(t, types.ChainToChannelKey(chainID), channelKey)
+
+	spawnTime := time.Now().UTC()
+	propKey, err := collections.EncodeKeyWithPrefix(types.PendingCAPPrefix.Bytes(),
+		collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		collections.Join(uint64(spawnTime.UnixNano()), chainID))
+	require.NoError(t, err)
+	require.Equal(t, types.PendingCAPKey(spawnTime, chainID), propKey)
+
+	denomKey, err := collections.EncodeKeyWithPrefix(types.ConsumerRewardDenomsPrefix.Bytes(), collections.StringKey, "denom")
+	require.NoError(t, err)
+	require.Equal(t, types.ConsumerRewardDenomsKey("denom"), denomKey)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a stateless validation of the epoch params of a consumer chain
+func (p ConsumerEpochParams) Validate() error {
+	if p.BlocksPerEpoch < 0 {
+		return errorsmod.Wrapf(ErrInvalidConsumerEpochParams, "blocks per epoch %d cannot be negative", p.BlocksPerEpoch)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestConsumerEpochParamsValidate(t *testing.T) {
+	require.NoError(t, types.ConsumerEpochParams{}.Validate())
+	require.NoError(t, types.ConsumerEpochParams{BlocksPerEpoch: 300}.Validate())
+	require.ErrorIs(t, types.ConsumerEpochParams{BlocksPerEpoch: -1}.Validate(), types.ErrInvalidConsumerEpochParams)
+}
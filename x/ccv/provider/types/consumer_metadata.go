@@ -0,0 +1,138 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxMetadataNameLength is the maximum length of the name of a consumer chain
+	MaxMetadataNameLength = 50
+	// MaxMetadataDescriptionLength is the maximum length of the description of a consumer chain
+	MaxMetadataDescriptionLength = 10000
+	// MaxMetadataURLLength is the maximum length of the URLs in the metadata of a consumer chain
+	MaxMetadataURLLength = 255
+
+	// ChainRegistrySchema is the JSON schema of the chain.json files of the chain registry
+	ChainRegistrySchema = "../chain.schema.json"
+)
+
+// Validate performs a stateless validation of the consumer metadata. All the fields are
+// optional, but the URLs must be absolute http(s) URLs and the hashes hex-encoded SHA-256 hashes.
+func (m ConsumerMetadata) Validate() error {
+	if len(m.Name) > MaxMetadataNameLength {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "name exceeds max length %d", MaxMetadataNameLength)
+	}
+	if len(m.Description) > MaxMetadataDescriptionLength {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "description exceeds max length %d", MaxMetadataDescriptionLength)
+	}
+	if err := validateMetadataURL(m.Website); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "website: %s", err)
+	}
+	if err := validateMetadataURL(m.BinaryRepository); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "binary repository: %s", err)
+	}
+	if err := validateMetadataURL(m.GenesisUrl); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "genesis URL: %s", err)
+	}
+	if err := validateMetadataHash(m.BinaryHash); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "binary hash: %s", err)
+	}
+	if err := validateMetadataHash(m.GenesisHash); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "genesis hash: %s", err)
+	}
+	return nil
+}
+
+func validateMetadataURL(s string) error {
+	if s == "" {
+		return nil
+	}
+	if len(s) > MaxMetadataURLLength {
+		return fmt.Errorf("URL exceeds max length %d", MaxMetadataURLLength)
+	}
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s is not an absolute http(s) URL", s)
+	}
+	return nil
+}
+
+func validateMetadataHash(s string) error {
+	if s == "" {
+		return nil
+	}
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("expected a %d bytes hash, got %d bytes", sha256.Size, len(bz))
+	}
+	return nil
+}
+
+// ChainRegistryEntry is the subset of the chain registry chain.json format
+// (https://github.com/cosmos/chain-registry) that is derived from the
+// metadata of a consumer chain. The expected hashes have no counterpart in
+// chain.json and are left out.
+type ChainRegistryEntry struct {
+	Schema      string                `json:"$schema"`
+	ChainName   string                `json:"chain_name"`
+	ChainID     string                `json:"chain_id"`
+	PrettyName  string                `json:"pretty_name,omitempty"`
+	Website     string                `json:"website,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Codebase    ChainRegistryCodebase `json:"codebase"`
+}
+
+// ChainRegistryCodebase is the codebase section of a chain registry chain.json file
+type ChainRegistryCodebase struct {
+	GitRepo string               `json:"git_repo,omitempty"`
+	Genesis ChainRegistryGenesis `json:"genesis"`
+}
+
+// ChainRegistryGenesis is the genesis section of a chain registry chain.json file
+type ChainRegistryGenesis struct {
+	GenesisURL string `json:"genesis_url,omitempty"`
+}
+
+// ToChainRegistryEntry returns the chain registry entry of the consumer chain with the given chain ID.
+func (m ConsumerMetadata) ToChainRegistryEntry(chainID string) ChainRegistryEntry {
+	return ChainRegistryEntry{
+		Schema:      ChainRegistrySchema,
+		ChainName:   chainRegistryName(m.Name, chainID),
+		ChainID:     chainID,
+		PrettyName:  m.Name,
+		Website:     m.Website,
+		Description: m.Description,
+		Codebase: ChainRegistryCodebase{
+			GitRepo: m.BinaryRepository,
+			Genesis: ChainRegistryGenesis{GenesisURL: m.GenesisUrl},
+		},
+	}
+}
+
+// chainRegistryName returns the chain registry name of a consumer chain, i.e., its name
+// in lowercase without non-alphanumeric characters. It falls back to the chain ID
+// when the name has no alphanumeric characters.
+func chainRegistryName(name, chainID string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return chainID
+	}
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestConsumerMetadataValidate(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+
+	testCases := []struct {
+		name     string
+		metadata types.ConsumerMetadata
+		expPass  bool
+	}{
+		{"empty metadata", types.ConsumerMetadata{}, true},
+		{
+			"all fields set",
+			types.ConsumerMetadata{
+				Name:             "Consumer",
+				Description:      "a consumer chain",
+				Website:          "https://consumer.zone",
+				BinaryRepository: "https://github.com/consumer/consumer",
+				GenesisUrl:       "http://consumer.zone/genesis.json",
+				BinaryHash:       hash,
+				GenesisHash:      strings.ToUpper(hash),
+			},
+			true,
+		},
+		{"name too long", types.ConsumerMetadata{Name: strings.Repeat("a", types.MaxMetadataNameLength+1)}, false},
+		{"description too long", types.ConsumerMetadata{Description: strings.Repeat("a", types.MaxMetadataDescriptionLength+1)}, false},
+		{"URL too long", types.ConsumerMetadata{Website: "https://" + strings.Repeat("a", types.MaxMetadataURLLength)}, false},
+		{"relative website", types.ConsumerMetadata{Website: "consumer.zone"}, false},
+		{"non-http binary repository", types.ConsumerMetadata{BinaryRepository: "ftp://consumer.zone/consumer"}, false},
+		{"genesis URL without host", types.ConsumerMetadata{GenesisUrl: "https:///genesis.json"}, false},
+		{"non-hex binary hash", types.ConsumerMetadata{BinaryHash: "zz" + hash[2:]}, false},
+		{"short genesis hash", types.ConsumerMetadata{GenesisHash: hash[:62]}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidConsumerMetadata)
+			}
+		})
+	}
+}
+
+func TestConsumerMetadataToChainRegistryEntry(t *testing.T) {
+	metadata := types.ConsumerMetadata{
+		Name:             "My Consumer-1",
+		Description:      "a consumer chain",
+		Website:          "https://consumer.zone",
+		BinaryRepository: "https://github.com/consumer/consumer",
+		GenesisUrl:       "https://consumer.zone/genesis.json",
+		BinaryHash:       strings.Repeat("ab", 32),
+	}
+
+	require.Equal(t, types.ChainRegistryEntry{
+		Schema:      types.ChainRegistrySchema,
+		ChainName:   "myconsumer1",
+		ChainID:     "consumer-1",
+		PrettyName:  "My Consumer-1",
+		Website:     "https://consumer.zone",
+		Description: "a consumer chain",
+		Codebase: types.ChainRegistryCodebase{
+			GitRepo: "https://github.com/consumer/consumer",
+			Genesis: types.ChainRegistryGenesis{GenesisURL: "https://consumer.zone/genesis.json"},
+		},
+	}, metadata.ToChainRegistryEntry("consumer-1"))
+
+	// the chain ID is used as name when the metadata has no usable name
+	require.Equal(t, "consumer-1", types.ConsumerMetadata{Name: "!?"}.ToChainRegistryEntry("consumer-1").ChainName)
+}
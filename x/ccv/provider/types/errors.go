@@ -31,4 +31,18 @@ var (
 	ErrInvalidAddress                      = errorsmod.Register(ModuleName, 24, "invalid address")
 	ErrUnauthorized                        = errorsmod.Register(ModuleName, 25, "unauthorized")
 	ErrBlankConsumerChainID                = errorsmod.Register(ModuleName, 26, "consumer chain id must not be blank")
+	ErrInvalidConsumerMetadata             = errorsmod.Register(ModuleName, 27, "invalid consumer metadata")
+	ErrInvalidConsumerUpdate               = errorsmod.Register(ModuleName, 28, "invalid consumer update")
+	ErrConsumerChainPaused                 = errorsmod.Register(ModuleName, 29, "consumer chain is paused")
+	ErrConsumerChainNotPaused              = errorsmod.Register(ModuleName, 30, "consumer chain is not paused")
+	ErrInvalidConsumerRelaunch             = errorsmod.Register(ModuleName, 31, "invalid consumer relaunch")
+	ErrInvalidConsumerKeyPossession        = errorsmod.Register(ModuleName, 32, "invalid consumer key possession proof")
+	ErrConsumerKeyTypeNotAllowed           = errorsmod.Register(ModuleName, 33, "consumer key type not allowed")
+	ErrInvalidConsumerKeyRotation          = errorsmod.Register(ModuleName, 34, "invalid consumer key rotation")
+	ErrNoConsumerKeyRotation               = errorsmod.Register(ModuleName, 35, "no consumer key rotation scheduled")
+	ErrOptInCommitmentNotEnded             = errorsmod.Register(ModuleName, 36, "opt-in commitment has not ended")
+	ErrOptInCooldownNotEnded               = errorsmod.Register(ModuleName, 37, "opt-in cooldown has not ended")
+	ErrInvalidLaunchThresholds             = errorsmod.Register(ModuleName, 38, "invalid launch thresholds")
+	ErrInvalidReadinessSignal              = errorsmod.Register(ModuleName, 39, "invalid consumer readiness signal")
+	ErrInvalidConsumerEpochParams          = errorsmod.Register(ModuleName, 40, "invalid consumer epoch params")
 )
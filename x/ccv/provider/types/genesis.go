@@ -85,6 +85,119 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	for _, cm := range gs.ConsumerMetadata {
+		if err := ValidateChainId("ChainId", cm.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := cm.Metadata.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("%s: for consumer chain id: %s", err, cm.ChainId))
+		}
+	}
+
+	for _, co := range gs.ConsumerOwners {
+		if err := ValidateChainId("ChainId", co.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if _, err := sdk.AccAddressFromBech32(co.Owner); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid owner %s for consumer chain id: %s", co.Owner, co.ChainId))
+		}
+	}
+
+	for _, pc := range gs.PausedConsumers {
+		if err := ValidateChainId("ChainId", pc.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	for _, sc := range gs.StoppedConsumers {
+		if err := ValidateChainId("ChainId", sc.LaunchParams.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := host.ClientIdentifierValidator(sc.ClientId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid client id for stopped consumer chain id: %s: %s", sc.LaunchParams.ChainId, err))
+		}
+	}
+
+	for _, n := range gs.KeyAssignmentNonces {
+		if err := ValidateChainId("ChainId", n.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := sdk.VerifyAddressFormat(n.ProviderAddr); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid provider address: %s", n.ProviderAddr))
+		}
+	}
+
+	for _, r := range gs.ConsumerKeyRotations {
+		if err := ValidateChainId("ChainId", r.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := sdk.VerifyAddressFormat(r.ProviderAddr); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid provider address: %s", r.ProviderAddr))
+		}
+		if _, err := ccv.TMCryptoPublicKeyToConsAddr(r.Rotation.ConsumerKey); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid consumer key of rotation: %s", err))
+		}
+		if r.Rotation.ApplyHeight <= 0 {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid apply height of rotation: %d", r.Rotation.ApplyHeight))
+		}
+	}
+
+	for _, h := range gs.KeyAssignmentHistory {
+		if err := ValidateChainId("ChainId", h.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := sdk.VerifyAddressFormat(h.ProviderAddr); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid provider address: %s", h.ProviderAddr))
+		}
+		if _, err := ccv.TMCryptoPublicKeyToConsAddr(h.Record.ConsumerKey); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid consumer key in key assignment history: %s", err))
+		}
+	}
+
+	for _, p := range gs.OptInPolicies {
+		if err := ValidateChainId("ChainId", p.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	if gs.EpochState != nil && gs.Params.EpochDuration == 0 {
+		return errorsmod.Wrap(ccv.ErrInvalidGenesis, "epoch state is only set when the epochs are based on block time")
+	}
+
+	for _, p := range gs.ConsumerEpochParams {
+		if err := ValidateChainId("ChainId", p.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := p.Params.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	for _, r := range gs.OptInRecords {
+		if err := ValidateChainId("ChainId", r.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := sdk.VerifyAddressFormat(r.ProviderAddr); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid provider address: %s", r.ProviderAddr))
+		}
+		if r.Record.OptInHeight < 0 || r.Record.OptOutHeight < 0 || (r.Record.OptInHeight == 0) == (r.Record.OptOutHeight == 0) {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis,
+				fmt.Sprintf("exactly one of opt-in and opt-out heights must be positive: %d, %d", r.Record.OptInHeight, r.Record.OptOutHeight))
+		}
+	}
+
+	for _, s := range gs.ReadinessSignals {
+		if err := ValidateChainId("ChainId", s.ChainId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+		if err := sdk.VerifyAddressFormat(s.ProviderAddr); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid provider address: %s", s.ProviderAddr))
+		}
+		if s.Signal.Height <= 0 {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid readiness signal height: %d", s.Signal.Height))
+		}
+	}
+
 	return nil
 }
 
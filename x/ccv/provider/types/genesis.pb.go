@@ -43,6 +43,30 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPruneV2 []ConsumerAddrsToPruneV2 `protobuf:"bytes,14,rep,name=consumer_addrs_to_prune_v2,json=consumerAddrsToPruneV2,proto3" json:"consumer_addrs_to_prune_v2"`
+	// empty for a new chain
+	ConsumerMetadata []ConsumerChainMetadata `protobuf:"bytes,15,rep,name=consumer_metadata,json=consumerMetadata,proto3" json:"consumer_metadata"`
+	// empty for a new chain
+	ConsumerOwners []ConsumerOwner `protobuf:"bytes,16,rep,name=consumer_owners,json=consumerOwners,proto3" json:"consumer_owners"`
+	// empty for a new chain
+	PausedConsumers []PausedConsumer `protobuf:"bytes,17,rep,name=paused_consumers,json=pausedConsumers,proto3" json:"paused_consumers"`
+	// empty for a new chain
+	StoppedConsumers []StoppedConsumer `protobuf:"bytes,18,rep,name=stopped_consumers,json=stoppedConsumers,proto3" json:"stopped_consumers"`
+	// empty for a new chain
+	KeyAssignmentNonces []KeyAssignmentNonce `protobuf:"bytes,19,rep,name=key_assignment_nonces,json=keyAssignmentNonces,proto3" json:"key_assignment_nonces"`
+	// empty for a new chain
+	ConsumerKeyRotations []ScheduledConsumerKeyRotation `protobuf:"bytes,20,rep,name=consumer_key_rotations,json=consumerKeyRotations,proto3" json:"consumer_key_rotations"`
+	// empty for a new chain
+	KeyAssignmentHistory []KeyAssignmentHistoryEntry `protobuf:"bytes,21,rep,name=key_assignment_history,json=keyAssignmentHistory,proto3" json:"key_assignment_history"`
+	// empty for a new chain
+	OptInPolicies []ConsumerOptInPolicy `protobuf:"bytes,22,rep,name=opt_in_policies,json=optInPolicies,proto3" json:"opt_in_policies"`
+	// empty for a new chain
+	OptInRecords []ValidatorOptInRecord `protobuf:"bytes,23,rep,name=opt_in_records,json=optInRecords,proto3" json:"opt_in_records"`
+	// empty for a new chain
+	ReadinessSignals []ValidatorReadinessSignal `protobuf:"bytes,24,rep,name=readiness_signals,json=readinessSignals,proto3" json:"readiness_signals"`
+	// empty for a new chain
+	ConsumerEpochParams []ChainEpochParams `protobuf:"bytes,25,rep,name=consumer_epoch_params,json=consumerEpochParams,proto3" json:"consumer_epoch_params"`
+	// nil if the epochs are based on block height
+	EpochState *EpochState `protobuf:"bytes,26,opt,name=epoch_state,json=epochState,proto3" json:"epoch_state,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -141,6 +165,90 @@ func (m *GenesisState) GetConsumerAddrsToPruneV2() []ConsumerAddrsToPruneV2 {
 	return nil
 }
 
+func (m *GenesisState) GetConsumerMetadata() []ConsumerChainMetadata {
+	if m != nil {
+		return m.ConsumerMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerOwners() []ConsumerOwner {
+	if m != nil {
+		return m.ConsumerOwners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedConsumers() []PausedConsumer {
+	if m != nil {
+		return m.PausedConsumers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStoppedConsumers() []StoppedConsumer {
+	if m != nil {
+		return m.StoppedConsumers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyAssignmentNonces() []KeyAssignmentNonce {
+	if m != nil {
+		return m.KeyAssignmentNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerKeyRotations() []ScheduledConsumerKeyRotation {
+	if m != nil {
+		return m.ConsumerKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyAssignmentHistory() []KeyAssignmentHistoryEntry {
+	if m != nil {
+		return m.KeyAssignmentHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOptInPolicies() []ConsumerOptInPolicy {
+	if m != nil {
+		return m.OptInPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOptInRecords() []ValidatorOptInRecord {
+	if m != nil {
+		return m.OptInRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReadinessSignals() []ValidatorReadinessSignal {
+	if m != nil {
+		return m.ReadinessSignals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerEpochParams() []ChainEpochParams {
+	if m != nil {
+		return m.ConsumerEpochParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochState() *EpochState {
+	if m != nil {
+		return m.EpochState
+	}
+	return nil
+}
+
 // The provider CCV module's knowledge of consumer state.
 //
 // Note this type is only used internally to the provider CCV module.
@@ -308,56 +416,79 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xda, 0x48,
-	0x14, 0xc6, 0xc1, 0x21, 0x66, 0x12, 0x58, 0xcb, 0x8a, 0x90, 0x43, 0xb4, 0x24, 0x62, 0x15, 0x09,
-	0x69, 0x77, 0x71, 0x60, 0x2f, 0xab, 0xdd, 0xcd, 0x21, 0x24, 0xd2, 0x16, 0x7a, 0x41, 0x24, 0x4d,
-	0xa5, 0x5c, 0xac, 0x61, 0x3c, 0x82, 0x11, 0xc6, 0x63, 0x79, 0x06, 0xa7, 0xa8, 0xaa, 0xd4, 0xaa,
-	0x7f, 0xa0, 0xe7, 0xfe, 0x86, 0xfe, 0x90, 0x1c, 0x73, 0xec, 0x29, 0xaa, 0x92, 0x7f, 0xd0, 0x5f,
-	0x50, 0x79, 0x3c, 0x76, 0x21, 0x25, 0x11, 0xf4, 0x06, 0xf3, 0xcd, 0xf7, 0xbd, 0xef, 0x3d, 0xbf,
-	0xf7, 0x06, 0x34, 0x88, 0xc7, 0x71, 0x80, 0x86, 0x90, 0x78, 0x36, 0xc3, 0x68, 0x12, 0x10, 0x3e,
-	0xb5, 0x10, 0x0a, 0x2d, 0x3f, 0xa0, 0x21, 0x71, 0x70, 0x60, 0x85, 0x0d, 0x6b, 0x80, 0x3d, 0xcc,
-	0x08, 0xab, 0xfb, 0x01, 0xe5, 0xd4, 0xf8, 0x6d, 0x01, 0xa5, 0x8e, 0x50, 0x58, 0x4f, 0x28, 0xf5,
-	0xb0, 0x51, 0xde, 0x1e, 0xd0, 0x01, 0x15, 0xf7, 0xad, 0xe8, 0x57, 0x4c, 0x2d, 0x1f, 0x3e, 0x16,
-	0x2d, 0x6c, 0x58, 0x6c, 0x08, 0x03, 0xec, 0xd8, 0x88, 0x7a, 0x6c, 0x32, 0xc6, 0x81, 0x64, 0x1c,
-	0x3c, 0xc1, 0xb8, 0x22, 0x01, 0x96, 0xd7, 0x9a, 0xcb, 0xa4, 0x91, 0xfa, 0x13, 0x9c, 0xea, 0x27,
-	0x0d, 0x6c, 0xfd, 0x1f, 0x67, 0x76, 0xc6, 0x21, 0xc7, 0x46, 0x0d, 0xe8, 0x21, 0x74, 0x19, 0xe6,
-	0xf6, 0xc4, 0x77, 0x20, 0xc7, 0x36, 0x71, 0x4c, 0x65, 0x5f, 0xa9, 0xa9, 0xbd, 0x62, 0x7c, 0xfe,
-	0x42, 0x1c, 0xb7, 0x1d, 0xe3, 0x35, 0xf8, 0x25, 0xf1, 0x69, 0xb3, 0x88, 0xcb, 0xcc, 0xb5, 0xfd,
-	0x6c, 0x6d, 0xb3, 0xd9, 0xac, 0x2f, 0x51, 0x9c, 0xfa, 0x89, 0xe4, 0x8a, 0xb0, 0xad, 0xca, 0xf5,
-	0xed, 0x5e, 0xe6, 0xeb, 0xed, 0x5e, 0x69, 0x0a, 0xc7, 0xee, 0x3f, 0xd5, 0x07, 0xc2, 0xd5, 0x5e,
-	0x11, 0xcd, 0x5e, 0x67, 0xc6, 0x1b, 0x50, 0x7e, 0x68, 0xd3, 0xe6, 0xd4, 0x1e, 0x62, 0x32, 0x18,
-	0x72, 0x73, 0x5d, 0xf8, 0xf8, 0x77, 0x29, 0x1f, 0x17, 0x73, 0x59, 0x9d, 0xd3, 0x67, 0x42, 0xa2,
-	0xa5, 0x46, 0x86, 0x7a, 0xa5, 0x70, 0x21, 0x6a, 0xbc, 0x57, 0xc0, 0x6e, 0xea, 0x11, 0x3a, 0x0e,
-	0xe1, 0x84, 0x7a, 0xb6, 0x1f, 0x50, 0x9f, 0x32, 0xe8, 0x32, 0x33, 0x27, 0x0c, 0x1c, 0xad, 0x54,
-	0x88, 0x63, 0x29, 0xd3, 0x95, 0x2a, 0xd2, 0xc2, 0x0e, 0x7a, 0x04, 0x67, 0xc6, 0x5b, 0x05, 0x94,
-	0x53, 0x17, 0x01, 0x1e, 0xd3, 0x10, 0xba, 0x33, 0x26, 0x36, 0x84, 0x89, 0xff, 0x56, 0x32, 0xd1,
-	0x8b, 0x55, 0x1e, 0x78, 0x30, 0xd1, 0x62, 0x98, 0x19, 0x6d, 0x90, 0xf3, 0x61, 0x00, 0xc7, 0xcc,
-	0xd4, 0xf6, 0x95, 0xda, 0x66, 0xf3, 0xf7, 0xa5, 0xa2, 0x75, 0x05, 0x45, 0x8a, 0x4b, 0x01, 0x91,
-	0x4d, 0x08, 0x5d, 0xe2, 0x40, 0x4e, 0x83, 0x74, 0x04, 0x6c, 0x7f, 0xd2, 0x1f, 0xe1, 0x29, 0x33,
-	0xf3, 0x2b, 0x64, 0x73, 0x91, 0xc8, 0x24, 0x69, 0x75, 0x27, 0xfd, 0xe7, 0x78, 0x9a, 0x64, 0x13,
-	0x2e, 0x80, 0xa3, 0x18, 0xc6, 0x3b, 0x05, 0xec, 0xa6, 0x20, 0xb3, 0xfb, 0x53, 0x7b, 0xf6, 0x23,
-	0x07, 0x26, 0xf8, 0x19, 0x0f, 0xad, 0xe9, 0xcc, 0x17, 0x0e, 0x7e, 0xf0, 0xc0, 0xe6, 0xf1, 0xa8,
-	0xb3, 0xe7, 0x82, 0xb2, 0xa8, 0xaf, 0xfd, 0x60, 0xe2, 0x61, 0x3b, 0x6c, 0x9a, 0xc5, 0x15, 0x3a,
-	0x7b, 0x56, 0x96, 0x9d, 0xd3, 0x6e, 0xa4, 0x71, 0xd1, 0x4c, 0x3a, 0x1b, 0x2d, 0x44, 0x3b, 0xaa,
-	0x96, 0xd5, 0xd5, 0x8e, 0xaa, 0xa9, 0xfa, 0x7a, 0x47, 0xd5, 0x36, 0xf5, 0xad, 0x8e, 0xaa, 0x6d,
-	0xe9, 0x85, 0x8e, 0xaa, 0x15, 0xf4, 0x62, 0xf5, 0x63, 0x16, 0x14, 0xe6, 0x06, 0xd7, 0xd8, 0x01,
-	0x5a, 0x6c, 0x43, 0xee, 0x89, 0x7c, 0x6f, 0x43, 0xfc, 0x6f, 0x3b, 0xc6, 0xaf, 0x00, 0xa0, 0x21,
-	0xf4, 0x3c, 0xec, 0x46, 0xe0, 0x9a, 0x00, 0xf3, 0xf2, 0xa4, 0xed, 0x18, 0xbb, 0x20, 0x8f, 0x5c,
-	0x82, 0x3d, 0x1e, 0xa1, 0x59, 0x81, 0x6a, 0xf1, 0x41, 0xdb, 0x31, 0x0e, 0x40, 0x91, 0x78, 0x84,
-	0x13, 0xe8, 0x26, 0x33, 0xad, 0x8a, 0x25, 0x54, 0x90, 0xa7, 0x72, 0x0e, 0x21, 0xd0, 0xd3, 0x62,
-	0xc9, 0x05, 0x6d, 0xae, 0x8b, 0x46, 0x3c, 0x7c, 0xb4, 0x44, 0x33, 0x95, 0x99, 0xdd, 0x7c, 0xb2,
-	0x2e, 0xe9, 0x4e, 0x93, 0x98, 0xc1, 0x41, 0xc9, 0xc7, 0x9e, 0x43, 0xbc, 0x81, 0x2d, 0x37, 0x4e,
-	0x94, 0xc2, 0x00, 0x27, 0x43, 0xfe, 0xf7, 0x53, 0x81, 0xd2, 0x26, 0x38, 0xc3, 0xfc, 0x44, 0xd0,
-	0xba, 0x10, 0x8d, 0x30, 0x3f, 0x85, 0x1c, 0xca, 0x80, 0xdb, 0x52, 0x3d, 0xde, 0x43, 0xf1, 0x25,
-	0x66, 0xfc, 0x01, 0x0c, 0xe6, 0x42, 0x36, 0xb4, 0x1d, 0x7a, 0xe5, 0x71, 0x32, 0xc6, 0x36, 0x44,
-	0x23, 0x31, 0xd1, 0xf9, 0x9e, 0x2e, 0x90, 0x53, 0x09, 0x1c, 0xa3, 0x51, 0x47, 0xd5, 0x34, 0x3d,
-	0x5f, 0xbd, 0x04, 0xa5, 0xc5, 0xcb, 0x6c, 0x85, 0xa5, 0x5e, 0x02, 0x39, 0x59, 0xef, 0x35, 0x81,
-	0xcb, 0x7f, 0xad, 0x97, 0xd7, 0x77, 0x15, 0xe5, 0xe6, 0xae, 0xa2, 0x7c, 0xb9, 0xab, 0x28, 0x1f,
-	0xee, 0x2b, 0x99, 0x9b, 0xfb, 0x4a, 0xe6, 0xf3, 0x7d, 0x25, 0x73, 0x79, 0x34, 0x20, 0x7c, 0x38,
-	0xe9, 0xd7, 0x11, 0x1d, 0x5b, 0xd0, 0x75, 0x89, 0xd7, 0x27, 0x9c, 0x59, 0xdf, 0x6b, 0xf2, 0x67,
-	0xfa, 0x14, 0xbd, 0x9a, 0x7f, 0x8c, 0xf8, 0xd4, 0xc7, 0xac, 0x9f, 0x13, 0xef, 0xd0, 0x5f, 0xdf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x97, 0xe1, 0xf7, 0x84, 0x07, 0x00, 0x00,
+	// 1137 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xd1, 0x6e, 0x1b, 0x45,
+	0x17, 0xc7, 0xe3, 0xc6, 0x49, 0xed, 0x49, 0xe2, 0x6c, 0x26, 0x89, 0xbf, 0x4d, 0xa2, 0xcf, 0x8d,
+	0x82, 0x2a, 0x45, 0x02, 0xec, 0xc6, 0x05, 0x51, 0x0a, 0x45, 0x4a, 0xd2, 0x8a, 0xda, 0x11, 0x60,
+	0x39, 0x25, 0x48, 0xbd, 0x19, 0x8d, 0x67, 0x07, 0x7b, 0xe4, 0xf5, 0xce, 0x32, 0x67, 0xbc, 0xc1,
+	0x20, 0x24, 0x10, 0x2f, 0xc0, 0x35, 0x2f, 0xc3, 0x6d, 0x2f, 0x7b, 0xc9, 0x55, 0x85, 0x92, 0x37,
+	0xe0, 0x09, 0xd0, 0x8e, 0x67, 0x37, 0x76, 0xe2, 0x54, 0x76, 0xef, 0xec, 0x73, 0xe6, 0xfc, 0x7f,
+	0xe7, 0x8c, 0x8f, 0xcf, 0x1c, 0x74, 0x20, 0x02, 0xcd, 0x15, 0xeb, 0x50, 0x11, 0x10, 0xe0, 0xac,
+	0xaf, 0x84, 0x1e, 0x54, 0x18, 0x8b, 0x2a, 0xa1, 0x92, 0x91, 0xf0, 0xb8, 0xaa, 0x44, 0x07, 0x95,
+	0x36, 0x0f, 0x38, 0x08, 0x28, 0x87, 0x4a, 0x6a, 0x89, 0xdf, 0x9b, 0x10, 0x52, 0x66, 0x2c, 0x2a,
+	0x27, 0x21, 0xe5, 0xe8, 0x60, 0x7b, 0xa3, 0x2d, 0xdb, 0xd2, 0x9c, 0xaf, 0xc4, 0x9f, 0x86, 0xa1,
+	0xdb, 0x0f, 0x6e, 0xa3, 0x45, 0x07, 0x15, 0xe8, 0x50, 0xc5, 0x3d, 0xc2, 0x64, 0x00, 0xfd, 0x1e,
+	0x57, 0x36, 0xe2, 0xfe, 0x5b, 0x22, 0xce, 0x85, 0xe2, 0xf6, 0x58, 0x75, 0x9a, 0x32, 0xd2, 0xfc,
+	0x4c, 0xcc, 0xde, 0x5f, 0x18, 0x2d, 0x7f, 0x39, 0xac, 0xec, 0x54, 0x53, 0xcd, 0xf1, 0x3e, 0x72,
+	0x22, 0xea, 0x03, 0xd7, 0xa4, 0x1f, 0x7a, 0x54, 0x73, 0x22, 0x3c, 0x37, 0xb3, 0x9b, 0xd9, 0xcf,
+	0x36, 0x0b, 0x43, 0xfb, 0xb7, 0xc6, 0x5c, 0xf3, 0xf0, 0xcf, 0x68, 0x35, 0xc9, 0x93, 0x40, 0x1c,
+	0x0b, 0xee, 0x9d, 0xdd, 0xf9, 0xfd, 0xa5, 0x6a, 0xb5, 0x3c, 0xc5, 0xe5, 0x94, 0x8f, 0x6d, 0xac,
+	0xc1, 0x1e, 0x95, 0x5e, 0xbd, 0xb9, 0x37, 0xf7, 0xef, 0x9b, 0x7b, 0xc5, 0x01, 0xed, 0xf9, 0x8f,
+	0xf7, 0xae, 0x09, 0xef, 0x35, 0x0b, 0x6c, 0xf4, 0x38, 0xe0, 0x5f, 0xd0, 0xf6, 0xf5, 0x34, 0x89,
+	0x96, 0xa4, 0xc3, 0x45, 0xbb, 0xa3, 0xdd, 0x05, 0x93, 0xc7, 0x67, 0x53, 0xe5, 0x71, 0x36, 0x56,
+	0xd5, 0x0b, 0xf9, 0xdc, 0x48, 0x1c, 0x65, 0xe3, 0x84, 0x9a, 0xc5, 0x68, 0xa2, 0x17, 0xff, 0x9e,
+	0x41, 0x3b, 0x69, 0x8e, 0xd4, 0xf3, 0x84, 0x16, 0x32, 0x20, 0xa1, 0x92, 0xa1, 0x04, 0xea, 0x83,
+	0xbb, 0x68, 0x12, 0x78, 0x32, 0xd3, 0x45, 0x1c, 0x5a, 0x99, 0x86, 0x55, 0xb1, 0x29, 0x6c, 0xb1,
+	0x5b, 0xfc, 0x80, 0x7f, 0xcd, 0xa0, 0xed, 0x34, 0x0b, 0xc5, 0x7b, 0x32, 0xa2, 0xfe, 0x48, 0x12,
+	0x77, 0x4d, 0x12, 0x9f, 0xcf, 0x94, 0x44, 0x73, 0xa8, 0x72, 0x2d, 0x07, 0x97, 0x4d, 0x76, 0x03,
+	0xae, 0xa1, 0xc5, 0x90, 0x2a, 0xda, 0x03, 0x37, 0xb7, 0x9b, 0xd9, 0x5f, 0xaa, 0xbe, 0x3f, 0x15,
+	0xad, 0x61, 0x42, 0xac, 0xb8, 0x15, 0x30, 0xd5, 0x44, 0xd4, 0x17, 0x1e, 0xd5, 0x52, 0xa5, 0x7f,
+	0x01, 0x12, 0xf6, 0x5b, 0x5d, 0x3e, 0x00, 0x37, 0x3f, 0x43, 0x35, 0x67, 0x89, 0x4c, 0x52, 0x56,
+	0xa3, 0xdf, 0x3a, 0xe1, 0x83, 0xa4, 0x9a, 0x68, 0x82, 0x3b, 0x66, 0xe0, 0xdf, 0x32, 0x68, 0x27,
+	0x75, 0x02, 0x69, 0x0d, 0xc8, 0xe8, 0x8f, 0xac, 0x5c, 0xf4, 0x2e, 0x39, 0x1c, 0x0d, 0x46, 0x7e,
+	0x61, 0x75, 0x23, 0x07, 0x18, 0xf7, 0xc7, 0x9d, 0x3d, 0x06, 0x85, 0xb8, 0xaf, 0x43, 0xd5, 0x0f,
+	0x38, 0x89, 0xaa, 0x6e, 0x61, 0x86, 0xce, 0x1e, 0x95, 0x85, 0x17, 0xb2, 0x11, 0x6b, 0x9c, 0x55,
+	0x93, 0xce, 0x66, 0x13, 0xbd, 0xb8, 0x87, 0xd6, 0x52, 0x7c, 0x8f, 0x6b, 0xea, 0x51, 0x4d, 0xdd,
+	0x55, 0x43, 0x7d, 0x3c, 0x13, 0xf5, 0x38, 0x3e, 0xf6, 0x95, 0x55, 0xb0, 0x50, 0x27, 0x91, 0x4e,
+	0xec, 0x98, 0x8e, 0x0c, 0x11, 0x79, 0x1e, 0x70, 0x05, 0xae, 0xf3, 0x0e, 0x43, 0xe4, 0x9b, 0x38,
+	0xd4, 0x42, 0xd2, 0x51, 0x61, 0x8c, 0x80, 0x3d, 0xe4, 0x84, 0xb4, 0x0f, 0x23, 0x63, 0x15, 0xdc,
+	0x35, 0xc3, 0x78, 0x38, 0x65, 0xb3, 0xc6, 0xc1, 0x09, 0xc9, 0x42, 0x56, 0xc3, 0x31, 0x2b, 0xe0,
+	0x36, 0x5a, 0x03, 0x2d, 0xc3, 0x70, 0x0c, 0x83, 0x0d, 0xe6, 0xa3, 0xa9, 0x30, 0xa7, 0xc3, 0xe8,
+	0x6b, 0x1c, 0x07, 0xc6, 0xcd, 0x80, 0x7f, 0x40, 0x9b, 0x5d, 0x3e, 0x20, 0x14, 0x40, 0xb4, 0x83,
+	0x1e, 0x0f, 0x34, 0x09, 0x64, 0xc0, 0x38, 0xb8, 0xeb, 0x06, 0xf6, 0xc9, 0x54, 0xb0, 0x13, 0x3e,
+	0x38, 0x4c, 0x05, 0xbe, 0x8e, 0xe3, 0x2d, 0x6f, 0xbd, 0x7b, 0xc3, 0x13, 0x0f, 0xdb, 0xb4, 0x5b,
+	0x48, 0xcc, 0x56, 0x52, 0xd3, 0x78, 0x12, 0x81, 0xbb, 0x61, 0x98, 0x87, 0xd3, 0x15, 0xc8, 0x3a,
+	0xdc, 0xeb, 0xfb, 0x57, 0xb5, 0x9c, 0xf0, 0x41, 0xd3, 0x2a, 0x59, 0xfa, 0x06, 0xbb, 0xe9, 0x02,
+	0xfc, 0x13, 0x2a, 0x5e, 0xab, 0xb8, 0x23, 0x40, 0x4b, 0x35, 0x70, 0x37, 0x0d, 0xfe, 0x8b, 0xd9,
+	0x4b, 0x7e, 0x3e, 0x14, 0x78, 0x16, 0x68, 0x95, 0x4c, 0x85, 0x8d, 0xee, 0x84, 0x03, 0xf8, 0x7b,
+	0xb4, 0x2a, 0x43, 0x4d, 0x44, 0x40, 0x42, 0xe9, 0x0b, 0x26, 0x38, 0xb8, 0x45, 0x03, 0x7d, 0x34,
+	0x5b, 0x7f, 0x86, 0xba, 0x16, 0x34, 0x62, 0x85, 0x04, 0xb7, 0x22, 0x53, 0x93, 0xe0, 0x80, 0x39,
+	0x2a, 0x58, 0x8e, 0xe2, 0x4c, 0x2a, 0x0f, 0xdc, 0xff, 0x19, 0xcc, 0xa7, 0xb3, 0xcd, 0x1a, 0xc3,
+	0x69, 0x1a, 0x05, 0xcb, 0x59, 0x96, 0x57, 0x26, 0xc0, 0x21, 0x5a, 0x53, 0x9c, 0x7a, 0x22, 0xe0,
+	0x00, 0x24, 0xae, 0x35, 0x7e, 0x27, 0xdc, 0x19, 0x1e, 0xab, 0x94, 0xd4, 0x4c, 0x64, 0x4e, 0x8d,
+	0x4a, 0xd2, 0xae, 0x6a, 0xdc, 0x0c, 0x58, 0xa2, 0xcd, 0xb4, 0x77, 0x78, 0x28, 0x59, 0x87, 0xd8,
+	0xf7, 0x62, 0xcb, 0x50, 0x3f, 0x9e, 0xee, 0x1a, 0x63, 0xf7, 0xb3, 0x38, 0x7a, 0xec, 0xe5, 0x58,
+	0x4f, 0x94, 0x47, 0x5c, 0xb8, 0x81, 0x96, 0x86, 0x1c, 0xb3, 0x3a, 0xb8, 0xdb, 0xe6, 0x59, 0xaa,
+	0x4c, 0x85, 0x31, 0x32, 0x66, 0xc1, 0x68, 0x22, 0x9e, 0x7e, 0xae, 0x67, 0x73, 0xf3, 0x4e, 0xb6,
+	0x9e, 0xcd, 0x65, 0x9d, 0x85, 0x7a, 0x36, 0xb7, 0xe4, 0x2c, 0xd7, 0xb3, 0xb9, 0x65, 0x67, 0xa5,
+	0x9e, 0xcd, 0xad, 0x38, 0x85, 0xbd, 0x3f, 0xe7, 0xd1, 0xca, 0xd8, 0x2e, 0x83, 0xb7, 0x50, 0x6e,
+	0x08, 0xb2, 0xab, 0x53, 0xbe, 0x79, 0xd7, 0x7c, 0xaf, 0x79, 0xf8, 0xff, 0x08, 0xb1, 0x0e, 0x0d,
+	0x02, 0xee, 0xc7, 0xce, 0x3b, 0xc6, 0x99, 0xb7, 0x96, 0x9a, 0x87, 0x77, 0x50, 0x9e, 0xf9, 0x22,
+	0xee, 0x70, 0xe1, 0xb9, 0xf3, 0xc6, 0x9b, 0x1b, 0x1a, 0x6a, 0x1e, 0xbe, 0x8f, 0x0a, 0x22, 0x10,
+	0x5a, 0x50, 0x3f, 0x59, 0x73, 0xb2, 0x66, 0x2f, 0x5b, 0xb1, 0x56, 0xbb, 0x9a, 0x50, 0x94, 0x4e,
+	0x59, 0x62, 0x77, 0x56, 0x77, 0xc1, 0x5c, 0xc2, 0x83, 0x5b, 0x2f, 0x61, 0xa4, 0x53, 0x47, 0x97,
+	0xc1, 0x64, 0xd6, 0xb1, 0x71, 0x1f, 0xd6, 0xa8, 0x18, 0xf2, 0xc0, 0x13, 0x41, 0x9b, 0xd8, 0x25,
+	0x2c, 0x2e, 0xa1, 0xcd, 0x93, 0xbd, 0xe7, 0xd1, 0xdb, 0x40, 0x69, 0x07, 0x9d, 0x72, 0x7d, 0x6c,
+	0xc2, 0x1a, 0x94, 0x75, 0xb9, 0x7e, 0x7a, 0xf5, 0x4c, 0x6c, 0x58, 0xf5, 0xe1, 0x6a, 0x36, 0x3c,
+	0x04, 0xf8, 0x03, 0x84, 0xc1, 0xa7, 0xd0, 0x21, 0x9e, 0x3c, 0x0f, 0xb4, 0xe8, 0x71, 0x42, 0x59,
+	0xd7, 0x2c, 0x39, 0xf9, 0xa6, 0x63, 0x3c, 0x4f, 0xad, 0xe3, 0x90, 0x75, 0xeb, 0xd9, 0x5c, 0xce,
+	0xc9, 0xef, 0xbd, 0x44, 0xc5, 0xc9, 0xfb, 0xdd, 0x0c, 0x7b, 0x6e, 0x11, 0x2d, 0xda, 0xfb, 0xbe,
+	0x63, 0xfc, 0xf6, 0xdb, 0xd1, 0x77, 0xaf, 0x2e, 0x4a, 0x99, 0xd7, 0x17, 0xa5, 0xcc, 0x3f, 0x17,
+	0xa5, 0xcc, 0x1f, 0x97, 0xa5, 0xb9, 0xd7, 0x97, 0xa5, 0xb9, 0xbf, 0x2f, 0x4b, 0x73, 0x2f, 0x9f,
+	0xb4, 0x85, 0xee, 0xf4, 0x5b, 0x65, 0x26, 0x7b, 0x15, 0xea, 0xfb, 0x22, 0x68, 0x09, 0x0d, 0x95,
+	0xab, 0x3b, 0xf9, 0x30, 0xdd, 0xce, 0x7f, 0x1c, 0xdf, 0xcf, 0xf5, 0x20, 0xe4, 0xd0, 0x5a, 0x34,
+	0xab, 0xf9, 0xc3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x02, 0x23, 0xa2, 0x57, 0x97, 0x0c, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -380,6 +511,194 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochState != nil {
+		{
+			size, err := m.EpochState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.ConsumerEpochParams) > 0 {
+		for iNdEx := len(m.ConsumerEpochParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerEpochParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.ReadinessSignals) > 0 {
+		for iNdEx := len(m.ReadinessSignals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReadinessSignals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.OptInRecords) > 0 {
+		for iNdEx := len(m.OptInRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptInRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.OptInPolicies) > 0 {
+		for iNdEx := len(m.OptInPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptInPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.KeyAssignmentHistory) > 0 {
+		for iNdEx := len(m.KeyAssignmentHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAssignmentHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ConsumerKeyRotations) > 0 {
+		for iNdEx := len(m.ConsumerKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.KeyAssignmentNonces) > 0 {
+		for iNdEx := len(m.KeyAssignmentNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAssignmentNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.StoppedConsumers) > 0 {
+		for iNdEx := len(m.StoppedConsumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoppedConsumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PausedConsumers) > 0 {
+		for iNdEx := len(m.PausedConsumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedConsumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ConsumerOwners) > 0 {
+		for iNdEx := len(m.ConsumerOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ConsumerMetadata) > 0 {
+		for iNdEx := len(m.ConsumerMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.ConsumerAddrsToPruneV2) > 0 {
 		for iNdEx := len(m.ConsumerAddrsToPruneV2) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -675,6 +994,76 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConsumerMetadata) > 0 {
+		for _, e := range m.ConsumerMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerOwners) > 0 {
+		for _, e := range m.ConsumerOwners {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedConsumers) > 0 {
+		for _, e := range m.PausedConsumers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StoppedConsumers) > 0 {
+		for _, e := range m.StoppedConsumers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyAssignmentNonces) > 0 {
+		for _, e := range m.KeyAssignmentNonces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerKeyRotations) > 0 {
+		for _, e := range m.ConsumerKeyRotations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyAssignmentHistory) > 0 {
+		for _, e := range m.KeyAssignmentHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OptInPolicies) > 0 {
+		for _, e := range m.OptInPolicies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OptInRecords) > 0 {
+		for _, e := range m.OptInRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReadinessSignals) > 0 {
+		for _, e := range m.ReadinessSignals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerEpochParams) > 0 {
+		for _, e := range m.ConsumerEpochParams {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EpochState != nil {
+		l = m.EpochState.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -1056,6 +1445,416 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerMetadata = append(m.ConsumerMetadata, ConsumerChainMetadata{})
+			if err := m.ConsumerMetadata[len(m.ConsumerMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerOwners = append(m.ConsumerOwners, ConsumerOwner{})
+			if err := m.ConsumerOwners[len(m.ConsumerOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedConsumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedConsumers = append(m.PausedConsumers, PausedConsumer{})
+			if err := m.PausedConsumers[len(m.PausedConsumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedConsumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoppedConsumers = append(m.StoppedConsumers, StoppedConsumer{})
+			if err := m.StoppedConsumers[len(m.StoppedConsumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAssignmentNonces = append(m.KeyAssignmentNonces, KeyAssignmentNonce{})
+			if err := m.KeyAssignmentNonces[len(m.KeyAssignmentNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerKeyRotations = append(m.ConsumerKeyRotations, ScheduledConsumerKeyRotation{})
+			if err := m.ConsumerKeyRotations[len(m.ConsumerKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAssignmentHistory = append(m.KeyAssignmentHistory, KeyAssignmentHistoryEntry{})
+			if err := m.KeyAssignmentHistory[len(m.KeyAssignmentHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptInPolicies = append(m.OptInPolicies, ConsumerOptInPolicy{})
+			if err := m.OptInPolicies[len(m.OptInPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptInRecords = append(m.OptInRecords, ValidatorOptInRecord{})
+			if err := m.OptInRecords[len(m.OptInRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadinessSignals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadinessSignals = append(m.ReadinessSignals, ValidatorReadinessSignal{})
+			if err := m.ReadinessSignals[len(m.ReadinessSignals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerEpochParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerEpochParams = append(m.ConsumerEpochParams, ChainEpochParams{})
+			if err := m.ConsumerEpochParams[len(m.ConsumerEpochParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochState == nil {
+				m.EpochState = &EpochState{}
+			}
+			if err := m.EpochState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -72,7 +72,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -89,7 +89,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -106,7 +106,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -127,7 +127,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -148,7 +148,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -169,7 +169,7 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 ccv timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -190,7 +190,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -211,7 +211,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -357,6 +357,15 @@ func TestValidateGenesisState(t *testing.T) {
 			),
 			false,
 		},
+		{
+			"invalid epoch state, epochs based on block height",
+			&types.GenesisState{
+				ValsetUpdateId: types.DefaultValsetUpdateID,
+				Params:         types.DefaultParams(),
+				EpochState:     &types.EpochState{Number: 1, StartHeight: 1, StartTime: time.Unix(0, 0).UTC()},
+			},
+			false,
+		},
 		{
 			"invalid params- invalid consumer registration fee denom",
 			types.NewGenesisState(
@@ -367,7 +376,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
@@ -384,7 +393,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 0, "", 20, 0, time.Hour),
 				nil,
 				nil,
 				nil,
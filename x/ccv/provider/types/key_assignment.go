@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
 	"strings"
 
@@ -8,9 +9,15 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+
 	ccvtypes "github.com/allinbits/interchain-security/x/ccv/types"
 )
 
+// ConsumerKeyPossessionDomain separates the consumer key possession payloads
+// from any other bytes that are signed with a consensus private key
+const ConsumerKeyPossessionDomain = "interchain-security/consumer-key-possession/v1"
+
 // A validator's consensus address on the provider chain.
 type ProviderConsAddress struct {
 	Address sdk.ConsAddress
@@ -61,6 +68,23 @@ func (c *ConsumerConsAddress) String() string {
 	return c.ToSdkConsAddr().String()
 }
 
+// ConsumerKeyPossessionBytes returns the payload that a validator signs with a consumer private key
+// to prove that it controls the key, when assigning it on the given consumer chain.
+// The provider address is the bech32 validator operator address.
+func ConsumerKeyPossessionBytes(chainID, providerAddr string, nonce uint64) []byte {
+	bz := []byte(ConsumerKeyPossessionDomain)
+	for _, s := range []string{chainID, providerAddr} {
+		bz = binary.BigEndian.AppendUint64(bz, uint64(len(s)))
+		bz = append(bz, s...)
+	}
+	return binary.BigEndian.AppendUint64(bz, nonce)
+}
+
+// SignConsumerKeyPossession signs the consumer key possession payload with the given consumer private key
+func SignConsumerKeyPossession(consumerPrivKey cmtcrypto.PrivKey, chainID, providerAddr string, nonce uint64) ([]byte, error) {
+	return consumerPrivKey.Sign(ConsumerKeyPossessionBytes(chainID, providerAddr, nonce))
+}
+
 // KeyAssignmentValidateBasic validates all the genesis state for key assignment
 // This is a utility. Key Assignment does not define any new proto types, but
 // has a lot of nested data.
@@ -195,6 +195,71 @@ const (
 	// minimum power required to be in the top N per consumer chain.
 	MinimumPowerInTopNBytePrefix
 
+	// DirtyValidatorBytePrefix is the byte prefix for storing the provider validators whose
+	// staking power or bonded status changed since the last epoch
+	DirtyValidatorBytePrefix
+
+	// DirtyConsumerValidatorBytePrefix is the byte prefix for storing the provider validators whose
+	// opt-in status or consumer key on a consumer chain changed since the last epoch
+	DirtyConsumerValidatorBytePrefix
+
+	// ConsumerValSetTrackedBytePrefix is the byte prefix for storing the consumer chains whose
+	// consumer validator set is updated incrementally from the dirty validators
+	ConsumerValSetTrackedBytePrefix
+
+	// ConsumerAddrsToPruneTimeIndexBytePrefix is the byte prefix for the cross-chain index
+	// of consumer addresses to prune, ordered by prune time and then by consumer chain ID
+	ConsumerAddrsToPruneTimeIndexBytePrefix
+
+	// ConsumerMetadataBytePrefix is the byte prefix for storing the metadata
+	// of each consumer chain
+	ConsumerMetadataBytePrefix
+
+	// ConsumerOwnerBytePrefix is the byte prefix for storing the owner account
+	// of each consumer chain
+	ConsumerOwnerBytePrefix
+
+	// PausedConsumerBytePrefix is the byte prefix for storing the pause
+	// of each paused consumer chain
+	PausedConsumerBytePrefix
+
+	// ConsumerToCatchUpBytePrefix is the byte prefix for storing the consumer chains
+	// resumed in the current block, which are sent a catch-up VSC packet at the end of the block
+	ConsumerToCatchUpBytePrefix
+
+	// StoppedConsumerBytePrefix is the byte prefix for storing the launch parameters
+	// of each stopped consumer chain, which can be relaunched from its exported state
+	StoppedConsumerBytePrefix
+
+	// KeyAssignmentNonceBytePrefix is the byte prefix for storing the last nonce used
+	// by each validator to prove the possession of a consumer key on each consumer chain
+	KeyAssignmentNonceBytePrefix
+
+	// ConsumerKeyRotationBytePrefix is the byte prefix for storing the consumer key
+	// rotations scheduled by each validator on each consumer chain
+	ConsumerKeyRotationBytePrefix
+
+	// KeyAssignmentHistoryBytePrefix is the byte prefix for storing the consumer keys
+	// assigned by each validator on each consumer chain
+	KeyAssignmentHistoryBytePrefix
+
+	// OptInPolicyBytePrefix is the byte prefix for storing the opt-in policy of each consumer chain
+	OptInPolicyBytePrefix
+
+	// OptInRecordBytePrefix is the byte prefix for storing when each validator last opted in to
+	// or out of each consumer chain
+	OptInRecordBytePrefix
+
+	// ReadinessSignalBytePrefix is the byte prefix for storing the readiness signals of the
+	// validators in the expected initial validator set of each consumer chain
+	ReadinessSignalBytePrefix
+
+	// ConsumerEpochParamsBytePrefix is the byte prefix for storing the epoch params of each consumer chain
+	ConsumerEpochParamsBytePrefix
+
+	// EpochStateByteKey is the byte key for storing the current epoch when the epochs are based on block time
+	EpochStateByteKey
+
 	// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO getAllKeyPrefixes() IN keys_test.go
 
 )
@@ -235,6 +300,11 @@ func SlashMeterReplenishTimeCandidateKey() []byte {
 	return []byte{SlashMeterReplenishTimeCandidateByteKey}
 }
 
+// EpochStateKey returns the key storing the current epoch when the epochs are based on block time
+func EpochStateKey() []byte {
+	return []byte{EpochStateByteKey}
+}
+
 // ChainToChannelKey returns the key under which the CCV channel ID will be stored for the given consumer chain.
 func ChainToChannelKey(chainID string) []byte {
 	return append([]byte{ChainToChannelBytePrefix}, []byte(chainID)...)
@@ -550,12 +620,106 @@ func ConsumerAddrsToPruneV2Key(chainID string, pruneTs time.Time) []byte {
 	return ChainIdAndTsKey(ConsumerAddrsToPruneV2BytePrefix, chainID, pruneTs)
 }
 
+// ConsumerAddrsToPruneTimeIndexKey returns the key of the cross-chain index entry recording
+// that chainID has consumer addresses to prune at pruneTs.
+// The key has the following format: ConsumerAddrsToPruneTimeIndexBytePrefix | pruneTs.UnixNano() | chainID
+func ConsumerAddrsToPruneTimeIndexKey(pruneTs time.Time, chainID string) []byte {
+	ts := uint64(pruneTs.UTC().UnixNano())
+	return ccvtypes.AppendMany(
+		// Append the prefix
+		[]byte{ConsumerAddrsToPruneTimeIndexBytePrefix},
+		// Append the time
+		sdk.Uint64ToBigEndian(ts),
+		// Append the chainId
+		[]byte(chainID),
+	)
+}
+
+// ParseConsumerAddrsToPruneTimeIndexKey returns the prune time and chain ID for a ConsumerAddrsToPruneTimeIndex key
+func ParseConsumerAddrsToPruneTimeIndexKey(bz []byte) (time.Time, string, error) {
+	if len(bz) < 9 || bz[0] != ConsumerAddrsToPruneTimeIndexBytePrefix {
+		return time.Time{}, "", fmt.Errorf("invalid consumer addresses to prune time index key: %X", bz)
+	}
+	pruneTs := time.Unix(0, int64(sdk.BigEndianToUint64(bz[1:9]))).UTC()
+	chainID := string(bz[9:])
+	return pruneTs, chainID, nil
+}
+
 // MinimumPowerInTopNKey returns the key used to store the minimum power required
 // to be in the top N for a given consumer chain.
 func MinimumPowerInTopNKey(chainID string) []byte {
 	return ChainIdWithLenKey(MinimumPowerInTopNBytePrefix, chainID)
 }
 
+// DirtyValidatorKey returns the key used to mark validator `providerAddr` as dirty,
+// i.e., its staking power or bonded status changed since the last epoch
+func DirtyValidatorKey(providerAddr ProviderConsAddress) []byte {
+	return append([]byte{DirtyValidatorBytePrefix}, providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// DirtyConsumerValidatorKey returns the key used to mark validator `providerAddr` as dirty on
+// a given consumer chain, i.e., its opt-in status or consumer key changed since the last epoch
+func DirtyConsumerValidatorKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return append(ChainIdWithLenKey(DirtyConsumerValidatorBytePrefix, chainID), providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// ConsumerValSetTrackedKey returns the key used to mark that the consumer validator set of
+// a given consumer chain is updated incrementally from the dirty validators
+func ConsumerValSetTrackedKey(chainID string) []byte {
+	return ChainIdWithLenKey(ConsumerValSetTrackedBytePrefix, chainID)
+}
+
+// ConsumerMetadataKey returns the key used to store the metadata of a given consumer chain
+func ConsumerMetadataKey(chainID string) []byte {
+	return ChainIdWithLenKey(ConsumerMetadataBytePrefix, chainID)
+}
+
+// ConsumerOwnerKey returns the key used to store the owner account of a given consumer chain
+func ConsumerOwnerKey(chainID string) []byte {
+	return ChainIdWithLenKey(ConsumerOwnerBytePrefix, chainID)
+}
+
+// PausedConsumerKey returns the key used to store the pause of a given consumer chain
+func PausedConsumerKey(chainID string) []byte {
+	return ChainIdWithLenKey(PausedConsumerBytePrefix, chainID)
+}
+
+// ConsumerToCatchUpKey returns the key used to mark that a given consumer chain
+// is sent a catch-up VSC packet at the end of the block
+func ConsumerToCatchUpKey(chainID string) []byte {
+	return ChainIdWithLenKey(ConsumerToCatchUpBytePrefix, chainID)
+}
+
+// StoppedConsumerKey returns the key used to store the launch parameters of a given stopped consumer chain
+func StoppedConsumerKey(chainID string) []byte {
+	return ChainIdWithLenKey(StoppedConsumerBytePrefix, chainID)
+}
+
+// KeyAssignmentNonceKey returns the key used to store the last nonce used by a validator
+// to prove the possession of a consumer key on a given consumer chain
+func KeyAssignmentNonceKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(KeyAssignmentNonceBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerKeyRotationKey returns the key used to store the consumer key rotation
+// scheduled by a validator on a given consumer chain
+func ConsumerKeyRotationKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ConsumerKeyRotationBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// KeyAssignmentHistoryKey returns the key used to store an entry of the key assignment
+// history of a validator on a given consumer chain
+func KeyAssignmentHistoryKey(chainID string, providerAddr ProviderConsAddress, index uint64) []byte {
+	addr := providerAddr.ToSdkConsAddr()
+	return ccvtypes.AppendMany(
+		ChainIdWithLenKey(KeyAssignmentHistoryBytePrefix, chainID),
+		// the address is length-prefixed, as it is followed by the index
+		[]byte{byte(len(addr))},
+		addr,
+		sdk.Uint64ToBigEndian(index),
+	)
+}
+
 // ValidatorSetCapKey returns the key used to store the validator set cap for a given consumer chain.
 func ValidatorSetCapKey(chainID string) []byte {
 	return ChainIdWithLenKey(ValidatorSetCapPrefix, chainID)
@@ -579,3 +743,25 @@ func DenylistCapKey(chainID string, providerAddr ProviderConsAddress) []byte {
 //
 // End of generic helpers section
 //
+
+// OptInPolicyKey returns the key used to store the opt-in policy of a given consumer chain
+func OptInPolicyKey(chainID string) []byte {
+	return ChainIdWithLenKey(OptInPolicyBytePrefix, chainID)
+}
+
+// OptInRecordKey returns the key used to store when a validator last opted in to or out of
+// a given consumer chain
+func OptInRecordKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(OptInRecordBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// ReadinessSignalKey returns the key used to store the readiness signal of a validator
+// on a given consumer chain
+func ReadinessSignalKey(chainID string, providerAddr ProviderConsAddress) []byte {
+	return ChainIdAndConsAddrKey(ReadinessSignalBytePrefix, chainID, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerEpochParamsKey returns the key used to store the epoch params of a given consumer chain
+func ConsumerEpochParamsKey(chainID string) []byte {
+	return ChainIdWithLenKey(ConsumerEpochParamsBytePrefix, chainID)
+}
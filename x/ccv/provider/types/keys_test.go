@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -53,6 +54,24 @@ func getAllKeyPrefixes() []byte {
 		providertypes.ConsumerRewardsAllocationBytePrefix,
 		providertypes.ParametersByteKey,
 		providertypes.ConsumerAddrsToPruneV2BytePrefix,
+		providertypes.MinimumPowerInTopNBytePrefix,
+		providertypes.DirtyValidatorBytePrefix,
+		providertypes.DirtyConsumerValidatorBytePrefix,
+		providertypes.ConsumerValSetTrackedBytePrefix,
+		providertypes.ConsumerAddrsToPruneTimeIndexBytePrefix,
+		providertypes.ConsumerMetadataBytePrefix,
+		providertypes.ConsumerOwnerBytePrefix,
+		providertypes.PausedConsumerBytePrefix,
+		providertypes.ConsumerToCatchUpBytePrefix,
+		providertypes.StoppedConsumerBytePrefix,
+		providertypes.KeyAssignmentNonceBytePrefix,
+		providertypes.ConsumerKeyRotationBytePrefix,
+		providertypes.KeyAssignmentHistoryBytePrefix,
+		providertypes.OptInPolicyBytePrefix,
+		providertypes.OptInRecordBytePrefix,
+		providertypes.ReadinessSignalBytePrefix,
+		providertypes.ConsumerEpochParamsBytePrefix,
+		providertypes.EpochStateByteKey,
 	}
 }
 
@@ -91,6 +110,24 @@ func getAllFullyDefinedKeys() [][]byte {
 		providertypes.SlashLogKey(providertypes.NewProviderConsAddress([]byte{0x05})),
 		providertypes.EquivocationEvidenceMinHeightKey("chainID"),
 		providertypes.ConsumerAddrsToPruneV2Key("chainID", time.Time{}),
+		providertypes.MinimumPowerInTopNKey("chainID"),
+		providertypes.DirtyValidatorKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.DirtyConsumerValidatorKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerValSetTrackedKey("chainID"),
+		providertypes.ConsumerAddrsToPruneTimeIndexKey(time.Time{}, "chainID"),
+		providertypes.ConsumerMetadataKey("chainID"),
+		providertypes.ConsumerOwnerKey("chainID"),
+		providertypes.PausedConsumerKey("chainID"),
+		providertypes.ConsumerToCatchUpKey("chainID"),
+		providertypes.StoppedConsumerKey("chainID"),
+		providertypes.KeyAssignmentNonceKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerKeyRotationKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.KeyAssignmentHistoryKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05}), 1),
+		providertypes.OptInPolicyKey("chainID"),
+		providertypes.OptInRecordKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ReadinessSignalKey("chainID", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerEpochParamsKey("chainID"),
+		providertypes.EpochStateKey(),
 	}
 }
 
@@ -199,6 +236,40 @@ func TestGlobalSlashEntryKeyAndParse(t *testing.T) {
 	}
 }
 
+// Tests the construction and parsing of ConsumerAddrsToPruneTimeIndex keys
+func TestConsumerAddrsToPruneTimeIndexKeyAndParse(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		pruneTs time.Time
+		chainID string
+	}{
+		{pruneTs: now, chainID: "chain-0"},
+		{pruneTs: now.Add(2 * time.Hour), chainID: "chain-7896978"},
+		{pruneTs: time.Unix(0, 0).UTC(), chainID: ""},
+	}
+
+	for _, test := range tests {
+		key := providertypes.ConsumerAddrsToPruneTimeIndexKey(test.pruneTs, test.chainID)
+		require.NotEmpty(t, key)
+		// This key should be of set length: prefix + 8 + chainID
+		require.Equal(t, 1+8+len(test.chainID), len(key))
+		parsedTs, parsedChainID, err := providertypes.ParseConsumerAddrsToPruneTimeIndexKey(key)
+		require.NoError(t, err)
+		require.Equal(t, test.pruneTs, parsedTs)
+		require.Equal(t, test.chainID, parsedChainID)
+	}
+
+	// keys are ordered by prune time first
+	require.Negative(t, bytes.Compare(
+		providertypes.ConsumerAddrsToPruneTimeIndexKey(now, "chain-b"),
+		providertypes.ConsumerAddrsToPruneTimeIndexKey(now.Add(time.Nanosecond), "chain-a"),
+	))
+
+	_, _, err := providertypes.ParseConsumerAddrsToPruneTimeIndexKey([]byte{providertypes.ConsumerAddrsToPruneV2BytePrefix})
+	require.Error(t, err)
+}
+
 // Tests the construction and parsing of ChainIdAndConsAddr keys
 func TestChainIdAndConsAddrAndParse(t *testing.T) {
 	cIds := []*cryptoutil.CryptoIdentity{
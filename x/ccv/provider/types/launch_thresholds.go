@@ -0,0 +1,22 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a stateless validation of the launch thresholds of a consumer chain
+func (t LaunchThresholds) Validate() error {
+	if t.MinPowerPercentage > 100 {
+		return errorsmod.Wrapf(ErrInvalidLaunchThresholds, "min power percentage %d is not in [0, 100]", t.MinPowerPercentage)
+	}
+	if t.MinReadyPowerPercentage > 100 {
+		return errorsmod.Wrapf(ErrInvalidLaunchThresholds, "min ready power percentage %d is not in [0, 100]", t.MinReadyPowerPercentage)
+	}
+	if t.PostponementInterval < 0 {
+		return errorsmod.Wrapf(ErrInvalidLaunchThresholds, "postponement interval %s cannot be negative", t.PostponementInterval)
+	}
+	if t.MaxPostponements > 0 && t.PostponementInterval == 0 {
+		return errorsmod.Wrap(ErrInvalidLaunchThresholds, "postponement interval cannot be zero if the launch can be postponed")
+	}
+	return nil
+}
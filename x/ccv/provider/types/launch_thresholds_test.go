@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/allinbits/interchain-security/x/ccv/provider/types"
+)
+
+func TestLaunchThresholdsValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		thresholds types.LaunchThresholds
+		expPass    bool
+	}{
+		{"empty thresholds", types.LaunchThresholds{}, true},
+		{
+			"all fields set",
+			types.LaunchThresholds{MinValidators: 5, MinPowerPercentage: 100, PostponementInterval: time.Hour, MaxPostponements: 3},
+			true,
+		},
+		{"drop without postponement", types.LaunchThresholds{MinValidators: 5}, true},
+		{"power percentage above 100", types.LaunchThresholds{MinPowerPercentage: 101}, false},
+		{"ready power percentage above 100", types.LaunchThresholds{MinReadyPowerPercentage: 101}, false},
+		{"negative postponement interval", types.LaunchThresholds{PostponementInterval: -time.Hour}, false},
+		{"postponements without interval", types.LaunchThresholds{MaxPostponements: 1}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.thresholds.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidLaunchThresholds, tc.name)
+		}
+	}
+}
@@ -143,6 +143,32 @@ func (cccp *ConsumerAdditionProposal) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, "unbonding period cannot be zero")
 	}
 
+	if cccp.Metadata != nil {
+		if err := cccp.Metadata.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
+	if err := validateOptionalOwner(cccp.Owner); err != nil {
+		return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if err := ccvtypes.ValidateAllowedKeyTypes(cccp.AllowedKeyTypes); err != nil {
+		return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if cccp.LaunchThresholds != nil {
+		if err := cccp.LaunchThresholds.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
+	if cccp.EpochParams != nil {
+		if err := cccp.EpochParams.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -256,6 +256,36 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 			),
 			false,
 		},
+		{
+			"allowed key types are valid",
+			withAllowedKeyTypes(types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+				"0.75",
+				10,
+				"",
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+				100,
+				"", // ConnectionId
+			), "ed25519", "secp256k1"),
+			true,
+		},
+		{
+			"allowed key types are invalid",
+			withAllowedKeyTypes(types.NewConsumerAdditionProposal("title", "description", "chainID", initialHeight, []byte("gen_hash"), []byte("bin_hash"), time.Now(),
+				"0.75",
+				10,
+				"",
+				10000,
+				100000000000,
+				100000000000,
+				100000000000,
+				100,
+				"", // ConnectionId
+			), "bls12_381"),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -269,6 +299,11 @@ func TestConsumerAdditionProposalValidateBasic(t *testing.T) {
 	}
 }
 
+func withAllowedKeyTypes(content govv1beta1.Content, keyTypes ...string) govv1beta1.Content {
+	content.(*types.ConsumerAdditionProposal).AllowedKeyTypes = keyTypes
+	return content
+}
+
 func TestMarshalConsumerAdditionProposal(t *testing.T) {
 	content := types.NewConsumerAdditionProposal("title", "description", "chainID", clienttypes.NewHeight(0, 1), []byte("gen_hash"), []byte("bin_hash"), time.Now().UTC(),
 		"0.75",
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
@@ -33,6 +34,14 @@ var (
 	_ sdk.Msg = (*MsgChangeRewardDenoms)(nil)
 	_ sdk.Msg = (*MsgSubmitConsumerMisbehaviour)(nil)
 	_ sdk.Msg = (*MsgSubmitConsumerDoubleVoting)(nil)
+	_ sdk.Msg = (*MsgUpdateConsumer)(nil)
+	_ sdk.Msg = (*MsgEmergencyPauseConsumer)(nil)
+	_ sdk.Msg = (*MsgPauseConsumer)(nil)
+	_ sdk.Msg = (*MsgResumeConsumer)(nil)
+	_ sdk.Msg = (*MsgConsumerRelaunch)(nil)
+	_ sdk.Msg = (*MsgScheduleConsumerKeyRotation)(nil)
+	_ sdk.Msg = (*MsgCancelConsumerKeyRotation)(nil)
+	_ sdk.Msg = (*MsgSignalConsumerReady)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgAssignConsumerKey)(nil)
 	_ sdk.HasValidateBasic = (*MsgConsumerAddition)(nil)
@@ -41,6 +50,16 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChangeRewardDenoms)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitConsumerMisbehaviour)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitConsumerDoubleVoting)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgEmergencyPauseConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgPauseConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgResumeConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgConsumerRelaunch)(nil)
+	_ sdk.HasValidateBasic = (*MsgOptIn)(nil)
+	_ sdk.HasValidateBasic = (*MsgOptOut)(nil)
+	_ sdk.HasValidateBasic = (*MsgScheduleConsumerKeyRotation)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelConsumerKeyRotation)(nil)
+	_ sdk.HasValidateBasic = (*MsgSignalConsumerReady)(nil)
 )
 
 // IsReservedChainId returns true if the chain id is reserved and cannot be reused.
@@ -69,14 +88,17 @@ func ValidateChainId(field, chainId string) error {
 
 // NewMsgAssignConsumerKey creates a new MsgAssignConsumerKey instance.
 // Delegator address and validator address are the same.
+// The consumer key signature is made over ConsumerKeyPossessionBytes, see SignConsumerKeyPossession.
 func NewMsgAssignConsumerKey(chainID string, providerValidatorAddress sdk.ValAddress,
-	consumerConsensusPubKey, signer string,
+	consumerConsensusPubKey, signer string, consumerKeySignature []byte, nonce uint64,
 ) (*MsgAssignConsumerKey, error) {
 	return &MsgAssignConsumerKey{
-		ChainId:      chainID,
-		ProviderAddr: providerValidatorAddress.String(),
-		ConsumerKey:  consumerConsensusPubKey,
-		Signer:       signer,
+		ChainId:              chainID,
+		ProviderAddr:         providerValidatorAddress.String(),
+		ConsumerKey:          consumerConsensusPubKey,
+		Signer:               signer,
+		ConsumerKeySignature: consumerKeySignature,
+		Nonce:                nonce,
 	}, nil
 }
 
@@ -128,6 +150,9 @@ func (msg MsgAssignConsumerKey) ValidateBasic() error {
 	if _, _, err := ParseConsumerKeyFromJson(msg.ConsumerKey); err != nil {
 		return ErrInvalidConsumerConsensusPubKey
 	}
+	if len(msg.ConsumerKeySignature) == 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerKeyPossession, "consumer key signature cannot be empty")
+	}
 	return nil
 }
 
@@ -304,6 +329,32 @@ func (msg *MsgConsumerAddition) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, "unbonding period cannot be zero")
 	}
 
+	if msg.Metadata != nil {
+		if err := msg.Metadata.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
+	if err := validateOptionalOwner(msg.Owner); err != nil {
+		return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if err := ccvtypes.ValidateAllowedKeyTypes(msg.AllowedKeyTypes); err != nil {
+		return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+	}
+
+	if msg.LaunchThresholds != nil {
+		if err := msg.LaunchThresholds.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
+	if msg.EpochParams != nil {
+		if err := msg.EpochParams.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerAdditionProposal, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -325,6 +376,233 @@ func (msg *MsgConsumerModification) ValidateBasic() error {
 		return err
 	}
 
+	if msg.Metadata != nil {
+		if err := msg.Metadata.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerModificationProposal, err.Error())
+		}
+	}
+
+	if err := validateOptionalOwner(msg.Owner); err != nil {
+		return errorsmod.Wrap(ErrInvalidConsumerModificationProposal, err.Error())
+	}
+
+	if msg.EpochParams != nil {
+		if err := msg.EpochParams.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidConsumerModificationProposal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// NewMsgUpdateConsumer creates a new MsgUpdateConsumer instance
+func NewMsgUpdateConsumer(chainID, owner string) *MsgUpdateConsumer {
+	return &MsgUpdateConsumer{ChainId: chainID, Owner: owner}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgUpdateConsumer) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "owner: %s", err)
+	}
+
+	if err := validateOptionalOwner(msg.NewOwner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "new owner: %s", err)
+	}
+
+	if msg.Metadata != nil {
+		if err := msg.Metadata.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if msg.SpawnTime != nil && msg.SpawnTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerUpdate, "spawn time cannot be zero")
+	}
+
+	if s := strings.TrimSpace(msg.NewChainId); s != "" {
+		if err := ValidateChainId("NewChainId", s); err != nil {
+			return err
+		}
+	}
+
+	if msg.NewOwner == "" && msg.Metadata == nil && msg.SpawnTime == nil && strings.TrimSpace(msg.NewChainId) == "" {
+		return errorsmod.Wrap(ErrInvalidConsumerUpdate, "nothing to update")
+	}
+
+	return nil
+}
+
+// MaxPauseReasonLength is the maximum length of the reason for pausing a consumer chain
+const MaxPauseReasonLength = 1000
+
+// NewMsgEmergencyPauseConsumer creates a new MsgEmergencyPauseConsumer instance
+func NewMsgEmergencyPauseConsumer(authority, chainID, reason string) *MsgEmergencyPauseConsumer {
+	return &MsgEmergencyPauseConsumer{Authority: authority, ChainId: chainID, Reason: reason}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgEmergencyPauseConsumer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "authority: %s", err)
+	}
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	if len(msg.Reason) > MaxPauseReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason exceeds max length %d", MaxPauseReasonLength)
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgPauseConsumer) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	if len(msg.Reason) > MaxPauseReasonLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reason exceeds max length %d", MaxPauseReasonLength)
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgResumeConsumer) ValidateBasic() error {
+	return ValidateChainId("ChainId", msg.ChainId)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgOptIn) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	if err := validateProviderAddrSigner(msg.ProviderAddr, msg.Signer); err != nil {
+		return err
+	}
+	if msg.ConsumerKey != "" && len(msg.ConsumerKeySignature) == 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerKeyPossession, "consumer key signature cannot be empty")
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgOptOut) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	return validateProviderAddrSigner(msg.ProviderAddr, msg.Signer)
+}
+
+// validateProviderAddrSigner checks that the provider validator address is valid
+// and that it belongs to the signer of the message
+func validateProviderAddrSigner(providerAddr, signer string) error {
+	valAddr, err := sdk.ValAddressFromBech32(providerAddr)
+	if err != nil {
+		return ErrInvalidProviderAddress
+	}
+	if sdk.AccAddress(valAddr.Bytes()).String() != signer {
+		return errorsmod.Wrapf(ErrInvalidProviderAddress, "provider validator address must be the same as the signer address")
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgScheduleConsumerKeyRotation) ValidateBasic() error {
+	// the consumer key and its possession proof are validated as in MsgAssignConsumerKey
+	assign := MsgAssignConsumerKey{
+		ChainId:              msg.ChainId,
+		ProviderAddr:         msg.ProviderAddr,
+		ConsumerKey:          msg.ConsumerKey,
+		Signer:               msg.Signer,
+		ConsumerKeySignature: msg.ConsumerKeySignature,
+		Nonce:                msg.Nonce,
+	}
+	if err := assign.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Height < 0 {
+		return errorsmod.Wrapf(ErrInvalidConsumerKeyRotation, "height cannot be negative: %d", msg.Height)
+	}
+	if (msg.Height == 0) == (msg.Epoch == 0) {
+		return errorsmod.Wrap(ErrInvalidConsumerKeyRotation, "exactly one of height and epoch must be set")
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgCancelConsumerKeyRotation) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return ErrInvalidProviderAddress
+	}
+	if sdk.AccAddress(valAddr.Bytes()).String() != msg.Signer {
+		return errorsmod.Wrapf(ErrInvalidProviderAddress, "provider validator address must be the same as the signer address")
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgSignalConsumerReady) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return ErrInvalidProviderAddress
+	}
+	if sdk.AccAddress(valAddr.Bytes()).String() != msg.Signer {
+		return errorsmod.Wrapf(ErrInvalidProviderAddress, "provider validator address must be the same as the signer address")
+	}
+	if len(msg.GenesisHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidReadinessSignal, "genesis hash cannot be empty")
+	}
+	if len(msg.BinaryHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidReadinessSignal, "binary hash cannot be empty")
+	}
+	return nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg *MsgConsumerRelaunch) ValidateBasic() error {
+	if err := ValidateChainId("ChainId", msg.ChainId); err != nil {
+		return err
+	}
+	if msg.InitialHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerRelaunch, "initial height cannot be zero")
+	}
+	// the client to the relaunched chain only accepts headers of the revision of its chain ID
+	if revision := clienttypes.ParseChainID(msg.ChainId); msg.InitialHeight.RevisionNumber != revision {
+		return errorsmod.Wrapf(ErrInvalidConsumerRelaunch,
+			"initial height revision number %d must be the revision number %d of chain ID %s",
+			msg.InitialHeight.RevisionNumber, revision, msg.ChainId)
+	}
+	if len(msg.GenesisHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerRelaunch, "genesis hash cannot be empty")
+	}
+	if len(msg.BinaryHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerRelaunch, "binary hash cannot be empty")
+	}
+	if msg.SpawnTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerRelaunch, "spawn time cannot be zero")
+	}
+	return nil
+}
+
+// validateOptionalOwner validates the owner account of a consumer chain, if any
+func validateOptionalOwner(owner string) error {
+	if owner == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", owner, err)
+	}
 	return nil
 }
 
@@ -2,10 +2,14 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	cryptoutil "github.com/allinbits/interchain-security/testutil/crypto"
 	"github.com/allinbits/interchain-security/x/ccv/provider/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,6 +32,7 @@ func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 		providerAddr string
 		signer       string
 		consumerKey  string
+		signature    []byte
 		expErr       bool
 	}{
 		{
@@ -58,12 +63,21 @@ func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 			signer:       acc1,
 			expErr:       true,
 		},
+		{
+			name:         "missing consumer key possession signature",
+			chainId:      "chainId",
+			providerAddr: valOpAddr1.String(),
+			consumerKey:  "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}",
+			signer:       acc1,
+			expErr:       true,
+		},
 		{
 			name:         "valid assign consumer key msg",
 			chainId:      "chainId",
 			providerAddr: valOpAddr1.String(),
 			consumerKey:  "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}",
 			signer:       acc1,
+			signature:    []byte("signature"),
 			expErr:       false,
 		},
 	}
@@ -71,17 +85,468 @@ func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.MsgAssignConsumerKey{
-				ChainId:      tc.chainId,
-				ConsumerKey:  tc.consumerKey,
-				ProviderAddr: tc.providerAddr,
-				Signer:       tc.signer,
+				ChainId:              tc.chainId,
+				ConsumerKey:          tc.consumerKey,
+				ProviderAddr:         tc.providerAddr,
+				Signer:               tc.signer,
+				ConsumerKeySignature: tc.signature,
+			}
+
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgOptInValidateBasic(t *testing.T) {
+	valOpAddr := cryptoutil.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress()
+	providerAddr := valOpAddr.String()
+	signer := sdk.AccAddress(valOpAddr.Bytes()).String()
+	otherSigner := sdk.AccAddress(cryptoutil.NewCryptoIdentityFromIntSeed(2).SDKValOpAddress().Bytes()).String()
+	consumerKey := "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}"
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgOptIn
+		expErr bool
+	}{
+		{
+			name:   "chain Id empty",
+			msg:    types.MsgOptIn{ProviderAddr: providerAddr, Signer: signer},
+			expErr: true,
+		},
+		{
+			name:   "invalid provider address",
+			msg:    types.MsgOptIn{ChainId: "chainId", ProviderAddr: "providerAddr", Signer: signer},
+			expErr: true,
+		},
+		{
+			name:   "provider address does not belong to the signer",
+			msg:    types.MsgOptIn{ChainId: "chainId", ProviderAddr: providerAddr, Signer: otherSigner},
+			expErr: true,
+		},
+		{
+			name:   "consumer key without possession signature",
+			msg:    types.MsgOptIn{ChainId: "chainId", ProviderAddr: providerAddr, Signer: signer, ConsumerKey: consumerKey, Nonce: 1},
+			expErr: true,
+		},
+		{
+			name:   "valid opt in msg without consumer key",
+			msg:    types.MsgOptIn{ChainId: "chainId", ProviderAddr: providerAddr, Signer: signer},
+			expErr: false,
+		},
+		{
+			name: "valid opt in msg with consumer key",
+			msg: types.MsgOptIn{
+				ChainId:              "chainId",
+				ProviderAddr:         providerAddr,
+				Signer:               signer,
+				ConsumerKey:          consumerKey,
+				ConsumerKeySignature: []byte("signature"),
+				Nonce:                1,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgOptOutValidateBasic(t *testing.T) {
+	valOpAddr := cryptoutil.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress()
+	providerAddr := valOpAddr.String()
+	signer := sdk.AccAddress(valOpAddr.Bytes()).String()
+	otherSigner := sdk.AccAddress(cryptoutil.NewCryptoIdentityFromIntSeed(2).SDKValOpAddress().Bytes()).String()
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgOptOut
+		expErr bool
+	}{
+		{
+			name:   "chain Id empty",
+			msg:    types.MsgOptOut{ProviderAddr: providerAddr, Signer: signer},
+			expErr: true,
+		},
+		{
+			name:   "invalid provider address",
+			msg:    types.MsgOptOut{ChainId: "chainId", ProviderAddr: "providerAddr", Signer: signer},
+			expErr: true,
+		},
+		{
+			name:   "provider address does not belong to the signer",
+			msg:    types.MsgOptOut{ChainId: "chainId", ProviderAddr: providerAddr, Signer: otherSigner},
+			expErr: true,
+		},
+		{
+			name:   "valid opt out msg",
+			msg:    types.MsgOptOut{ChainId: "chainId", ProviderAddr: providerAddr, Signer: signer},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgScheduleConsumerKeyRotationValidateBasic(t *testing.T) {
+	valOpAddr := cryptoutil.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress()
+	signer := sdk.AccAddress(valOpAddr.Bytes()).String()
+	consumerKey := "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}"
+
+	validMsg := func() types.MsgScheduleConsumerKeyRotation {
+		return types.MsgScheduleConsumerKeyRotation{
+			ChainId:              "chainId",
+			ProviderAddr:         valOpAddr.String(),
+			ConsumerKey:          consumerKey,
+			Signer:               signer,
+			ConsumerKeySignature: []byte("signature"),
+			Nonce:                1,
+			Epoch:                10,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgScheduleConsumerKeyRotation)
+		expErr   bool
+	}{
+		{"valid rotation at an epoch", func(*types.MsgScheduleConsumerKeyRotation) {}, false},
+		{"valid rotation at a height", func(msg *types.MsgScheduleConsumerKeyRotation) {
+			msg.Epoch, msg.Height = 0, 6000
+		}, false},
+		{"neither height nor epoch", func(msg *types.MsgScheduleConsumerKeyRotation) { msg.Epoch = 0 }, true},
+		{"height and epoch", func(msg *types.MsgScheduleConsumerKeyRotation) { msg.Height = 6000 }, true},
+		{"negative height", func(msg *types.MsgScheduleConsumerKeyRotation) {
+			msg.Epoch, msg.Height = 0, -1
+		}, true},
+		{"chain Id empty", func(msg *types.MsgScheduleConsumerKeyRotation) { msg.ChainId = "" }, true},
+		{"signer is not the validator", func(msg *types.MsgScheduleConsumerKeyRotation) {
+			msg.Signer = sdk.AccAddress([]byte("other")).String()
+		}, true},
+		{"invalid consumer key", func(msg *types.MsgScheduleConsumerKeyRotation) { msg.ConsumerKey = "key" }, true},
+		{"no possession signature", func(msg *types.MsgScheduleConsumerKeyRotation) { msg.ConsumerKeySignature = nil }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := validMsg()
+			tc.malleate(&msg)
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelConsumerKeyRotationValidateBasic(t *testing.T) {
+	valOpAddr := cryptoutil.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress()
+	signer := sdk.AccAddress(valOpAddr.Bytes()).String()
+
+	msg := types.MsgCancelConsumerKeyRotation{ChainId: "chainId", ProviderAddr: valOpAddr.String(), Signer: signer}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Signer = sdk.AccAddress([]byte("other")).String()
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.MsgCancelConsumerKeyRotation{ProviderAddr: valOpAddr.String(), Signer: signer}
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgSignalConsumerReadyValidateBasic(t *testing.T) {
+	valOpAddr := cryptoutil.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress()
+	signer := sdk.AccAddress(valOpAddr.Bytes()).String()
+	valid := func() *types.MsgSignalConsumerReady {
+		return &types.MsgSignalConsumerReady{
+			ChainId:      "chainId",
+			ProviderAddr: valOpAddr.String(),
+			GenesisHash:  []byte("gen_hash"),
+			BinaryHash:   []byte("bin_hash"),
+			Signer:       signer,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgSignalConsumerReady)
+		expErr   bool
+	}{
+		{"chain Id empty", func(msg *types.MsgSignalConsumerReady) { msg.ChainId = "" }, true},
+		{"invalid signer", func(msg *types.MsgSignalConsumerReady) { msg.Signer = sdk.AccAddress([]byte("other")).String() }, true},
+		{"empty genesis hash", func(msg *types.MsgSignalConsumerReady) { msg.GenesisHash = nil }, true},
+		{"empty binary hash", func(msg *types.MsgSignalConsumerReady) { msg.BinaryHash = nil }, true},
+		{"valid signal consumer ready msg", func(msg *types.MsgSignalConsumerReady) {}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := valid()
+			tc.malleate(msg)
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
 			}
+		})
+	}
+}
 
+func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner")).String()
+	spawnTime := time.Now()
+
+	testCases := []struct {
+		name   string
+		msg    types.MsgUpdateConsumer
+		expErr bool
+	}{
+		{
+			name:   "chain Id empty",
+			msg:    types.MsgUpdateConsumer{Owner: owner, NewOwner: owner},
+			expErr: true,
+		},
+		{
+			name:   "invalid owner",
+			msg:    types.MsgUpdateConsumer{ChainId: "chainId", Owner: "owner", NewOwner: owner},
+			expErr: true,
+		},
+		{
+			name:   "invalid new owner",
+			msg:    types.MsgUpdateConsumer{ChainId: "chainId", Owner: owner, NewOwner: "owner"},
+			expErr: true,
+		},
+		{
+			name:   "invalid metadata",
+			msg:    types.MsgUpdateConsumer{ChainId: "chainId", Owner: owner, Metadata: &types.ConsumerMetadata{Website: "consumer.zone"}},
+			expErr: true,
+		},
+		{
+			name:   "zero spawn time",
+			msg:    types.MsgUpdateConsumer{ChainId: "chainId", Owner: owner, SpawnTime: &time.Time{}},
+			expErr: true,
+		},
+		{
+			name:   "reserved new chain Id",
+			msg:    types.MsgUpdateConsumer{ChainId: "chainId", Owner: owner, NewChainId: "stride-1"},
+			expErr: true,
+		},
+		{
+			name:   "nothing to update",
+			msg:    types.MsgUpdateConsumer{ChainId: "chainId", Owner: owner},
+			expErr: true,
+		},
+		{
+			name: "valid update consumer msg",
+			msg: types.MsgUpdateConsumer{
+				ChainId:    "chainId",
+				Owner:      owner,
+				NewOwner:   owner,
+				Metadata:   &types.ConsumerMetadata{Name: "Consumer"},
+				SpawnTime:  &spawnTime,
+				NewChainId: "chainId-1",
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgEmergencyPauseConsumerValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("emergency")).String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgEmergencyPauseConsumer
+		expErr bool
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgEmergencyPauseConsumer("emergency", "chainId", "exploit"),
+			expErr: true,
+		},
+		{
+			name:   "chain Id empty",
+			msg:    types.NewMsgEmergencyPauseConsumer(authority, "", "exploit"),
+			expErr: true,
+		},
+		{
+			name:   "reason too long",
+			msg:    types.NewMsgEmergencyPauseConsumer(authority, "chainId", string(make([]byte, types.MaxPauseReasonLength+1))),
+			expErr: true,
+		},
+		{
+			name:   "valid emergency pause consumer msg",
+			msg:    types.NewMsgEmergencyPauseConsumer(authority, "chainId", "exploit"),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgPauseConsumerValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("gov")).String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgPauseConsumer
+		expErr bool
+	}{
+		{
+			name:   "chain Id empty",
+			msg:    &types.MsgPauseConsumer{Authority: authority, ChainId: "", Reason: "upgrade"},
+			expErr: true,
+		},
+		{
+			name:   "reason too long",
+			msg:    &types.MsgPauseConsumer{Authority: authority, ChainId: "chainId", Reason: string(make([]byte, types.MaxPauseReasonLength+1))},
+			expErr: true,
+		},
+		{
+			name:   "valid pause consumer msg",
+			msg:    &types.MsgPauseConsumer{Authority: authority, ChainId: "chainId", Reason: "upgrade"},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgConsumerRelaunchValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress([]byte("gov")).String()
+	valid := func() *types.MsgConsumerRelaunch {
+		return &types.MsgConsumerRelaunch{
+			Authority:     authority,
+			ChainId:       "chainId-2",
+			InitialHeight: clienttypes.NewHeight(2, 1),
+			GenesisHash:   []byte("gen_hash"),
+			BinaryHash:    []byte("bin_hash"),
+			SpawnTime:     time.Now(),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.MsgConsumerRelaunch)
+		expErr   bool
+	}{
+		{
+			name:     "chain Id empty",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.ChainId = "" },
+			expErr:   true,
+		},
+		{
+			name:     "zero initial height",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.InitialHeight = clienttypes.ZeroHeight() },
+			expErr:   true,
+		},
+		{
+			name:     "initial height revision number is not the one of the chain Id",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.InitialHeight = clienttypes.NewHeight(3, 1) },
+			expErr:   true,
+		},
+		{
+			name:     "initial height revision number is not zero for a chain Id without revision",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.ChainId = "chainId" },
+			expErr:   true,
+		},
+		{
+			name: "chain Id without revision",
+			malleate: func(msg *types.MsgConsumerRelaunch) {
+				msg.ChainId = "chainId"
+				msg.InitialHeight = clienttypes.NewHeight(0, 100)
+			},
+			expErr: false,
+		},
+		{
+			name:     "empty genesis hash",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.GenesisHash = nil },
+			expErr:   true,
+		},
+		{
+			name:     "empty binary hash",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.BinaryHash = nil },
+			expErr:   true,
+		},
+		{
+			name:     "zero spawn time",
+			malleate: func(msg *types.MsgConsumerRelaunch) { msg.SpawnTime = time.Time{} },
+			expErr:   true,
+		},
+		{
+			name:     "valid consumer relaunch msg",
+			malleate: func(msg *types.MsgConsumerRelaunch) {},
+			expErr:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := valid()
+			tc.malleate(msg)
 			err := msg.ValidateBasic()
 			if tc.expErr {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
+				// the client to the relaunched chain must be valid
+				clientState := ibctmtypes.NewClientState(msg.ChainId, ibctmtypes.DefaultTrustLevel,
+					time.Hour, 2*time.Hour, time.Second*10, msg.InitialHeight,
+					commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"})
+				require.NoError(t, clientState.Validate())
 			}
 		})
 	}
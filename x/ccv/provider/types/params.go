@@ -47,6 +47,23 @@ const (
 	// Current default values for blocks per epoch corresponds to about 1 hour, so with 24 being the
 	// minimum amount of epochs, this would imply that a validator has to validate at least for 1 day to receive rewards.
 	DefaultNumberOfEpochsToStartReceivingRewards = int64(24)
+
+	// DefaultMaxVSCPacketSize defines the default maximum number of validator updates and slash acks
+	// carried by a single VSC packet. Zero means that VSC packets are never split, which keeps
+	// the provider compatible with consumers that cannot reassemble split packets.
+	DefaultMaxVSCPacketSize = int64(0)
+
+	// DefaultKeyAssignmentHistorySize defines the default number of consumer keys kept in the key
+	// assignment history of a validator on a consumer chain.
+	DefaultKeyAssignmentHistorySize = int64(20)
+
+	// DefaultEpochDuration defines the default duration of an epoch based on block time.
+	// Zero means that the epochs are based on block height, i.e., made of BlocksPerEpoch blocks.
+	DefaultEpochDuration = time.Duration(0)
+
+	// DefaultStoppedConsumerRetentionPeriod defines the default duration for which a stopped
+	// consumer chain can be relaunched, i.e., four weeks.
+	DefaultStoppedConsumerRetentionPeriod = 4 * 7 * 24 * time.Hour
 )
 
 // Reflection based keys for params subspace
@@ -61,6 +78,11 @@ var (
 	KeyConsumerRewardDenomRegistrationFee    = []byte("ConsumerRewardDenomRegistrationFee")
 	KeyBlocksPerEpoch                        = []byte("BlocksPerEpoch")
 	KeyNumberOfEpochsToStartReceivingRewards = []byte("NumberOfEpochsToStartReceivingRewards")
+	KeyMaxVSCPacketSize                      = []byte("MaxVSCPacketSize")
+	KeyEmergencyAuthority                    = []byte("EmergencyAuthority")
+	KeyKeyAssignmentHistorySize              = []byte("KeyAssignmentHistorySize")
+	KeyEpochDuration                         = []byte("EpochDuration")
+	KeyStoppedConsumerRetentionPeriod        = []byte("StoppedConsumerRetentionPeriod")
 )
 
 // ParamKeyTable returns a key table with the necessary registered provider params
@@ -78,6 +100,11 @@ func NewParams(
 	consumerRewardDenomRegistrationFee sdk.Coin,
 	blocksPerEpoch int64,
 	numberOfEpochsToStartReceivingRewards int64,
+	maxVSCPacketSize int64,
+	emergencyAuthority string,
+	keyAssignmentHistorySize int64,
+	epochDuration time.Duration,
+	stoppedConsumerRetentionPeriod time.Duration,
 ) Params {
 	return Params{
 		TemplateClient:                        cs,
@@ -88,6 +115,11 @@ func NewParams(
 		ConsumerRewardDenomRegistrationFee:    consumerRewardDenomRegistrationFee,
 		BlocksPerEpoch:                        blocksPerEpoch,
 		NumberOfEpochsToStartReceivingRewards: numberOfEpochsToStartReceivingRewards,
+		MaxVscPacketSize:                      maxVSCPacketSize,
+		EmergencyAuthority:                    emergencyAuthority,
+		KeyAssignmentHistorySize:              keyAssignmentHistorySize,
+		EpochDuration:                         epochDuration,
+		StoppedConsumerRetentionPeriod:        stoppedConsumerRetentionPeriod,
 	}
 }
 
@@ -118,6 +150,11 @@ func DefaultParams() Params {
 		},
 		DefaultBlocksPerEpoch,
 		DefaultNumberOfEpochsToStartReceivingRewards,
+		DefaultMaxVSCPacketSize,
+		"", // no emergency authority
+		DefaultKeyAssignmentHistorySize,
+		DefaultEpochDuration,
+		DefaultStoppedConsumerRetentionPeriod,
 	)
 }
 
@@ -150,6 +187,21 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.NumberOfEpochsToStartReceivingRewards); err != nil {
 		return fmt.Errorf("number of epochs to start receiving rewards is invalid: %s", err)
 	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxVscPacketSize); err != nil {
+		return fmt.Errorf("max vsc packet size is invalid: %s", err)
+	}
+	if err := ValidateEmergencyAuthority(p.EmergencyAuthority); err != nil {
+		return fmt.Errorf("emergency authority is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.KeyAssignmentHistorySize); err != nil {
+		return fmt.Errorf("key assignment history size is invalid: %s", err)
+	}
+	if err := ValidateEpochDuration(p.EpochDuration); err != nil {
+		return fmt.Errorf("epoch duration is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.StoppedConsumerRetentionPeriod); err != nil {
+		return fmt.Errorf("stopped consumer retention period is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -164,6 +216,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyConsumerRewardDenomRegistrationFee, p.ConsumerRewardDenomRegistrationFee, ValidateCoin),
 		paramtypes.NewParamSetPair(KeyBlocksPerEpoch, p.BlocksPerEpoch, ccvtypes.ValidatePositiveInt64),
 		paramtypes.NewParamSetPair(KeyNumberOfEpochsToStartReceivingRewards, p.NumberOfEpochsToStartReceivingRewards, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyMaxVSCPacketSize, p.MaxVscPacketSize, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyEmergencyAuthority, p.EmergencyAuthority, ValidateEmergencyAuthority),
+		paramtypes.NewParamSetPair(KeyKeyAssignmentHistorySize, p.KeyAssignmentHistorySize, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyEpochDuration, p.EpochDuration, ValidateEpochDuration),
+		paramtypes.NewParamSetPair(KeyStoppedConsumerRetentionPeriod, p.StoppedConsumerRetentionPeriod, ccvtypes.ValidateNonNegativeDuration),
 	}
 }
 
@@ -206,3 +263,32 @@ func ValidateCoin(i interface{}) error {
 
 	return nil
 }
+
+// ValidateEmergencyAuthority validates the emergency authority param, which is either
+// empty or a bech32 account address
+func ValidateEmergencyAuthority(i interface{}) error {
+	authority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if authority == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateEpochDuration validates the duration of an epoch based on block time,
+// which is zero if the epochs are based on block height
+func ValidateEpochDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration < 0 {
+		return fmt.Errorf("duration cannot be negative")
+	}
+	return nil
+}
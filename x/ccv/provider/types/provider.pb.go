@@ -129,6 +129,31 @@ type ConsumerAdditionProposal struct {
 	// If connection_id is empty, a new client will be created and a new connection on top of this client will be
 	// established for the consumer chain.
 	ConnectionId string `protobuf:"bytes,20,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// (optional) The metadata describing how to run the consumer chain. It is
+	// stored in the consumer metadata registry when the proposal is executed.
+	Metadata *ConsumerMetadata `protobuf:"bytes,21,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// (optional) The account that can update the consumer chain with
+	// MsgUpdateConsumer, without going through governance.
+	Owner string `protobuf:"bytes,22,opt,name=owner,proto3" json:"owner,omitempty"`
+	// true if the proposal relaunches a stopped consumer chain from its exported
+	// state, see MsgConsumerRelaunch
+	Relaunch bool `protobuf:"varint,23,opt,name=relaunch,proto3" json:"relaunch,omitempty"`
+	// The consensus key types, e.g. "ed25519" or "secp256k1", that the
+	// validators of the consumer chain can use. If empty, only ed25519 keys are
+	// allowed. BLS12-381 keys are not supported.
+	AllowedKeyTypes []string `protobuf:"bytes,24,rep,name=allowed_key_types,json=allowedKeyTypes,proto3" json:"allowed_key_types,omitempty"`
+	// (optional) How long the validators that opt in to the consumer chain
+	// commit to validating it. If not set, validators can opt out at any time.
+	OptInPolicy *OptInPolicy `protobuf:"bytes,25,opt,name=opt_in_policy,json=optInPolicy,proto3" json:"opt_in_policy,omitempty"`
+	// (optional) The conditions the initial validator set of the consumer chain
+	// has to meet at spawn time. If they are not met, the launch is postponed.
+	LaunchThresholds *LaunchThresholds `protobuf:"bytes,26,opt,name=launch_thresholds,json=launchThresholds,proto3" json:"launch_thresholds,omitempty"`
+	// the number of times the launch of the consumer chain was postponed because
+	// its launch thresholds were not met, set by the provider
+	LaunchPostponements uint32 `protobuf:"varint,27,opt,name=launch_postponements,json=launchPostponements,proto3" json:"launch_postponements,omitempty"`
+	// (optional) The epochs of the consumer chain. If not set, the validator
+	// updates of the chain are queued at the end of every provider epoch.
+	EpochParams *ConsumerEpochParams `protobuf:"bytes,28,opt,name=epoch_params,json=epochParams,proto3" json:"epoch_params,omitempty"`
 }
 
 func (m *ConsumerAdditionProposal) Reset()      { *m = ConsumerAdditionProposal{} }
@@ -163,6 +188,417 @@ func (m *ConsumerAdditionProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsumerAdditionProposal proto.InternalMessageInfo
 
+// ConsumerMetadata contains the information validators need to find and run
+// a consumer chain. It is kept on the provider for as long as the consumer
+// chain is pending or running.
+type ConsumerMetadata struct {
+	// the human-readable name of the consumer chain
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// a description of the consumer chain
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the website of the consumer chain
+	Website string `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	// the URL of the source code repository of the consumer chain binary
+	BinaryRepository string `protobuf:"bytes,4,opt,name=binary_repository,json=binaryRepository,proto3" json:"binary_repository,omitempty"`
+	// the URL from which the consumer chain genesis file can be downloaded
+	GenesisUrl string `protobuf:"bytes,5,opt,name=genesis_url,json=genesisUrl,proto3" json:"genesis_url,omitempty"`
+	// the hex-encoded SHA-256 hash of the binary validators are expected to run
+	BinaryHash string `protobuf:"bytes,6,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+	// the hex-encoded SHA-256 hash of the consumer chain genesis file, without
+	// the consumer CCV module genesis state
+	GenesisHash string `protobuf:"bytes,7,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+}
+
+func (m *ConsumerMetadata) Reset()         { *m = ConsumerMetadata{} }
+func (m *ConsumerMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsumerMetadata) ProtoMessage()    {}
+func (*ConsumerMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{1}
+}
+func (m *ConsumerMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerMetadata.Merge(m, src)
+}
+func (m *ConsumerMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerMetadata proto.InternalMessageInfo
+
+func (m *ConsumerMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetBinaryRepository() string {
+	if m != nil {
+		return m.BinaryRepository
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetGenesisUrl() string {
+	if m != nil {
+		return m.GenesisUrl
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetBinaryHash() string {
+	if m != nil {
+		return m.BinaryHash
+	}
+	return ""
+}
+
+func (m *ConsumerMetadata) GetGenesisHash() string {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return ""
+}
+
+// ConsumerChainMetadata is used to export the consumer metadata registry,
+// i.e., the mapping from consumer chain IDs to ConsumerMetadata.
+type ConsumerChainMetadata struct {
+	ChainId  string           `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Metadata ConsumerMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *ConsumerChainMetadata) Reset()         { *m = ConsumerChainMetadata{} }
+func (m *ConsumerChainMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsumerChainMetadata) ProtoMessage()    {}
+func (*ConsumerChainMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{2}
+}
+func (m *ConsumerChainMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerChainMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerChainMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerChainMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerChainMetadata.Merge(m, src)
+}
+func (m *ConsumerChainMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerChainMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerChainMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerChainMetadata proto.InternalMessageInfo
+
+func (m *ConsumerChainMetadata) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerChainMetadata) GetMetadata() ConsumerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return ConsumerMetadata{}
+}
+
+// ConsumerPause describes why and when a consumer chain was paused. While a
+// consumer chain is paused, the provider sends it no VSC packets, ignores its
+// slash packets and does not allocate its rewards.
+type ConsumerPause struct {
+	// the account that paused the consumer chain
+	PausedBy string `protobuf:"bytes,1,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	// whether the chain was paused by the emergency authority
+	Emergency bool   `protobuf:"varint,2,opt,name=emergency,proto3" json:"emergency,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the provider height at which the chain was paused
+	PauseHeight int64     `protobuf:"varint,4,opt,name=pause_height,json=pauseHeight,proto3" json:"pause_height,omitempty"`
+	PauseTime   time.Time `protobuf:"bytes,5,opt,name=pause_time,json=pauseTime,proto3,stdtime" json:"pause_time"`
+}
+
+func (m *ConsumerPause) Reset()         { *m = ConsumerPause{} }
+func (m *ConsumerPause) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPause) ProtoMessage()    {}
+func (*ConsumerPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{3}
+}
+func (m *ConsumerPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPause.Merge(m, src)
+}
+func (m *ConsumerPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerPause proto.InternalMessageInfo
+
+func (m *ConsumerPause) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
+func (m *ConsumerPause) GetEmergency() bool {
+	if m != nil {
+		return m.Emergency
+	}
+	return false
+}
+
+func (m *ConsumerPause) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ConsumerPause) GetPauseHeight() int64 {
+	if m != nil {
+		return m.PauseHeight
+	}
+	return 0
+}
+
+func (m *ConsumerPause) GetPauseTime() time.Time {
+	if m != nil {
+		return m.PauseTime
+	}
+	return time.Time{}
+}
+
+// PausedConsumer is used to export the paused consumer chains
+type PausedConsumer struct {
+	ChainId string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pause   ConsumerPause `protobuf:"bytes,2,opt,name=pause,proto3" json:"pause"`
+}
+
+func (m *PausedConsumer) Reset()         { *m = PausedConsumer{} }
+func (m *PausedConsumer) String() string { return proto.CompactTextString(m) }
+func (*PausedConsumer) ProtoMessage()    {}
+func (*PausedConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{4}
+}
+func (m *PausedConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedConsumer.Merge(m, src)
+}
+func (m *PausedConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedConsumer proto.InternalMessageInfo
+
+func (m *PausedConsumer) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *PausedConsumer) GetPause() ConsumerPause {
+	if m != nil {
+		return m.Pause
+	}
+	return ConsumerPause{}
+}
+
+// StoppedConsumer records a stopped consumer chain, so that governance can
+// relaunch it from its exported state with MsgConsumerRelaunch.
+type StoppedConsumer struct {
+	// the parameters the chain was last launched with, including its metadata
+	// and owner
+	LaunchParams ConsumerAdditionProposal `protobuf:"bytes,1,opt,name=launch_params,json=launchParams,proto3" json:"launch_params"`
+	// the ID of the client to the stopped chain
+	ClientId string    `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	StopTime time.Time `protobuf:"bytes,3,opt,name=stop_time,json=stopTime,proto3,stdtime" json:"stop_time"`
+}
+
+func (m *StoppedConsumer) Reset()         { *m = StoppedConsumer{} }
+func (m *StoppedConsumer) String() string { return proto.CompactTextString(m) }
+func (*StoppedConsumer) ProtoMessage()    {}
+func (*StoppedConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{5}
+}
+func (m *StoppedConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoppedConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoppedConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoppedConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoppedConsumer.Merge(m, src)
+}
+func (m *StoppedConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoppedConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoppedConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoppedConsumer proto.InternalMessageInfo
+
+func (m *StoppedConsumer) GetLaunchParams() ConsumerAdditionProposal {
+	if m != nil {
+		return m.LaunchParams
+	}
+	return ConsumerAdditionProposal{}
+}
+
+func (m *StoppedConsumer) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *StoppedConsumer) GetStopTime() time.Time {
+	if m != nil {
+		return m.StopTime
+	}
+	return time.Time{}
+}
+
+// ConsumerOwner is used to export the owners of the consumer chains, i.e.,
+// the accounts that can update a consumer chain with MsgUpdateConsumer.
+type ConsumerOwner struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *ConsumerOwner) Reset()         { *m = ConsumerOwner{} }
+func (m *ConsumerOwner) String() string { return proto.CompactTextString(m) }
+func (*ConsumerOwner) ProtoMessage()    {}
+func (*ConsumerOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{6}
+}
+func (m *ConsumerOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerOwner.Merge(m, src)
+}
+func (m *ConsumerOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerOwner proto.InternalMessageInfo
+
+func (m *ConsumerOwner) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 // ConsumerRemovalProposal is a governance proposal on the provider chain to
 // remove (and stop) a consumer chain. If it passes, all the consumer chain's
 // state is removed from the provider chain. The outstanding unbonding operation
@@ -184,7 +620,7 @@ func (m *ConsumerRemovalProposal) Reset()         { *m = ConsumerRemovalProposal
 func (m *ConsumerRemovalProposal) String() string { return proto.CompactTextString(m) }
 func (*ConsumerRemovalProposal) ProtoMessage()    {}
 func (*ConsumerRemovalProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{1}
+	return fileDescriptor_f22ec409a72b7b72, []int{7}
 }
 func (m *ConsumerRemovalProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +719,7 @@ func (m *ConsumerModificationProposal) Reset()         { *m = ConsumerModificati
 func (m *ConsumerModificationProposal) String() string { return proto.CompactTextString(m) }
 func (*ConsumerModificationProposal) ProtoMessage()    {}
 func (*ConsumerModificationProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{2}
+	return fileDescriptor_f22ec409a72b7b72, []int{8}
 }
 func (m *ConsumerModificationProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +826,7 @@ func (m *EquivocationProposal) Reset()         { *m = EquivocationProposal{} }
 func (m *EquivocationProposal) String() string { return proto.CompactTextString(m) }
 func (*EquivocationProposal) ProtoMessage()    {}
 func (*EquivocationProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{3}
+	return fileDescriptor_f22ec409a72b7b72, []int{9}
 }
 func (m *EquivocationProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +894,7 @@ func (m *ChangeRewardDenomsProposal) Reset()         { *m = ChangeRewardDenomsPr
 func (m *ChangeRewardDenomsProposal) String() string { return proto.CompactTextString(m) }
 func (*ChangeRewardDenomsProposal) ProtoMessage()    {}
 func (*ChangeRewardDenomsProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{4}
+	return fileDescriptor_f22ec409a72b7b72, []int{10}
 }
 func (m *ChangeRewardDenomsProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +975,7 @@ func (m *GlobalSlashEntry) Reset()         { *m = GlobalSlashEntry{} }
 func (m *GlobalSlashEntry) String() string { return proto.CompactTextString(m) }
 func (*GlobalSlashEntry) ProtoMessage()    {}
 func (*GlobalSlashEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{5}
+	return fileDescriptor_f22ec409a72b7b72, []int{11}
 }
 func (m *GlobalSlashEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,13 +1053,36 @@ type Params struct {
 	// The number of epochs a validator has to validate a consumer chain in order
 	// to start receiving rewards from that chain.
 	NumberOfEpochsToStartReceivingRewards int64 `protobuf:"varint,11,opt,name=number_of_epochs_to_start_receiving_rewards,json=numberOfEpochsToStartReceivingRewards,proto3" json:"number_of_epochs_to_start_receiving_rewards,omitempty"`
+	// The maximum number of validator updates and slash acks carried by a
+	// single VSC packet. Larger validator set changes are split into several
+	// packets with the same valset update id. Zero disables splitting.
+	MaxVscPacketSize int64 `protobuf:"varint,12,opt,name=max_vsc_packet_size,json=maxVscPacketSize,proto3" json:"max_vsc_packet_size,omitempty"`
+	// The account, e.g., a multisig of a security council, that can pause a
+	// consumer chain with MsgEmergencyPauseConsumer. A paused consumer chain is
+	// resumed or removed by governance. Empty disables the emergency pause.
+	EmergencyAuthority string `protobuf:"bytes,13,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
+	// The number of consumer keys kept in the key assignment history of a
+	// validator on a consumer chain. The oldest entries are removed once the
+	// history is full. Zero disables the key assignment history.
+	KeyAssignmentHistorySize int64 `protobuf:"varint,14,opt,name=key_assignment_history_size,json=keyAssignmentHistorySize,proto3" json:"key_assignment_history_size,omitempty"`
+	// The duration of an epoch when the epochs are based on block time, e.g.,
+	// 6h. An epoch then ends with the first block whose time is at least the
+	// start time of the epoch plus this duration. Zero keeps the epochs of
+	// blocks_per_epoch blocks. The consumer chains with an epoch length of their
+	// own keep their epochs of blocks. The opt-in commitments and cooldowns are
+	// counted in the epochs of the consumer chain.
+	EpochDuration time.Duration `protobuf:"bytes,15,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+	// The duration for which a stopped consumer chain can be relaunched with
+	// MsgConsumerRelaunch. The records of the chains stopped for longer are
+	// pruned. Zero disables the relaunch of stopped consumer chains.
+	StoppedConsumerRetentionPeriod time.Duration `protobuf:"bytes,16,opt,name=stopped_consumer_retention_period,json=stoppedConsumerRetentionPeriod,proto3,stdduration" json:"stopped_consumer_retention_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{6}
+	return fileDescriptor_f22ec409a72b7b72, []int{12}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,17 +1167,52 @@ func (m *Params) GetNumberOfEpochsToStartReceivingRewards() int64 {
 	return 0
 }
 
-// SlashAcks contains cons addresses of consumer chain validators
-// successfully slashed on the provider chain.
-type SlashAcks struct {
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+func (m *Params) GetMaxVscPacketSize() int64 {
+	if m != nil {
+		return m.MaxVscPacketSize
+	}
+	return 0
+}
+
+func (m *Params) GetEmergencyAuthority() string {
+	if m != nil {
+		return m.EmergencyAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetKeyAssignmentHistorySize() int64 {
+	if m != nil {
+		return m.KeyAssignmentHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetStoppedConsumerRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.StoppedConsumerRetentionPeriod
+	}
+	return 0
+}
+
+// SlashAcks contains cons addresses of consumer chain validators
+// successfully slashed on the provider chain.
+type SlashAcks struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
 func (m *SlashAcks) Reset()         { *m = SlashAcks{} }
 func (m *SlashAcks) String() string { return proto.CompactTextString(m) }
 func (*SlashAcks) ProtoMessage()    {}
 func (*SlashAcks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{7}
+	return fileDescriptor_f22ec409a72b7b72, []int{13}
 }
 func (m *SlashAcks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +1259,7 @@ func (m *ConsumerAdditionProposals) Reset()         { *m = ConsumerAdditionPropo
 func (m *ConsumerAdditionProposals) String() string { return proto.CompactTextString(m) }
 func (*ConsumerAdditionProposals) ProtoMessage()    {}
 func (*ConsumerAdditionProposals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{8}
+	return fileDescriptor_f22ec409a72b7b72, []int{14}
 }
 func (m *ConsumerAdditionProposals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +1306,7 @@ func (m *ConsumerRemovalProposals) Reset()         { *m = ConsumerRemovalProposa
 func (m *ConsumerRemovalProposals) String() string { return proto.CompactTextString(m) }
 func (*ConsumerRemovalProposals) ProtoMessage()    {}
 func (*ConsumerRemovalProposals) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{9}
+	return fileDescriptor_f22ec409a72b7b72, []int{15}
 }
 func (m *ConsumerRemovalProposals) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +1351,7 @@ func (m *AddressList) Reset()         { *m = AddressList{} }
 func (m *AddressList) String() string { return proto.CompactTextString(m) }
 func (*AddressList) ProtoMessage()    {}
 func (*AddressList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{10}
+	return fileDescriptor_f22ec409a72b7b72, []int{16}
 }
 func (m *AddressList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -903,7 +1397,7 @@ func (m *ChannelToChain) Reset()         { *m = ChannelToChain{} }
 func (m *ChannelToChain) String() string { return proto.CompactTextString(m) }
 func (*ChannelToChain) ProtoMessage()    {}
 func (*ChannelToChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{11}
+	return fileDescriptor_f22ec409a72b7b72, []int{17}
 }
 func (m *ChannelToChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1449,7 @@ func (m *ValidatorSetChangePackets) Reset()         { *m = ValidatorSetChangePac
 func (m *ValidatorSetChangePackets) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetChangePackets) ProtoMessage()    {}
 func (*ValidatorSetChangePackets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{12}
+	return fileDescriptor_f22ec409a72b7b72, []int{18}
 }
 func (m *ValidatorSetChangePackets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1001,7 +1495,7 @@ func (m *KeyAssignmentReplacement) Reset()         { *m = KeyAssignmentReplaceme
 func (m *KeyAssignmentReplacement) String() string { return proto.CompactTextString(m) }
 func (*KeyAssignmentReplacement) ProtoMessage()    {}
 func (*KeyAssignmentReplacement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{13}
+	return fileDescriptor_f22ec409a72b7b72, []int{19}
 }
 func (m *KeyAssignmentReplacement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1558,7 @@ func (m *ValidatorConsumerPubKey) Reset()         { *m = ValidatorConsumerPubKey
 func (m *ValidatorConsumerPubKey) String() string { return proto.CompactTextString(m) }
 func (*ValidatorConsumerPubKey) ProtoMessage()    {}
 func (*ValidatorConsumerPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{14}
+	return fileDescriptor_f22ec409a72b7b72, []int{20}
 }
 func (m *ValidatorConsumerPubKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,27 +1608,26 @@ func (m *ValidatorConsumerPubKey) GetConsumerKey() *crypto.PublicKey {
 	return nil
 }
 
-// Used to serialize the ValidatorConsumerAddr index from key assignment
-// ValidatorByConsumerAddr: (chainID, consumerAddr consAddr) -> providerAddr
-// consAddr
-type ValidatorByConsumerAddr struct {
+// Used to serialize the last nonces used in consumer key possession proofs
+// KeyAssignmentNonce: (chainID, providerAddr consAddr) -> nonce
+type KeyAssignmentNonce struct {
 	ChainId      string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	ConsumerAddr []byte `protobuf:"bytes,2,opt,name=consumer_addr,json=consumerAddr,proto3" json:"consumer_addr,omitempty"`
-	ProviderAddr []byte `protobuf:"bytes,3,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	ProviderAddr []byte `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *ValidatorByConsumerAddr) Reset()         { *m = ValidatorByConsumerAddr{} }
-func (m *ValidatorByConsumerAddr) String() string { return proto.CompactTextString(m) }
-func (*ValidatorByConsumerAddr) ProtoMessage()    {}
-func (*ValidatorByConsumerAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{15}
+func (m *KeyAssignmentNonce) Reset()         { *m = KeyAssignmentNonce{} }
+func (m *KeyAssignmentNonce) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentNonce) ProtoMessage()    {}
+func (*KeyAssignmentNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{21}
 }
-func (m *ValidatorByConsumerAddr) XXX_Unmarshal(b []byte) error {
+func (m *KeyAssignmentNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorByConsumerAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KeyAssignmentNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorByConsumerAddr.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KeyAssignmentNonce.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1144,59 +1637,62 @@ func (m *ValidatorByConsumerAddr) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ValidatorByConsumerAddr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorByConsumerAddr.Merge(m, src)
+func (m *KeyAssignmentNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentNonce.Merge(m, src)
 }
-func (m *ValidatorByConsumerAddr) XXX_Size() int {
+func (m *KeyAssignmentNonce) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorByConsumerAddr) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorByConsumerAddr.DiscardUnknown(m)
+func (m *KeyAssignmentNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentNonce.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidatorByConsumerAddr proto.InternalMessageInfo
+var xxx_messageInfo_KeyAssignmentNonce proto.InternalMessageInfo
 
-func (m *ValidatorByConsumerAddr) GetChainId() string {
+func (m *KeyAssignmentNonce) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *ValidatorByConsumerAddr) GetConsumerAddr() []byte {
+func (m *KeyAssignmentNonce) GetProviderAddr() []byte {
 	if m != nil {
-		return m.ConsumerAddr
+		return m.ProviderAddr
 	}
 	return nil
 }
 
-func (m *ValidatorByConsumerAddr) GetProviderAddr() []byte {
+func (m *KeyAssignmentNonce) GetNonce() uint64 {
 	if m != nil {
-		return m.ProviderAddr
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
-// Used to serialize the ConsumerAddrsToPruneV2 index from key assignment
-// ConsumerAddrsToPruneV2: (chainID, pruneTs time.Time) -> consumerAddrs AddressList
-type ConsumerAddrsToPruneV2 struct {
-	ChainId       string       `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	PruneTs       time.Time    `protobuf:"bytes,2,opt,name=prune_ts,json=pruneTs,proto3,stdtime" json:"prune_ts"`
-	ConsumerAddrs *AddressList `protobuf:"bytes,3,opt,name=consumer_addrs,json=consumerAddrs,proto3" json:"consumer_addrs,omitempty"`
+// ConsumerKeyRotation is a consumer key assignment scheduled by a validator
+// on a running consumer chain. The key is assigned at the end of the epoch
+// that ends at apply_height, so that the operator can prepare the node that
+// uses the new key while the old key is still in use.
+type ConsumerKeyRotation struct {
+	ConsumerKey crypto.PublicKey `protobuf:"bytes,1,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key"`
+	// the provider height at which the key is assigned, always the last height
+	// of an epoch
+	ApplyHeight int64 `protobuf:"varint,2,opt,name=apply_height,json=applyHeight,proto3" json:"apply_height,omitempty"`
 }
 
-func (m *ConsumerAddrsToPruneV2) Reset()         { *m = ConsumerAddrsToPruneV2{} }
-func (m *ConsumerAddrsToPruneV2) String() string { return proto.CompactTextString(m) }
-func (*ConsumerAddrsToPruneV2) ProtoMessage()    {}
-func (*ConsumerAddrsToPruneV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{16}
+func (m *ConsumerKeyRotation) Reset()         { *m = ConsumerKeyRotation{} }
+func (m *ConsumerKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ConsumerKeyRotation) ProtoMessage()    {}
+func (*ConsumerKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{22}
 }
-func (m *ConsumerAddrsToPruneV2) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerAddrsToPruneV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerAddrsToPruneV2.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerKeyRotation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1206,70 +1702,126 @@ func (m *ConsumerAddrsToPruneV2) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ConsumerAddrsToPruneV2) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerAddrsToPruneV2.Merge(m, src)
+func (m *ConsumerKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerKeyRotation.Merge(m, src)
 }
-func (m *ConsumerAddrsToPruneV2) XXX_Size() int {
+func (m *ConsumerKeyRotation) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerAddrsToPruneV2) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerAddrsToPruneV2.DiscardUnknown(m)
+func (m *ConsumerKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerKeyRotation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerAddrsToPruneV2 proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerKeyRotation proto.InternalMessageInfo
 
-func (m *ConsumerAddrsToPruneV2) GetChainId() string {
+func (m *ConsumerKeyRotation) GetConsumerKey() crypto.PublicKey {
 	if m != nil {
-		return m.ChainId
+		return m.ConsumerKey
 	}
-	return ""
+	return crypto.PublicKey{}
 }
 
-func (m *ConsumerAddrsToPruneV2) GetPruneTs() time.Time {
+func (m *ConsumerKeyRotation) GetApplyHeight() int64 {
 	if m != nil {
-		return m.PruneTs
+		return m.ApplyHeight
 	}
-	return time.Time{}
+	return 0
 }
 
-func (m *ConsumerAddrsToPruneV2) GetConsumerAddrs() *AddressList {
-	if m != nil {
-		return m.ConsumerAddrs
+// Used to serialize the scheduled consumer key rotations
+// ConsumerKeyRotation: (chainID, providerAddr consAddr) -> ConsumerKeyRotation
+type ScheduledConsumerKeyRotation struct {
+	ChainId      string              `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProviderAddr []byte              `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Rotation     ConsumerKeyRotation `protobuf:"bytes,3,opt,name=rotation,proto3" json:"rotation"`
+}
+
+func (m *ScheduledConsumerKeyRotation) Reset()         { *m = ScheduledConsumerKeyRotation{} }
+func (m *ScheduledConsumerKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ScheduledConsumerKeyRotation) ProtoMessage()    {}
+func (*ScheduledConsumerKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{23}
+}
+func (m *ScheduledConsumerKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledConsumerKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledConsumerKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ScheduledConsumerKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledConsumerKeyRotation.Merge(m, src)
+}
+func (m *ScheduledConsumerKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledConsumerKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledConsumerKeyRotation.DiscardUnknown(m)
 }
 
-// ConsumerValidator is used to facilitate epoch-based transitions. It contains
-// relevant info for a validator that is expected to validate on a consumer
-// chain during an epoch.
-type ConsumerValidator struct {
-	// validator's consensus address on the provider chain
-	ProviderConsAddr []byte `protobuf:"bytes,1,opt,name=provider_cons_addr,json=providerConsAddr,proto3" json:"provider_cons_addr,omitempty"`
-	// voting power the validator has during this epoch
-	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
-	// public key the validator uses on the consumer chain during this epoch
-	ConsumerPublicKey *crypto.PublicKey `protobuf:"bytes,3,opt,name=consumer_public_key,json=consumerPublicKey,proto3" json:"consumer_public_key,omitempty"`
-	// height the validator had when it FIRST became a consumer validator
-	// If a validator becomes a consumer validator at height `H` and is
-	// continuously a consumer validator for all the upcoming epochs, then the
-	// height of the validator SHOULD remain `H`. This height only resets to a
-	// different height if a validator stops being a consumer validator during an
-	// epoch and later becomes again a consumer validator.
-	JoinHeight int64 `protobuf:"varint,4,opt,name=join_height,json=joinHeight,proto3" json:"join_height,omitempty"`
+var xxx_messageInfo_ScheduledConsumerKeyRotation proto.InternalMessageInfo
+
+func (m *ScheduledConsumerKeyRotation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *ConsumerValidator) Reset()         { *m = ConsumerValidator{} }
-func (m *ConsumerValidator) String() string { return proto.CompactTextString(m) }
-func (*ConsumerValidator) ProtoMessage()    {}
-func (*ConsumerValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{17}
+func (m *ScheduledConsumerKeyRotation) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
 }
-func (m *ConsumerValidator) XXX_Unmarshal(b []byte) error {
+
+func (m *ScheduledConsumerKeyRotation) GetRotation() ConsumerKeyRotation {
+	if m != nil {
+		return m.Rotation
+	}
+	return ConsumerKeyRotation{}
+}
+
+// KeyAssignmentRecord records a consumer key assigned by a validator on a
+// consumer chain, so that the key used by the validator at a given time is
+// known also after the mapping from its consumer address is pruned
+type KeyAssignmentRecord struct {
+	ConsumerKey crypto.PublicKey `protobuf:"bytes,1,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key"`
+	// the provider height at which the key was assigned
+	AssignedHeight int64 `protobuf:"varint,2,opt,name=assigned_height,json=assignedHeight,proto3" json:"assigned_height,omitempty"`
+	// the valset update id when the key was assigned. This is a lower bound: the
+	// key is carried by the first VSC packet sent to the consumer chain with
+	// this or a greater valset update id, e.g., at the end of the epoch of the
+	// chain, or once the validator is in the validator set of the chain.
+	EffectiveVscId uint64 `protobuf:"varint,3,opt,name=effective_vsc_id,json=effectiveVscId,proto3" json:"effective_vsc_id,omitempty"`
+	// the provider height at which the key was replaced by another one, 0 if
+	// the key is still assigned
+	SupersededHeight int64 `protobuf:"varint,4,opt,name=superseded_height,json=supersededHeight,proto3" json:"superseded_height,omitempty"`
+	// the valset update id when the key was replaced by another one, 0 if the
+	// key is still assigned. Like effective_vsc_id, this is a lower bound.
+	SupersededVscId uint64 `protobuf:"varint,5,opt,name=superseded_vsc_id,json=supersededVscId,proto3" json:"superseded_vsc_id,omitempty"`
+}
+
+func (m *KeyAssignmentRecord) Reset()         { *m = KeyAssignmentRecord{} }
+func (m *KeyAssignmentRecord) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentRecord) ProtoMessage()    {}
+func (*KeyAssignmentRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{24}
+}
+func (m *KeyAssignmentRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KeyAssignmentRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerValidator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KeyAssignmentRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1279,65 +1831,75 @@ func (m *ConsumerValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ConsumerValidator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerValidator.Merge(m, src)
+func (m *KeyAssignmentRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentRecord.Merge(m, src)
 }
-func (m *ConsumerValidator) XXX_Size() int {
+func (m *KeyAssignmentRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerValidator) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerValidator.DiscardUnknown(m)
+func (m *KeyAssignmentRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerValidator proto.InternalMessageInfo
+var xxx_messageInfo_KeyAssignmentRecord proto.InternalMessageInfo
 
-func (m *ConsumerValidator) GetProviderConsAddr() []byte {
+func (m *KeyAssignmentRecord) GetConsumerKey() crypto.PublicKey {
 	if m != nil {
-		return m.ProviderConsAddr
+		return m.ConsumerKey
 	}
-	return nil
+	return crypto.PublicKey{}
 }
 
-func (m *ConsumerValidator) GetPower() int64 {
+func (m *KeyAssignmentRecord) GetAssignedHeight() int64 {
 	if m != nil {
-		return m.Power
+		return m.AssignedHeight
 	}
 	return 0
 }
 
-func (m *ConsumerValidator) GetConsumerPublicKey() *crypto.PublicKey {
+func (m *KeyAssignmentRecord) GetEffectiveVscId() uint64 {
 	if m != nil {
-		return m.ConsumerPublicKey
+		return m.EffectiveVscId
 	}
-	return nil
+	return 0
 }
 
-func (m *ConsumerValidator) GetJoinHeight() int64 {
+func (m *KeyAssignmentRecord) GetSupersededHeight() int64 {
 	if m != nil {
-		return m.JoinHeight
+		return m.SupersededHeight
 	}
 	return 0
 }
 
-// ConsumerRewardsAllocation stores the rewards allocated by a consumer chain
-// to the consumer rewards pool. It is used to allocate the tokens to the
-// consumer opted-in validators and the community pool during BeginBlock.
-type ConsumerRewardsAllocation struct {
-	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+func (m *KeyAssignmentRecord) GetSupersededVscId() uint64 {
+	if m != nil {
+		return m.SupersededVscId
+	}
+	return 0
 }
 
-func (m *ConsumerRewardsAllocation) Reset()         { *m = ConsumerRewardsAllocation{} }
-func (m *ConsumerRewardsAllocation) String() string { return proto.CompactTextString(m) }
-func (*ConsumerRewardsAllocation) ProtoMessage()    {}
-func (*ConsumerRewardsAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{18}
+// Used to serialize the key assignment history
+// KeyAssignmentHistory: (chainID, providerAddr consAddr, index) ->
+// KeyAssignmentRecord
+type KeyAssignmentHistoryEntry struct {
+	ChainId      string              `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProviderAddr []byte              `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Index        uint64              `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Record       KeyAssignmentRecord `protobuf:"bytes,4,opt,name=record,proto3" json:"record"`
 }
-func (m *ConsumerRewardsAllocation) XXX_Unmarshal(b []byte) error {
+
+func (m *KeyAssignmentHistoryEntry) Reset()         { *m = KeyAssignmentHistoryEntry{} }
+func (m *KeyAssignmentHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentHistoryEntry) ProtoMessage()    {}
+func (*KeyAssignmentHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{25}
+}
+func (m *KeyAssignmentHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ConsumerRewardsAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KeyAssignmentHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ConsumerRewardsAllocation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KeyAssignmentHistoryEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1347,977 +1909,1198 @@ func (m *ConsumerRewardsAllocation) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ConsumerRewardsAllocation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConsumerRewardsAllocation.Merge(m, src)
+func (m *KeyAssignmentHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentHistoryEntry.Merge(m, src)
 }
-func (m *ConsumerRewardsAllocation) XXX_Size() int {
+func (m *KeyAssignmentHistoryEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *ConsumerRewardsAllocation) XXX_DiscardUnknown() {
-	xxx_messageInfo_ConsumerRewardsAllocation.DiscardUnknown(m)
+func (m *KeyAssignmentHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentHistoryEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ConsumerRewardsAllocation proto.InternalMessageInfo
+var xxx_messageInfo_KeyAssignmentHistoryEntry proto.InternalMessageInfo
 
-func (m *ConsumerRewardsAllocation) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *KeyAssignmentHistoryEntry) GetChainId() string {
 	if m != nil {
-		return m.Rewards
+		return m.ChainId
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
-	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
-	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
-	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
-	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
-	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
-	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
-	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
-	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
-	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
-	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
-	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
-	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
-	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
-	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
-	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
-	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
-	proto.RegisterType((*ConsumerValidator)(nil), "interchain_security.ccv.provider.v1.ConsumerValidator")
-	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
+func (m *KeyAssignmentHistoryEntry) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+func (m *KeyAssignmentHistoryEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-var fileDescriptor_f22ec409a72b7b72 = []byte{
-	// 1886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x8a, 0x94, 0x44, 0x0e, 0xf5, 0x77, 0xa4, 0xda, 0x2b, 0x55, 0xa5, 0x64, 0xa6, 0x36,
-	0xd4, 0xb8, 0x5e, 0x46, 0xca, 0xc5, 0x30, 0x1a, 0x18, 0x12, 0xe5, 0xc4, 0x96, 0x12, 0x87, 0x5d,
-	0x11, 0x0e, 0x90, 0x1e, 0x16, 0xb3, 0xb3, 0x23, 0x72, 0xaa, 0xe5, 0xce, 0x7a, 0x66, 0xb8, 0x2a,
-	0x2f, 0x3d, 0xf7, 0x52, 0x20, 0xbd, 0x05, 0x3d, 0xb4, 0x01, 0x7a, 0x29, 0x7a, 0xea, 0xa1, 0x9f,
-	0xa0, 0x87, 0x22, 0x28, 0x50, 0x24, 0xc7, 0x9e, 0x92, 0xc2, 0x3e, 0xf4, 0xd0, 0x2f, 0x51, 0xcc,
-	0xcc, 0xee, 0x72, 0xa9, 0x3f, 0x31, 0x8d, 0x24, 0x17, 0x69, 0xf7, 0xbd, 0xdf, 0xfb, 0xcd, 0x7b,
-	0x33, 0x6f, 0xde, 0x7b, 0x4b, 0xb0, 0x47, 0x23, 0x49, 0x38, 0xee, 0x21, 0x1a, 0x79, 0x82, 0xe0,
-	0x01, 0xa7, 0x72, 0xd8, 0xc4, 0x38, 0x69, 0xc6, 0x9c, 0x25, 0x34, 0x20, 0xbc, 0x99, 0xec, 0xe6,
-	0xcf, 0x4e, 0xcc, 0x99, 0x64, 0xf0, 0x8d, 0x2b, 0x6c, 0x1c, 0x8c, 0x13, 0x27, 0xc7, 0x25, 0xbb,
-	0x1b, 0xb7, 0xaf, 0x23, 0x4e, 0x76, 0x9b, 0xe7, 0x94, 0x13, 0xc3, 0xb5, 0xb1, 0xd6, 0x65, 0x5d,
-	0xa6, 0x1f, 0x9b, 0xea, 0x29, 0x95, 0x6e, 0x75, 0x19, 0xeb, 0x86, 0xa4, 0xa9, 0xdf, 0xfc, 0xc1,
-	0x69, 0x53, 0xd2, 0x3e, 0x11, 0x12, 0xf5, 0xe3, 0x14, 0x50, 0xbf, 0x08, 0x08, 0x06, 0x1c, 0x49,
-	0xca, 0xa2, 0x8c, 0x80, 0xfa, 0xb8, 0x89, 0x19, 0x27, 0x4d, 0x1c, 0x52, 0x12, 0x49, 0xb5, 0xaa,
-	0x79, 0x4a, 0x01, 0x4d, 0x05, 0x08, 0x69, 0xb7, 0x27, 0x8d, 0x58, 0x34, 0x25, 0x89, 0x02, 0xc2,
-	0xfb, 0xd4, 0x80, 0x47, 0x6f, 0xa9, 0xc1, 0x66, 0x41, 0x8f, 0xf9, 0x30, 0x96, 0xac, 0x79, 0x46,
-	0x86, 0x22, 0xd5, 0xde, 0xc1, 0x4c, 0xf4, 0x99, 0x68, 0x12, 0x15, 0x7f, 0x84, 0x49, 0x33, 0xd9,
-	0xf5, 0x89, 0x44, 0xbb, 0xb9, 0x20, 0xf3, 0x3b, 0xc5, 0xf9, 0x48, 0x8c, 0x30, 0x98, 0xd1, 0xcc,
-	0xef, 0x75, 0xa3, 0xf7, 0xcc, 0x8e, 0x98, 0x97, 0x54, 0xb5, 0x82, 0xfa, 0x34, 0x62, 0x4d, 0xfd,
-	0xd7, 0x88, 0x1a, 0x5f, 0x54, 0x80, 0xdd, 0x62, 0x91, 0x18, 0xf4, 0x09, 0xdf, 0x0f, 0x02, 0xaa,
-	0x36, 0xa0, 0xcd, 0x59, 0xcc, 0x04, 0x0a, 0xe1, 0x1a, 0x98, 0x91, 0x54, 0x86, 0xc4, 0xb6, 0xb6,
-	0xad, 0x9d, 0xaa, 0x6b, 0x5e, 0xe0, 0x36, 0xa8, 0x05, 0x44, 0x60, 0x4e, 0x63, 0x05, 0xb6, 0xa7,
-	0xb5, 0xae, 0x28, 0x82, 0xeb, 0xa0, 0x62, 0x4e, 0x8d, 0x06, 0x76, 0x49, 0xab, 0xe7, 0xf4, 0xfb,
-	0x93, 0x00, 0xbe, 0x07, 0x16, 0x69, 0x44, 0x25, 0x45, 0xa1, 0xd7, 0x23, 0x6a, 0xef, 0xec, 0xf2,
-	0xb6, 0xb5, 0x53, 0xdb, 0xdb, 0x70, 0xa8, 0x8f, 0x1d, 0xb5, 0xdd, 0x4e, 0xba, 0xc9, 0xc9, 0xae,
-	0xf3, 0x58, 0x23, 0x0e, 0xca, 0x9f, 0x7f, 0xb5, 0x35, 0xe5, 0x2e, 0xa4, 0x76, 0x46, 0x08, 0x6f,
-	0x81, 0xf9, 0x2e, 0x89, 0x88, 0xa0, 0xc2, 0xeb, 0x21, 0xd1, 0xb3, 0x67, 0xb6, 0xad, 0x9d, 0x79,
-	0xb7, 0x96, 0xca, 0x1e, 0x23, 0xd1, 0x83, 0x5b, 0xa0, 0xe6, 0xd3, 0x08, 0xf1, 0xa1, 0x41, 0xcc,
-	0x6a, 0x04, 0x30, 0x22, 0x0d, 0x68, 0x01, 0x20, 0x62, 0x74, 0x1e, 0x79, 0x2a, 0x37, 0xec, 0xb9,
-	0xd4, 0x11, 0x93, 0x17, 0x4e, 0x96, 0x17, 0x4e, 0x27, 0x4b, 0x9c, 0x83, 0x8a, 0x72, 0xe4, 0x93,
-	0xaf, 0xb7, 0x2c, 0xb7, 0xaa, 0xed, 0x94, 0x06, 0x3e, 0x05, 0xcb, 0x83, 0xc8, 0x67, 0x51, 0x40,
-	0xa3, 0xae, 0x17, 0x13, 0x4e, 0x59, 0x60, 0x57, 0x34, 0xd5, 0xfa, 0x25, 0xaa, 0xc3, 0x34, 0xc5,
-	0x0c, 0xd3, 0xa7, 0x8a, 0x69, 0x29, 0x37, 0x6e, 0x6b, 0x5b, 0xf8, 0x73, 0x00, 0x31, 0x4e, 0xb4,
-	0x4b, 0x6c, 0x20, 0x33, 0xc6, 0xea, 0xe4, 0x8c, 0xcb, 0x18, 0x27, 0x1d, 0x63, 0x9d, 0x52, 0xfe,
-	0x02, 0xdc, 0x94, 0x1c, 0x45, 0xe2, 0x94, 0xf0, 0x8b, 0xbc, 0x60, 0x72, 0xde, 0x1f, 0x64, 0x1c,
-	0xe3, 0xe4, 0x8f, 0xc1, 0x36, 0x4e, 0x13, 0xc8, 0xe3, 0x24, 0xa0, 0x42, 0x72, 0xea, 0x0f, 0x94,
-	0xad, 0x77, 0xca, 0x11, 0xd6, 0x39, 0x52, 0xd3, 0x49, 0x50, 0xcf, 0x70, 0xee, 0x18, 0xec, 0xdd,
-	0x14, 0x05, 0x3f, 0x04, 0x3f, 0xf6, 0x43, 0x86, 0xcf, 0x84, 0x72, 0xce, 0x1b, 0x63, 0xd2, 0x4b,
-	0xf7, 0xa9, 0x10, 0x8a, 0x6d, 0x7e, 0xdb, 0xda, 0x29, 0xb9, 0xb7, 0x0c, 0xb6, 0x4d, 0xf8, 0x61,
-	0x01, 0xd9, 0x29, 0x00, 0xe1, 0x3d, 0x00, 0x7b, 0x54, 0x48, 0xc6, 0x29, 0x46, 0xa1, 0x47, 0x22,
-	0xc9, 0x29, 0x11, 0xf6, 0x82, 0x36, 0x5f, 0x19, 0x69, 0x1e, 0x19, 0x05, 0x3c, 0x02, 0xb7, 0xae,
-	0x5d, 0xd4, 0xc3, 0x3d, 0x14, 0x45, 0x24, 0xb4, 0x17, 0x75, 0x28, 0x5b, 0xc1, 0x35, 0x6b, 0xb6,
-	0x0c, 0x0c, 0xae, 0x82, 0x19, 0xc9, 0x62, 0xef, 0xa9, 0xbd, 0xb4, 0x6d, 0xed, 0x2c, 0xb8, 0x65,
-	0xc9, 0xe2, 0xa7, 0xf0, 0x2d, 0xb0, 0x96, 0xa0, 0x90, 0x06, 0x48, 0x32, 0x2e, 0xbc, 0x98, 0x9d,
-	0x13, 0xee, 0x61, 0x14, 0xdb, 0xcb, 0x1a, 0x03, 0x47, 0xba, 0xb6, 0x52, 0xb5, 0x50, 0x0c, 0xdf,
-	0x04, 0x2b, 0xb9, 0xd4, 0x13, 0x44, 0x6a, 0xf8, 0x8a, 0x86, 0x2f, 0xe5, 0x8a, 0x13, 0x22, 0x15,
-	0x76, 0x13, 0x54, 0x51, 0x18, 0xb2, 0xf3, 0x90, 0x0a, 0x69, 0xc3, 0xed, 0xd2, 0x4e, 0xd5, 0x1d,
-	0x09, 0xe0, 0x06, 0xa8, 0x04, 0x24, 0x1a, 0x6a, 0xe5, 0xaa, 0x56, 0xe6, 0xef, 0xf0, 0x0d, 0xb0,
-	0x80, 0x59, 0x14, 0x11, 0x7d, 0x0c, 0xea, 0xd2, 0xae, 0xe9, 0x20, 0xe7, 0x47, 0xc2, 0x27, 0xc1,
-	0x83, 0x3b, 0xbf, 0xf9, 0x6c, 0x6b, 0xea, 0xd3, 0xcf, 0xb6, 0xa6, 0xfe, 0xf9, 0xb7, 0x7b, 0x1b,
-	0x69, 0x59, 0xe9, 0xb2, 0xc4, 0x49, 0x4b, 0x90, 0xd3, 0x62, 0x91, 0x24, 0x91, 0x6c, 0x7c, 0x61,
-	0x81, 0x9b, 0xad, 0xfc, 0xa0, 0xfb, 0x2c, 0x41, 0xe1, 0xf7, 0x59, 0x50, 0xf6, 0x41, 0x55, 0xa8,
-	0x9d, 0xd6, 0x57, 0xb8, 0xfc, 0x1a, 0x57, 0xb8, 0xa2, 0xcc, 0x94, 0xe2, 0x41, 0xfd, 0x15, 0x11,
-	0xfd, 0x61, 0x1a, 0x6c, 0x66, 0x11, 0x7d, 0xc0, 0x02, 0x7a, 0x4a, 0x31, 0xfa, 0xbe, 0xeb, 0x64,
-	0x9e, 0x3f, 0xe5, 0x09, 0xf2, 0x67, 0xe6, 0xf5, 0xf2, 0x67, 0x76, 0x82, 0xfc, 0x99, 0xfb, 0xa6,
-	0xfc, 0xa9, 0x8c, 0xe7, 0x4f, 0xe3, 0x8f, 0x16, 0x58, 0x7b, 0xf4, 0x7c, 0x40, 0x13, 0xf6, 0x1d,
-	0x6d, 0xcc, 0x31, 0x58, 0x20, 0x05, 0x3e, 0x61, 0x97, 0xb6, 0x4b, 0x3b, 0xb5, 0xbd, 0xdb, 0x4e,
-	0x7a, 0x4a, 0x79, 0x4b, 0xcc, 0x8e, 0xaa, 0xb8, 0xba, 0x3b, 0x6e, 0xfb, 0x60, 0xda, 0xb6, 0x1a,
-	0x7f, 0xb7, 0xc0, 0x86, 0xba, 0x9a, 0x5d, 0xe2, 0x92, 0x73, 0xc4, 0x83, 0x43, 0x12, 0xb1, 0xbe,
-	0xf8, 0xd6, 0x7e, 0x36, 0xc0, 0x42, 0xa0, 0x99, 0x3c, 0xc9, 0x3c, 0x14, 0x04, 0xda, 0x4f, 0x8d,
-	0x51, 0xc2, 0x0e, 0xdb, 0x0f, 0x02, 0xb8, 0x03, 0x96, 0x47, 0x18, 0xae, 0x2e, 0x84, 0xca, 0x53,
-	0x05, 0x5b, 0xcc, 0x60, 0xfa, 0x9a, 0xbc, 0x3a, 0x0f, 0xff, 0x67, 0x81, 0xe5, 0xf7, 0x42, 0xe6,
-	0xa3, 0xf0, 0x24, 0x44, 0xa2, 0xa7, 0xca, 0xd6, 0x50, 0xe5, 0x3f, 0x27, 0x69, 0xbf, 0xd0, 0xee,
-	0x4f, 0x9c, 0xff, 0xca, 0x4c, 0x77, 0xb0, 0x87, 0x60, 0x25, 0xaf, 0xe0, 0x79, 0x3e, 0xea, 0x68,
-	0x0f, 0x56, 0x5f, 0x7c, 0xb5, 0xb5, 0x94, 0xe5, 0x7e, 0x4b, 0xe7, 0xe6, 0xa1, 0xbb, 0x84, 0xc7,
-	0x04, 0x01, 0xac, 0x83, 0x1a, 0xf5, 0xb1, 0x27, 0xc8, 0x73, 0x2f, 0x1a, 0xf4, 0x75, 0x2a, 0x97,
-	0xdd, 0x2a, 0xf5, 0xf1, 0x09, 0x79, 0xfe, 0x74, 0xd0, 0x87, 0x6f, 0x83, 0x1b, 0xd9, 0x5c, 0xe7,
-	0x25, 0x28, 0xf4, 0x94, 0xbd, 0xda, 0x2e, 0xae, 0xb3, 0x7b, 0xde, 0x5d, 0xcd, 0xb4, 0xcf, 0x50,
-	0xa8, 0x16, 0xdb, 0x0f, 0x02, 0xde, 0xf8, 0xd3, 0x0c, 0x98, 0x6d, 0x23, 0x8e, 0xfa, 0x02, 0x76,
-	0xc0, 0x92, 0x24, 0xfd, 0x38, 0x44, 0x92, 0x78, 0x66, 0x3a, 0x48, 0x23, 0xbd, 0xab, 0xa7, 0x86,
-	0xe2, 0x0c, 0xe6, 0x14, 0xa6, 0xae, 0x64, 0xd7, 0x69, 0x69, 0xe9, 0x89, 0x44, 0x92, 0xb8, 0x8b,
-	0x19, 0x87, 0x11, 0xc2, 0xfb, 0xc0, 0x96, 0x7c, 0x20, 0xe4, 0xa8, 0x6f, 0x8f, 0x1a, 0x96, 0x39,
-	0xeb, 0x1b, 0x99, 0xde, 0xb4, 0xba, 0xbc, 0x51, 0x5d, 0xdd, 0xa2, 0x4b, 0xdf, 0xa6, 0x45, 0x07,
-	0x60, 0x53, 0xa8, 0x43, 0xf5, 0xfa, 0x44, 0xea, 0x46, 0x1a, 0x87, 0x24, 0xa2, 0xa2, 0x97, 0x91,
-	0xcf, 0x4e, 0x4e, 0xbe, 0xae, 0x89, 0x3e, 0x50, 0x3c, 0x6e, 0x46, 0x93, 0xae, 0xd2, 0x02, 0xf5,
-	0xab, 0x57, 0xc9, 0x03, 0x9f, 0xd3, 0x81, 0xff, 0xf0, 0x0a, 0x8a, 0x3c, 0x7a, 0x01, 0xee, 0x14,
-	0x1a, 0xbe, 0xba, 0x4d, 0x9e, 0x4e, 0x64, 0x8f, 0x93, 0xae, 0xea, 0x8a, 0xc8, 0xf4, 0x7e, 0x42,
-	0xf2, 0xa1, 0x25, 0xcd, 0x69, 0x35, 0xb1, 0x16, 0x92, 0x9a, 0x46, 0xe9, 0x64, 0xd7, 0x18, 0xcd,
-	0x05, 0xf9, 0xdd, 0x74, 0x0b, 0x5c, 0xef, 0x12, 0xa2, 0x6e, 0x51, 0x61, 0x36, 0x20, 0x31, 0xc3,
-	0x3d, 0x3d, 0xbb, 0x94, 0xdc, 0xc5, 0x7c, 0x0e, 0x78, 0xa4, 0xa4, 0xf0, 0x63, 0x70, 0x37, 0x1a,
-	0xf4, 0x7d, 0xc2, 0x3d, 0x76, 0x6a, 0x80, 0xfa, 0xe6, 0x09, 0x89, 0xb8, 0xf4, 0x38, 0xc1, 0x84,
-	0x26, 0xea, 0xc4, 0x8d, 0xe7, 0x42, 0x8f, 0x26, 0x25, 0xf7, 0xb6, 0x31, 0xf9, 0xf0, 0x54, 0x73,
-	0x88, 0x0e, 0x3b, 0x51, 0x70, 0x37, 0x43, 0x1b, 0xc7, 0xc4, 0x51, 0xb9, 0x52, 0x5e, 0x9e, 0x39,
-	0x2a, 0x57, 0x66, 0x96, 0x67, 0x8f, 0xca, 0x95, 0xca, 0x72, 0xb5, 0xf1, 0x13, 0x50, 0xd5, 0x97,
-	0x71, 0x1f, 0x9f, 0x09, 0x5d, 0x41, 0x83, 0x80, 0x13, 0x21, 0x88, 0xb0, 0xad, 0xb4, 0x82, 0x66,
-	0x82, 0x86, 0x04, 0xeb, 0xd7, 0x4d, 0xda, 0x02, 0x7e, 0x04, 0xe6, 0x62, 0xa2, 0xc7, 0x40, 0x6d,
-	0x58, 0xdb, 0x7b, 0xc7, 0x99, 0xe0, 0x13, 0xc9, 0xb9, 0x8e, 0xd0, 0xcd, 0xd8, 0x1a, 0x7c, 0x34,
-	0xdf, 0x5f, 0xe8, 0xc6, 0x02, 0x3e, 0xbb, 0xb8, 0xe8, 0xcf, 0x5e, 0x6b, 0xd1, 0x0b, 0x7c, 0xa3,
-	0x35, 0xef, 0x82, 0xda, 0xbe, 0x09, 0xfb, 0x7d, 0xd5, 0x3a, 0x2e, 0x6d, 0xcb, 0x7c, 0x71, 0x5b,
-	0x8e, 0xc0, 0x62, 0x3a, 0x34, 0x75, 0x98, 0x2e, 0x28, 0xf0, 0x47, 0x00, 0xa4, 0xd3, 0x96, 0x2a,
-	0x44, 0xa6, 0x24, 0x57, 0x53, 0xc9, 0x93, 0x60, 0xac, 0x6b, 0x4e, 0x8f, 0x75, 0xcd, 0x06, 0x03,
-	0xeb, 0xcf, 0x8a, 0x5d, 0x4d, 0x57, 0xfc, 0x36, 0xc2, 0x67, 0x44, 0x0a, 0xe8, 0x82, 0xb2, 0xee,
-	0x5e, 0x26, 0xd4, 0xfb, 0xd7, 0x86, 0x9a, 0xec, 0x3a, 0xd7, 0x91, 0x1c, 0x22, 0x89, 0xd2, 0xa4,
-	0xd5, 0x5c, 0x8d, 0xdf, 0x59, 0xc0, 0x3e, 0x26, 0xc3, 0x7d, 0x21, 0x68, 0x37, 0xea, 0x93, 0x48,
-	0xaa, 0xeb, 0x82, 0x30, 0x51, 0x8f, 0x6a, 0xac, 0xca, 0xcb, 0x9e, 0xae, 0x76, 0x96, 0xae, 0x76,
-	0xf3, 0x99, 0x50, 0xed, 0x11, 0x7c, 0x00, 0x40, 0xcc, 0x49, 0xe2, 0x61, 0xef, 0x8c, 0x0c, 0x75,
-	0x3c, 0xb5, 0xbd, 0xcd, 0x62, 0x15, 0x33, 0x5f, 0x8a, 0x4e, 0x7b, 0xe0, 0x87, 0x14, 0x1f, 0x93,
-	0xa1, 0x5b, 0x51, 0xf8, 0xd6, 0x31, 0x19, 0xaa, 0xb6, 0xa5, 0x87, 0x00, 0x5d, 0x7a, 0x4a, 0xae,
-	0x79, 0x69, 0xfc, 0xde, 0x02, 0x37, 0xf3, 0x00, 0xb2, 0xb3, 0x6a, 0x0f, 0x7c, 0x65, 0x51, 0xdc,
-	0x3b, 0x6b, 0x7c, 0xe2, 0xb8, 0xe4, 0xed, 0xf4, 0x15, 0xde, 0x3e, 0x04, 0xf3, 0xf9, 0xdd, 0x57,
-	0xfe, 0x96, 0x26, 0xf0, 0xb7, 0x96, 0x59, 0x1c, 0x93, 0x61, 0xe3, 0xd7, 0x05, 0xdf, 0x0e, 0x86,
-	0x85, 0xf4, 0xe5, 0xaf, 0xf0, 0x2d, 0x5f, 0xb6, 0xe8, 0x1b, 0x2e, 0xda, 0x5f, 0x0a, 0xa0, 0x74,
-	0x39, 0x80, 0xc6, 0xbf, 0x2c, 0x70, 0xa3, 0xb8, 0xaa, 0xe8, 0xb0, 0x36, 0x1f, 0x44, 0xe4, 0xd9,
-	0xde, 0x37, 0xad, 0xff, 0x10, 0x54, 0x62, 0x85, 0xf2, 0xa4, 0x48, 0x8f, 0x68, 0xb2, 0x1e, 0x3b,
-	0xa7, 0xad, 0x3a, 0xea, 0x7a, 0x2f, 0x8e, 0x05, 0x20, 0xd2, 0x9d, 0x7b, 0x6b, 0xa2, 0x0b, 0x57,
-	0xb8, 0x4c, 0xee, 0x42, 0x31, 0x66, 0xd1, 0xf8, 0x87, 0x05, 0x56, 0xb2, 0x78, 0xf2, 0x8d, 0x85,
-	0x3f, 0x05, 0x30, 0xdf, 0x8a, 0x51, 0xb3, 0x35, 0xe9, 0xb7, 0x9c, 0x69, 0xb2, 0x4e, 0x3b, 0x4a,
-	0xa3, 0xe9, 0x42, 0x1a, 0xc1, 0xf7, 0xc1, 0x6a, 0xee, 0x72, 0xac, 0x0f, 0x73, 0xe2, 0x13, 0xcf,
-	0xc7, 0x89, 0x5c, 0xa4, 0xbe, 0xc5, 0x7f, 0xc9, 0x68, 0x54, 0xfc, 0xe8, 0x2f, 0xb9, 0x40, 0x89,
-	0xcc, 0xf7, 0x7c, 0xe3, 0xb7, 0xd6, 0xa8, 0x3c, 0xa6, 0xe5, 0x76, 0x3f, 0x0c, 0xd3, 0x21, 0x0e,
-	0xc6, 0x60, 0x2e, 0x2b, 0xd8, 0xe6, 0xfa, 0x6e, 0x5e, 0xd9, 0x54, 0x0e, 0x09, 0xd6, 0x7d, 0xe5,
-	0xbe, 0x3a, 0x81, 0xbf, 0x7c, 0xbd, 0x75, 0xb7, 0x4b, 0x65, 0x6f, 0xe0, 0x3b, 0x98, 0xf5, 0xd3,
-	0x5f, 0x42, 0xd2, 0x7f, 0xf7, 0x44, 0x70, 0xd6, 0x94, 0xc3, 0x98, 0x88, 0xcc, 0x46, 0xfc, 0xf9,
-	0xbf, 0x7f, 0x7d, 0xd3, 0x72, 0xb3, 0x65, 0x0e, 0x3e, 0xfa, 0xfc, 0x45, 0xdd, 0xfa, 0xf2, 0x45,
-	0xdd, 0xfa, 0xcf, 0x8b, 0xba, 0xf5, 0xc9, 0xcb, 0xfa, 0xd4, 0x97, 0x2f, 0xeb, 0x53, 0xff, 0x7e,
-	0x59, 0x9f, 0xfa, 0xf8, 0x9d, 0x02, 0x29, 0x0a, 0x43, 0x1a, 0xf9, 0x54, 0x8a, 0xe6, 0xe8, 0x1c,
-	0xef, 0xe5, 0xbf, 0x55, 0xfd, 0x6a, 0xfc, 0x67, 0x30, 0xbd, 0x9e, 0x3f, 0xab, 0x33, 0xe6, 0xed,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xef, 0xd1, 0x10, 0xe8, 0x37, 0x13, 0x00, 0x00,
+func (m *KeyAssignmentHistoryEntry) GetRecord() KeyAssignmentRecord {
+	if m != nil {
+		return m.Record
+	}
+	return KeyAssignmentRecord{}
+}
+
+// LaunchThresholds defines the conditions that the initial validator set of a
+// consumer chain has to meet at spawn time for the chain to be launched. If they
+// are not met, the launch is postponed by postponement_interval, at most
+// max_postponements times, after which the consumer addition proposal is
+// dropped.
+type LaunchThresholds struct {
+	// the minimum number of validators in the initial validator set
+	MinValidators uint32 `protobuf:"varint,1,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// the minimum percentage, in [0, 100], of the total voting power of the
+	// provider chain held by the validators in the initial validator set
+	MinPowerPercentage uint32 `protobuf:"varint,2,opt,name=min_power_percentage,json=minPowerPercentage,proto3" json:"min_power_percentage,omitempty"`
+	// the time by which the launch is postponed when the thresholds are not met
+	PostponementInterval time.Duration `protobuf:"bytes,3,opt,name=postponement_interval,json=postponementInterval,proto3,stdduration" json:"postponement_interval"`
+	// the maximum number of times the launch is postponed
+	MaxPostponements uint32 `protobuf:"varint,4,opt,name=max_postponements,json=maxPostponements,proto3" json:"max_postponements,omitempty"`
+	// the minimum percentage, in [0, 100], of the voting power of the initial
+	// validator set on the consumer chain held by the validators that signalled
+	// that they are ready to validate the chain, with the genesis and binary
+	// hashes of the consumer addition proposal
+	MinReadyPowerPercentage uint32 `protobuf:"varint,5,opt,name=min_ready_power_percentage,json=minReadyPowerPercentage,proto3" json:"min_ready_power_percentage,omitempty"`
+}
+
+func (m *LaunchThresholds) Reset()         { *m = LaunchThresholds{} }
+func (m *LaunchThresholds) String() string { return proto.CompactTextString(m) }
+func (*LaunchThresholds) ProtoMessage()    {}
+func (*LaunchThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{26}
+}
+func (m *LaunchThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LaunchThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LaunchThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LaunchThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LaunchThresholds.Merge(m, src)
+}
+func (m *LaunchThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *LaunchThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_LaunchThresholds.DiscardUnknown(m)
 }
 
-func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
-	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+var xxx_messageInfo_LaunchThresholds proto.InternalMessageInfo
+
+func (m *LaunchThresholds) GetMinValidators() uint32 {
+	if m != nil {
+		return m.MinValidators
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
+	return 0
+}
+
+func (m *LaunchThresholds) GetMinPowerPercentage() uint32 {
+	if m != nil {
+		return m.MinPowerPercentage
 	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	return 0
+}
+
+func (m *LaunchThresholds) GetPostponementInterval() time.Duration {
+	if m != nil {
+		return m.PostponementInterval
 	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x78
+	return 0
+}
+
+func (m *LaunchThresholds) GetMaxPostponements() uint32 {
+	if m != nil {
+		return m.MaxPostponements
 	}
-	if len(m.DistributionTransmissionChannel) > 0 {
-		i -= len(m.DistributionTransmissionChannel)
-		copy(dAtA[i:], m.DistributionTransmissionChannel)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
-		i--
-		dAtA[i] = 0x72
+	return 0
+}
+
+func (m *LaunchThresholds) GetMinReadyPowerPercentage() uint32 {
+	if m != nil {
+		return m.MinReadyPowerPercentage
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x68
+	return 0
+}
+
+// OptInPolicy defines how long the validators that opt in to a consumer chain
+// commit to validating it, so that the validator set of the chain is stable
+type OptInPolicy struct {
+	// the number of epochs, after the epoch in which a validator starts
+	// validating the consumer chain, before the validator can stop validating it
+	MinCommitmentEpochs uint32 `protobuf:"varint,1,opt,name=min_commitment_epochs,json=minCommitmentEpochs,proto3" json:"min_commitment_epochs,omitempty"`
+	// the number of epochs, after the epoch in which a validator stops
+	// validating the consumer chain, before the validator can validate it again
+	OptInCooldownEpochs uint32 `protobuf:"varint,2,opt,name=opt_in_cooldown_epochs,json=optInCooldownEpochs,proto3" json:"opt_in_cooldown_epochs,omitempty"`
+}
+
+func (m *OptInPolicy) Reset()         { *m = OptInPolicy{} }
+func (m *OptInPolicy) String() string { return proto.CompactTextString(m) }
+func (*OptInPolicy) ProtoMessage()    {}
+func (*OptInPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{27}
+}
+func (m *OptInPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptInPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptInPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
-		i--
-		dAtA[i] = 0x60
+}
+func (m *OptInPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptInPolicy.Merge(m, src)
+}
+func (m *OptInPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptInPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptInPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptInPolicy proto.InternalMessageInfo
+
+func (m *OptInPolicy) GetMinCommitmentEpochs() uint32 {
+	if m != nil {
+		return m.MinCommitmentEpochs
 	}
-	if len(m.ConsumerRedistributionFraction) > 0 {
-		i -= len(m.ConsumerRedistributionFraction)
-		copy(dAtA[i:], m.ConsumerRedistributionFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+func (m *OptInPolicy) GetOptInCooldownEpochs() uint32 {
+	if m != nil {
+		return m.OptInCooldownEpochs
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err1 != nil {
-		return 0, err1
+	return 0
+}
+
+// ConsumerEpochParams defines the epochs of a consumer chain, at the end of
+// which its validator updates are queued, so that a consumer chain can be
+// updated more or less often than the other consumer chains
+type ConsumerEpochParams struct {
+	// the number of blocks that constitute an epoch of the consumer chain, 0 for
+	// the blocks_per_epoch of the provider chain
+	BlocksPerEpoch int64 `protobuf:"varint,1,opt,name=blocks_per_epoch,json=blocksPerEpoch,proto3" json:"blocks_per_epoch,omitempty"`
+}
+
+func (m *ConsumerEpochParams) Reset()         { *m = ConsumerEpochParams{} }
+func (m *ConsumerEpochParams) String() string { return proto.CompactTextString(m) }
+func (*ConsumerEpochParams) ProtoMessage()    {}
+func (*ConsumerEpochParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{28}
+}
+func (m *ConsumerEpochParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerEpochParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerEpochParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n1
-	i = encodeVarintProvider(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err2 != nil {
-		return 0, err2
+}
+func (m *ConsumerEpochParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerEpochParams.Merge(m, src)
+}
+func (m *ConsumerEpochParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerEpochParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerEpochParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerEpochParams proto.InternalMessageInfo
+
+func (m *ConsumerEpochParams) GetBlocksPerEpoch() int64 {
+	if m != nil {
+		return m.BlocksPerEpoch
 	}
-	i -= n2
-	i = encodeVarintProvider(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x4a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err3 != nil {
-		return 0, err3
+	return 0
+}
+
+// EpochState records the current epoch of the provider chain when the epochs
+// are based on block time
+type EpochState struct {
+	// the number of the epoch, which is increased by the number of epoch
+	// durations elapsed at the end of each epoch
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// the first provider height of the epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the block time at which the epoch started, a multiple of the epoch
+	// duration after the start time of the first time-based epoch
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *EpochState) Reset()         { *m = EpochState{} }
+func (m *EpochState) String() string { return proto.CompactTextString(m) }
+func (*EpochState) ProtoMessage()    {}
+func (*EpochState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{29}
+}
+func (m *EpochState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n3
-	i = encodeVarintProvider(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x42
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
-	if err4 != nil {
-		return 0, err4
+}
+func (m *EpochState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochState.Merge(m, src)
+}
+func (m *EpochState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochState proto.InternalMessageInfo
+
+func (m *EpochState) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
 	}
-	i -= n4
-	i = encodeVarintProvider(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BinaryHash) > 0 {
-		i -= len(m.BinaryHash)
-		copy(dAtA[i:], m.BinaryHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *EpochState) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *EpochState) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	{
-		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+// Used to serialize the epoch params of the consumer chains
+// ConsumerEpochParams: chainID -> ConsumerEpochParams
+type ChainEpochParams struct {
+	ChainId string              `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Params  ConsumerEpochParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ChainEpochParams) Reset()         { *m = ChainEpochParams{} }
+func (m *ChainEpochParams) String() string { return proto.CompactTextString(m) }
+func (*ChainEpochParams) ProtoMessage()    {}
+func (*ChainEpochParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{30}
+}
+func (m *ChainEpochParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainEpochParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainEpochParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ChainEpochParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainEpochParams.Merge(m, src)
+}
+func (m *ChainEpochParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainEpochParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainEpochParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainEpochParams proto.InternalMessageInfo
+
+func (m *ChainEpochParams) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChainEpochParams) GetParams() ConsumerEpochParams {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return ConsumerEpochParams{}
 }
 
-func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used to serialize the opt-in policies of the consumer chains
+// OptInPolicy: chainID -> OptInPolicy
+type ConsumerOptInPolicy struct {
+	ChainId string      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Policy  OptInPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
 }
 
-func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintProvider(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerOptInPolicy) Reset()         { *m = ConsumerOptInPolicy{} }
+func (m *ConsumerOptInPolicy) String() string { return proto.CompactTextString(m) }
+func (*ConsumerOptInPolicy) ProtoMessage()    {}
+func (*ConsumerOptInPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{31}
+}
+func (m *ConsumerOptInPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerOptInPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerOptInPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerOptInPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerOptInPolicy.Merge(m, src)
+}
+func (m *ConsumerOptInPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerOptInPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerOptInPolicy.DiscardUnknown(m)
 }
 
-func (m *ConsumerModificationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerOptInPolicy proto.InternalMessageInfo
+
+func (m *ConsumerOptInPolicy) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConsumerModificationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerOptInPolicy) GetPolicy() OptInPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return OptInPolicy{}
+}
+
+// OptInRecord records when a validator last opted in to or out of a consumer
+// chain, from which the end of its commitment or of its cooldown is computed
+type OptInRecord struct {
+	// the provider height at which the validator opted in, 0 if it opted out
+	OptInHeight int64 `protobuf:"varint,1,opt,name=opt_in_height,json=optInHeight,proto3" json:"opt_in_height,omitempty"`
+	// the provider height at which the validator opted out, 0 if it opted in
+	OptOutHeight int64 `protobuf:"varint,2,opt,name=opt_out_height,json=optOutHeight,proto3" json:"opt_out_height,omitempty"`
+	// the number of the provider epoch based on block time from the end of
+	// which the commitment of the validator is counted, i.e., the epoch in which
+	// it opted in or, if later, in which the CCV channel to the consumer chain
+	// was established. 0 if it opted out, or if the epochs of the consumer chain
+	// were based on block height when it opted in.
+	OptInEpoch uint64 `protobuf:"varint,3,opt,name=opt_in_epoch,json=optInEpoch,proto3" json:"opt_in_epoch,omitempty"`
+	// the number of the provider epoch based on block time in which the
+	// validator opted out. 0 if it opted in, or if the epochs of the consumer
+	// chain were based on block height when it opted out.
+	OptOutEpoch uint64 `protobuf:"varint,4,opt,name=opt_out_epoch,json=optOutEpoch,proto3" json:"opt_out_epoch,omitempty"`
+}
+
+func (m *OptInRecord) Reset()         { *m = OptInRecord{} }
+func (m *OptInRecord) String() string { return proto.CompactTextString(m) }
+func (*OptInRecord) ProtoMessage()    {}
+func (*OptInRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{32}
+}
+func (m *OptInRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ConsumerModificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+func (m *OptInRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptInRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+}
+func (m *OptInRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptInRecord.Merge(m, src)
+}
+func (m *OptInRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptInRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptInRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptInRecord proto.InternalMessageInfo
+
+func (m *OptInRecord) GetOptInHeight() int64 {
+	if m != nil {
+		return m.OptInHeight
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *OptInRecord) GetOptOutHeight() int64 {
+	if m != nil {
+		return m.OptOutHeight
 	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *OptInRecord) GetOptInEpoch() uint64 {
+	if m != nil {
+		return m.OptInEpoch
 	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *OptInRecord) GetOptOutEpoch() uint64 {
+	if m != nil {
+		return m.OptOutEpoch
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// Used to serialize the opt-in records of the validators
+// OptInRecord: (chainID, providerAddr consAddr) -> OptInRecord
+type ValidatorOptInRecord struct {
+	ChainId      string      `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProviderAddr []byte      `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Record       OptInRecord `protobuf:"bytes,3,opt,name=record,proto3" json:"record"`
+}
+
+func (m *ValidatorOptInRecord) Reset()         { *m = ValidatorOptInRecord{} }
+func (m *ValidatorOptInRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOptInRecord) ProtoMessage()    {}
+func (*ValidatorOptInRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{33}
+}
+func (m *ValidatorOptInRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOptInRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOptInRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ValidatorOptInRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOptInRecord.Merge(m, src)
+}
+func (m *ValidatorOptInRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOptInRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOptInRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOptInRecord proto.InternalMessageInfo
+
+func (m *ValidatorOptInRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ValidatorOptInRecord) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorOptInRecord) GetRecord() OptInRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return OptInRecord{}
 }
 
-func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ReadinessSignal records that a validator in the expected initial validator
+// set of a consumer chain signalled, before the launch of the chain, that its
+// node is ready to validate it
+type ReadinessSignal struct {
+	// the hash of the consumer chain genesis state verified by the validator
+	GenesisHash []byte `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// the hash of the consumer chain binary verified by the validator
+	BinaryHash []byte `protobuf:"bytes,2,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+	// the provider height at which the validator signalled its readiness
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Equivocations) > 0 {
-		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *ReadinessSignal) Reset()         { *m = ReadinessSignal{} }
+func (m *ReadinessSignal) String() string { return proto.CompactTextString(m) }
+func (*ReadinessSignal) ProtoMessage()    {}
+func (*ReadinessSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{34}
+}
+func (m *ReadinessSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ReadinessSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessSignal.Merge(m, src)
+}
+func (m *ReadinessSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessSignal proto.InternalMessageInfo
+
+func (m *ReadinessSignal) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *ReadinessSignal) GetBinaryHash() []byte {
+	if m != nil {
+		return m.BinaryHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReadinessSignal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used to serialize the readiness signals of the validators
+// ReadinessSignal: (chainID, providerAddr consAddr) -> ReadinessSignal
+type ValidatorReadinessSignal struct {
+	ChainId      string          `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProviderAddr []byte          `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Signal       ReadinessSignal `protobuf:"bytes,3,opt,name=signal,proto3" json:"signal"`
 }
 
-func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomsToRemove) > 0 {
-		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomsToRemove[iNdEx])
-			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.DenomsToAdd) > 0 {
-		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomsToAdd[iNdEx])
-			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *ValidatorReadinessSignal) Reset()         { *m = ValidatorReadinessSignal{} }
+func (m *ValidatorReadinessSignal) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReadinessSignal) ProtoMessage()    {}
+func (*ValidatorReadinessSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{35}
+}
+func (m *ValidatorReadinessSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReadinessSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReadinessSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorReadinessSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReadinessSignal.Merge(m, src)
 }
-
-func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorReadinessSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReadinessSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReadinessSignal.DiscardUnknown(m)
 }
 
-func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderValConsAddr) > 0 {
-		i -= len(m.ProviderValConsAddr)
-		copy(dAtA[i:], m.ProviderValConsAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderValConsAddr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IbcSeqNum != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ConsumerChainID) > 0 {
-		i -= len(m.ConsumerChainID)
-		copy(dAtA[i:], m.ConsumerChainID)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_ValidatorReadinessSignal proto.InternalMessageInfo
+
+func (m *ValidatorReadinessSignal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
-	if err7 != nil {
-		return 0, err7
+	return ""
+}
+
+func (m *ValidatorReadinessSignal) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	i -= n7
-	i = encodeVarintProvider(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorReadinessSignal) GetSignal() ReadinessSignal {
+	if m != nil {
+		return m.Signal
 	}
-	return dAtA[:n], nil
+	return ReadinessSignal{}
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used to serialize the ValidatorConsumerAddr index from key assignment
+// ValidatorByConsumerAddr: (chainID, consumerAddr consAddr) -> providerAddr
+// consAddr
+type ValidatorByConsumerAddr struct {
+	ChainId      string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConsumerAddr []byte `protobuf:"bytes,2,opt,name=consumer_addr,json=consumerAddr,proto3" json:"consumer_addr,omitempty"`
+	ProviderAddr []byte `protobuf:"bytes,3,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumberOfEpochsToStartReceivingRewards != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToStartReceivingRewards))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.BlocksPerEpoch != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.ConsumerRewardDenomRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorByConsumerAddr) Reset()         { *m = ValidatorByConsumerAddr{} }
+func (m *ValidatorByConsumerAddr) String() string { return proto.CompactTextString(m) }
+func (*ValidatorByConsumerAddr) ProtoMessage()    {}
+func (*ValidatorByConsumerAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{36}
+}
+func (m *ValidatorByConsumerAddr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorByConsumerAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorByConsumerAddr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.SlashMeterReplenishFraction) > 0 {
-		i -= len(m.SlashMeterReplenishFraction)
-		copy(dAtA[i:], m.SlashMeterReplenishFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintProvider(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintProvider(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TrustingPeriodFraction) > 0 {
-		i -= len(m.TrustingPeriodFraction)
-		copy(dAtA[i:], m.TrustingPeriodFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TemplateClient != nil {
-		{
-			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorByConsumerAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorByConsumerAddr.Merge(m, src)
+}
+func (m *ValidatorByConsumerAddr) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorByConsumerAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorByConsumerAddr.DiscardUnknown(m)
 }
 
-func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorByConsumerAddr proto.InternalMessageInfo
+
+func (m *ValidatorByConsumerAddr) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ValidatorByConsumerAddr) GetConsumerAddr() []byte {
+	if m != nil {
+		return m.ConsumerAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorByConsumerAddr) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Used to serialize the ConsumerAddrsToPruneV2 index from key assignment
+// ConsumerAddrsToPruneV2: (chainID, pruneTs time.Time) -> consumerAddrs AddressList
+type ConsumerAddrsToPruneV2 struct {
+	ChainId       string       `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	PruneTs       time.Time    `protobuf:"bytes,2,opt,name=prune_ts,json=pruneTs,proto3,stdtime" json:"prune_ts"`
+	ConsumerAddrs *AddressList `protobuf:"bytes,3,opt,name=consumer_addrs,json=consumerAddrs,proto3" json:"consumer_addrs,omitempty"`
 }
 
-func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerAddrsToPruneV2) Reset()         { *m = ConsumerAddrsToPruneV2{} }
+func (m *ConsumerAddrsToPruneV2) String() string { return proto.CompactTextString(m) }
+func (*ConsumerAddrsToPruneV2) ProtoMessage()    {}
+func (*ConsumerAddrsToPruneV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{37}
+}
+func (m *ConsumerAddrsToPruneV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerAddrsToPruneV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerAddrsToPruneV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ConsumerAddrsToPruneV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerAddrsToPruneV2.Merge(m, src)
+}
+func (m *ConsumerAddrsToPruneV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerAddrsToPruneV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerAddrsToPruneV2.DiscardUnknown(m)
 }
 
-func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConsumerAddrsToPruneV2 proto.InternalMessageInfo
+
+func (m *ConsumerAddrsToPruneV2) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ConsumerAddrsToPruneV2) GetPruneTs() time.Time {
+	if m != nil {
+		return m.PruneTs
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *AddressList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerAddrsToPruneV2) GetConsumerAddrs() *AddressList {
+	if m != nil {
+		return m.ConsumerAddrs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerValidator is used to facilitate epoch-based transitions. It contains
+// relevant info for a validator that is expected to validate on a consumer
+// chain during an epoch.
+type ConsumerValidator struct {
+	// validator's consensus address on the provider chain
+	ProviderConsAddr []byte `protobuf:"bytes,1,opt,name=provider_cons_addr,json=providerConsAddr,proto3" json:"provider_cons_addr,omitempty"`
+	// voting power the validator has during this epoch
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// public key the validator uses on the consumer chain during this epoch
+	ConsumerPublicKey *crypto.PublicKey `protobuf:"bytes,3,opt,name=consumer_public_key,json=consumerPublicKey,proto3" json:"consumer_public_key,omitempty"`
+	// height the validator had when it FIRST became a consumer validator
+	// If a validator becomes a consumer validator at height `H` and is
+	// continuously a consumer validator for all the upcoming epochs, then the
+	// height of the validator SHOULD remain `H`. This height only resets to a
+	// different height if a validator stops being a consumer validator during an
+	// epoch and later becomes again a consumer validator.
+	JoinHeight int64 `protobuf:"varint,4,opt,name=join_height,json=joinHeight,proto3" json:"join_height,omitempty"`
+	// block time of the height at which the validator FIRST became a consumer
+	// validator, reset together with join_height; zero for the validators that
+	// joined before it was recorded
+	JoinTime time.Time `protobuf:"bytes,5,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time"`
 }
 
-func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerValidator) Reset()         { *m = ConsumerValidator{} }
+func (m *ConsumerValidator) String() string { return proto.CompactTextString(m) }
+func (*ConsumerValidator) ProtoMessage()    {}
+func (*ConsumerValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{38}
+}
+func (m *ConsumerValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ConsumerValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerValidator.Merge(m, src)
 }
-
-func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerValidator.DiscardUnknown(m)
 }
 
-func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ConsumerValidator proto.InternalMessageInfo
+
+func (m *ConsumerValidator) GetProviderConsAddr() []byte {
+	if m != nil {
+		return m.ProviderConsAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorSetChangePackets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorSetChangePackets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerValidator) GetConsumerPublicKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerPublicKey
+	}
+	return nil
 }
 
-func (m *ValidatorSetChangePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.List) > 0 {
-		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ConsumerValidator) GetJoinHeight() int64 {
+	if m != nil {
+		return m.JoinHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerValidator) GetJoinTime() time.Time {
+	if m != nil {
+		return m.JoinTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerRewardsAllocation stores the rewards allocated by a consumer chain
+// to the consumer rewards pool. It is used to allocate the tokens to the
+// consumer opted-in validators and the community pool during BeginBlock.
+type ConsumerRewardsAllocation struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
 }
 
-func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PrevCKey != nil {
-		{
-			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *ConsumerRewardsAllocation) Reset()         { *m = ConsumerRewardsAllocation{} }
+func (m *ConsumerRewardsAllocation) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRewardsAllocation) ProtoMessage()    {}
+func (*ConsumerRewardsAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{39}
+}
+func (m *ConsumerRewardsAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRewardsAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRewardsAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ConsumerRewardsAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRewardsAllocation.Merge(m, src)
+}
+func (m *ConsumerRewardsAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRewardsAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRewardsAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRewardsAllocation proto.InternalMessageInfo
+
+func (m *ConsumerRewardsAllocation) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
+	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
+	proto.RegisterType((*ConsumerChainMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerChainMetadata")
+	proto.RegisterType((*ConsumerPause)(nil), "interchain_security.ccv.provider.v1.ConsumerPause")
+	proto.RegisterType((*PausedConsumer)(nil), "interchain_security.ccv.provider.v1.PausedConsumer")
+	proto.RegisterType((*StoppedConsumer)(nil), "interchain_security.ccv.provider.v1.StoppedConsumer")
+	proto.RegisterType((*ConsumerOwner)(nil), "interchain_security.ccv.provider.v1.ConsumerOwner")
+	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
+	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
+	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
+	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
+	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
+	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
+	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
+	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
+	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
+	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
+	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
+	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
+	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
+	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
+	proto.RegisterType((*KeyAssignmentNonce)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentNonce")
+	proto.RegisterType((*ConsumerKeyRotation)(nil), "interchain_security.ccv.provider.v1.ConsumerKeyRotation")
+	proto.RegisterType((*ScheduledConsumerKeyRotation)(nil), "interchain_security.ccv.provider.v1.ScheduledConsumerKeyRotation")
+	proto.RegisterType((*KeyAssignmentRecord)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentRecord")
+	proto.RegisterType((*KeyAssignmentHistoryEntry)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentHistoryEntry")
+	proto.RegisterType((*LaunchThresholds)(nil), "interchain_security.ccv.provider.v1.LaunchThresholds")
+	proto.RegisterType((*OptInPolicy)(nil), "interchain_security.ccv.provider.v1.OptInPolicy")
+	proto.RegisterType((*ConsumerEpochParams)(nil), "interchain_security.ccv.provider.v1.ConsumerEpochParams")
+	proto.RegisterType((*EpochState)(nil), "interchain_security.ccv.provider.v1.EpochState")
+	proto.RegisterType((*ChainEpochParams)(nil), "interchain_security.ccv.provider.v1.ChainEpochParams")
+	proto.RegisterType((*ConsumerOptInPolicy)(nil), "interchain_security.ccv.provider.v1.ConsumerOptInPolicy")
+	proto.RegisterType((*OptInRecord)(nil), "interchain_security.ccv.provider.v1.OptInRecord")
+	proto.RegisterType((*ValidatorOptInRecord)(nil), "interchain_security.ccv.provider.v1.ValidatorOptInRecord")
+	proto.RegisterType((*ReadinessSignal)(nil), "interchain_security.ccv.provider.v1.ReadinessSignal")
+	proto.RegisterType((*ValidatorReadinessSignal)(nil), "interchain_security.ccv.provider.v1.ValidatorReadinessSignal")
+	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
+	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
+	proto.RegisterType((*ConsumerValidator)(nil), "interchain_security.ccv.provider.v1.ConsumerValidator")
+	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+}
+
+var fileDescriptor_f22ec409a72b7b72 = []byte{
+	// 3118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xdd, 0x6f, 0x1c, 0x57,
+	0xf5, 0x19, 0x7b, 0x6d, 0xef, 0x1e, 0x7b, 0xed, 0xf5, 0xd8, 0x49, 0xc6, 0x8e, 0x7f, 0xb6, 0x33,
+	0x6d, 0xfa, 0x33, 0x0d, 0xd9, 0xad, 0x53, 0x90, 0xaa, 0x40, 0x15, 0x1c, 0x27, 0x6d, 0x9c, 0xb4,
+	0x89, 0x19, 0x1b, 0x17, 0xb5, 0x0f, 0xa3, 0xd9, 0x99, 0xeb, 0xdd, 0x5b, 0xcf, 0xcc, 0x9d, 0xce,
+	0x9d, 0x5d, 0x67, 0x8b, 0x04, 0xbc, 0x20, 0x15, 0x01, 0x52, 0x79, 0xab, 0x90, 0x80, 0x3e, 0x21,
+	0xc4, 0x03, 0xf0, 0xd0, 0x27, 0x1e, 0x79, 0x2a, 0x95, 0x10, 0x15, 0x4f, 0x48, 0x48, 0x2d, 0x4a,
+	0x1f, 0x78, 0xe0, 0x95, 0x3f, 0x00, 0xdd, 0x73, 0xef, 0x7c, 0xec, 0xda, 0x49, 0xd6, 0x75, 0xfb,
+	0x92, 0xcc, 0x3d, 0xf7, 0x9c, 0x73, 0xcf, 0x39, 0xf7, 0xdc, 0xf3, 0xb5, 0x86, 0xab, 0x34, 0x4c,
+	0x48, 0xec, 0xb6, 0x1d, 0x1a, 0xda, 0x9c, 0xb8, 0x9d, 0x98, 0x26, 0xbd, 0x86, 0xeb, 0x76, 0x1b,
+	0x51, 0xcc, 0xba, 0xd4, 0x23, 0x71, 0xa3, 0xbb, 0x9e, 0x7d, 0xd7, 0xa3, 0x98, 0x25, 0x4c, 0x7f,
+	0xea, 0x18, 0x9a, 0xba, 0xeb, 0x76, 0xeb, 0x19, 0x5e, 0x77, 0x7d, 0xf1, 0xd2, 0xa3, 0x18, 0x77,
+	0xd7, 0x1b, 0x87, 0x34, 0x26, 0x92, 0xd7, 0xe2, 0x7c, 0x8b, 0xb5, 0x18, 0x7e, 0x36, 0xc4, 0x97,
+	0x82, 0xae, 0xb4, 0x18, 0x6b, 0xf9, 0xa4, 0x81, 0xab, 0x66, 0x67, 0xbf, 0x91, 0xd0, 0x80, 0xf0,
+	0xc4, 0x09, 0x22, 0x85, 0xb0, 0x3c, 0x88, 0xe0, 0x75, 0x62, 0x27, 0xa1, 0x2c, 0x4c, 0x19, 0xd0,
+	0xa6, 0xdb, 0x70, 0x59, 0x4c, 0x1a, 0xae, 0x4f, 0x49, 0x98, 0x88, 0x53, 0xe5, 0x97, 0x42, 0x68,
+	0x08, 0x04, 0x9f, 0xb6, 0xda, 0x89, 0x04, 0xf3, 0x46, 0x42, 0x42, 0x8f, 0xc4, 0x01, 0x95, 0xc8,
+	0xf9, 0x4a, 0x11, 0x2c, 0x15, 0xf6, 0xdd, 0xb8, 0x17, 0x25, 0xac, 0x71, 0x40, 0x7a, 0x5c, 0xed,
+	0x3e, 0xe3, 0x32, 0x1e, 0x30, 0xde, 0x20, 0x42, 0xff, 0xd0, 0x25, 0x8d, 0xee, 0x7a, 0x93, 0x24,
+	0xce, 0x7a, 0x06, 0x48, 0xe5, 0x56, 0x78, 0x4d, 0x87, 0xe7, 0x38, 0x2e, 0xa3, 0xa9, 0xdc, 0x0b,
+	0x72, 0xdf, 0x96, 0x16, 0x91, 0x0b, 0xb5, 0x35, 0xeb, 0x04, 0x34, 0x64, 0x0d, 0xfc, 0x57, 0x82,
+	0xcc, 0x8f, 0xa6, 0xc0, 0xd8, 0x64, 0x21, 0xef, 0x04, 0x24, 0xde, 0xf0, 0x3c, 0x2a, 0x0c, 0xb0,
+	0x1d, 0xb3, 0x88, 0x71, 0xc7, 0xd7, 0xe7, 0x61, 0x2c, 0xa1, 0x89, 0x4f, 0x0c, 0x6d, 0x55, 0x5b,
+	0xab, 0x58, 0x72, 0xa1, 0xaf, 0xc2, 0xa4, 0x47, 0xb8, 0x1b, 0xd3, 0x48, 0x20, 0x1b, 0x23, 0xb8,
+	0x57, 0x04, 0xe9, 0x0b, 0x50, 0x96, 0xb7, 0x46, 0x3d, 0x63, 0x14, 0xb7, 0x27, 0x70, 0xbd, 0xe5,
+	0xe9, 0x2f, 0xc3, 0x34, 0x0d, 0x69, 0x42, 0x1d, 0xdf, 0x6e, 0x13, 0x61, 0x3b, 0xa3, 0xb4, 0xaa,
+	0xad, 0x4d, 0x5e, 0x5d, 0xac, 0xd3, 0xa6, 0x5b, 0x17, 0xe6, 0xae, 0x2b, 0x23, 0x77, 0xd7, 0xeb,
+	0xb7, 0x11, 0xe3, 0x46, 0xe9, 0xc3, 0x4f, 0x56, 0xce, 0x58, 0x55, 0x45, 0x27, 0x81, 0xfa, 0x45,
+	0x98, 0x6a, 0x91, 0x90, 0x70, 0xca, 0xed, 0xb6, 0xc3, 0xdb, 0xc6, 0xd8, 0xaa, 0xb6, 0x36, 0x65,
+	0x4d, 0x2a, 0xd8, 0x6d, 0x87, 0xb7, 0xf5, 0x15, 0x98, 0x6c, 0xd2, 0xd0, 0x89, 0x7b, 0x12, 0x63,
+	0x1c, 0x31, 0x40, 0x82, 0x10, 0x61, 0x13, 0x80, 0x47, 0xce, 0x61, 0x68, 0x0b, 0xdf, 0x30, 0x26,
+	0x94, 0x20, 0xd2, 0x2f, 0xea, 0xa9, 0x5f, 0xd4, 0x77, 0x53, 0xc7, 0xb9, 0x51, 0x16, 0x82, 0xbc,
+	0xfb, 0xe9, 0x8a, 0x66, 0x55, 0x90, 0x4e, 0xec, 0xe8, 0xf7, 0xa0, 0xd6, 0x09, 0x9b, 0x2c, 0xf4,
+	0x68, 0xd8, 0xb2, 0x23, 0x12, 0x53, 0xe6, 0x19, 0x65, 0x64, 0xb5, 0x70, 0x84, 0xd5, 0x4d, 0xe5,
+	0x62, 0x92, 0xd3, 0x7b, 0x82, 0xd3, 0x4c, 0x46, 0xbc, 0x8d, 0xb4, 0xfa, 0xb7, 0x41, 0x77, 0xdd,
+	0x2e, 0x8a, 0xc4, 0x3a, 0x49, 0xca, 0xb1, 0x32, 0x3c, 0xc7, 0x9a, 0xeb, 0x76, 0x77, 0x25, 0xb5,
+	0x62, 0xf9, 0x06, 0x9c, 0x4f, 0x62, 0x27, 0xe4, 0xfb, 0x24, 0x1e, 0xe4, 0x0b, 0xc3, 0xf3, 0x3d,
+	0x9b, 0xf2, 0xe8, 0x67, 0x7e, 0x1b, 0x56, 0x5d, 0xe5, 0x40, 0x76, 0x4c, 0x3c, 0xca, 0x93, 0x98,
+	0x36, 0x3b, 0x82, 0xd6, 0xde, 0x8f, 0x1d, 0x17, 0x7d, 0x64, 0x12, 0x9d, 0x60, 0x39, 0xc5, 0xb3,
+	0xfa, 0xd0, 0x5e, 0x52, 0x58, 0xfa, 0x7d, 0x78, 0xba, 0xe9, 0x33, 0xf7, 0x80, 0x0b, 0xe1, 0xec,
+	0x3e, 0x4e, 0x78, 0x74, 0x40, 0x39, 0x17, 0xdc, 0xa6, 0x56, 0xb5, 0xb5, 0x51, 0xeb, 0xa2, 0xc4,
+	0xdd, 0x26, 0xf1, 0xcd, 0x02, 0xe6, 0x6e, 0x01, 0x51, 0xbf, 0x02, 0x7a, 0x9b, 0xf2, 0x84, 0xc5,
+	0xd4, 0x75, 0x7c, 0x9b, 0x84, 0x49, 0x4c, 0x09, 0x37, 0xaa, 0x48, 0x3e, 0x9b, 0xef, 0xdc, 0x92,
+	0x1b, 0xfa, 0x1d, 0xb8, 0xf8, 0xc8, 0x43, 0x6d, 0xb7, 0xed, 0x84, 0x21, 0xf1, 0x8d, 0x69, 0x54,
+	0x65, 0xc5, 0x7b, 0xc4, 0x99, 0x9b, 0x12, 0x4d, 0x9f, 0x83, 0xb1, 0x84, 0x45, 0xf6, 0x3d, 0x63,
+	0x66, 0x55, 0x5b, 0xab, 0x5a, 0xa5, 0x84, 0x45, 0xf7, 0xf4, 0xe7, 0x60, 0xbe, 0xeb, 0xf8, 0xd4,
+	0x73, 0x12, 0x16, 0x73, 0x3b, 0x62, 0x87, 0x24, 0xb6, 0x5d, 0x27, 0x32, 0x6a, 0x88, 0xa3, 0xe7,
+	0x7b, 0xdb, 0x62, 0x6b, 0xd3, 0x89, 0xf4, 0x67, 0x61, 0x36, 0x83, 0xda, 0x9c, 0x24, 0x88, 0x3e,
+	0x8b, 0xe8, 0x33, 0xd9, 0xc6, 0x0e, 0x49, 0x04, 0xee, 0x12, 0x54, 0x1c, 0xdf, 0x67, 0x87, 0x3e,
+	0xe5, 0x89, 0xa1, 0xaf, 0x8e, 0xae, 0x55, 0xac, 0x1c, 0xa0, 0x2f, 0x42, 0xd9, 0x23, 0x61, 0x0f,
+	0x37, 0xe7, 0x70, 0x33, 0x5b, 0xeb, 0x4f, 0x41, 0xd5, 0x65, 0x61, 0x48, 0xf0, 0x1a, 0xc4, 0xa3,
+	0x9d, 0x47, 0x25, 0xa7, 0x72, 0xe0, 0x96, 0xf0, 0xcb, 0x72, 0x40, 0x12, 0xc7, 0x73, 0x12, 0xc7,
+	0x38, 0x8b, 0x5e, 0xf3, 0xf5, 0xfa, 0x10, 0x51, 0xbc, 0x9e, 0x46, 0x97, 0x57, 0x15, 0xb1, 0x95,
+	0xb1, 0x11, 0xf1, 0x85, 0x1d, 0x86, 0x24, 0x36, 0xce, 0xc9, 0xf8, 0x82, 0x0b, 0x21, 0x69, 0x4c,
+	0x7c, 0xa7, 0x13, 0xba, 0x6d, 0xe3, 0xfc, 0xaa, 0xb6, 0x56, 0xb6, 0xb2, 0xb5, 0xb0, 0x07, 0xaa,
+	0x44, 0x3c, 0xfb, 0x80, 0xf4, 0xec, 0xa4, 0x17, 0x11, 0x6e, 0x18, 0xa8, 0xce, 0x8c, 0xda, 0xb8,
+	0x4b, 0x7a, 0xbb, 0x02, 0xac, 0xef, 0x42, 0x95, 0x45, 0x89, 0x4d, 0x43, 0x3b, 0x62, 0x3e, 0x75,
+	0x7b, 0xc6, 0x02, 0x4a, 0xfd, 0xdc, 0x50, 0x52, 0xdf, 0x8f, 0x92, 0xad, 0x70, 0x1b, 0xe9, 0xac,
+	0x49, 0x96, 0x2f, 0xf4, 0x26, 0xcc, 0x4a, 0x59, 0xec, 0xa4, 0x1d, 0x13, 0xde, 0x66, 0xbe, 0xc7,
+	0x8d, 0xc5, 0x13, 0xd8, 0xe3, 0x15, 0xa4, 0xde, 0xcd, 0x88, 0xad, 0x9a, 0x3f, 0x00, 0xd1, 0xd7,
+	0x61, 0x5e, 0x9d, 0x11, 0x31, 0x9e, 0x44, 0x2c, 0x24, 0x81, 0xc8, 0x2f, 0xc6, 0x05, 0xbc, 0xf8,
+	0x39, 0xb9, 0xb7, 0x5d, 0xdc, 0xd2, 0xdf, 0x80, 0x29, 0x12, 0x31, 0x41, 0xe1, 0xc4, 0x4e, 0xc0,
+	0x8d, 0x25, 0x94, 0xe8, 0x85, 0x13, 0xdd, 0xd0, 0x2d, 0xc1, 0x60, 0x1b, 0xe9, 0xad, 0x49, 0x92,
+	0x2f, 0xae, 0x3d, 0xf3, 0xce, 0xfb, 0x2b, 0x67, 0xde, 0x7b, 0x7f, 0xe5, 0xcc, 0x47, 0x1f, 0x5c,
+	0x59, 0x54, 0x19, 0xa5, 0xc5, 0xba, 0x75, 0x95, 0x7d, 0x04, 0x83, 0x84, 0x84, 0x89, 0xf9, 0x5f,
+	0x0d, 0x6a, 0x83, 0xd7, 0xad, 0xeb, 0x50, 0x0a, 0x9d, 0x20, 0xcd, 0x21, 0xf8, 0x3d, 0x44, 0x0a,
+	0x31, 0x60, 0xe2, 0x90, 0x34, 0x39, 0x4d, 0x48, 0x9a, 0x41, 0xd4, 0x52, 0xbf, 0x0c, 0xb3, 0x2a,
+	0xaa, 0xc7, 0x24, 0x62, 0x9c, 0x26, 0x2c, 0xee, 0x61, 0x12, 0xa9, 0x58, 0x35, 0xb9, 0x61, 0x65,
+	0x70, 0x91, 0x02, 0xd2, 0x2c, 0xd1, 0x89, 0x7d, 0x4c, 0x12, 0x15, 0x0b, 0x14, 0xe8, 0x3b, 0xb1,
+	0x7f, 0x5c, 0x8e, 0xa8, 0xf4, 0xe5, 0x88, 0xc1, 0x3c, 0x33, 0x21, 0x65, 0x2d, 0xe4, 0x19, 0xf3,
+	0x27, 0x1a, 0x9c, 0x4d, 0xd5, 0xde, 0x14, 0xa6, 0xce, 0x74, 0x2f, 0x26, 0x42, 0xad, 0x3f, 0x11,
+	0xbe, 0x56, 0x78, 0x4e, 0x23, 0xa7, 0x78, 0x4e, 0x2a, 0x3b, 0x66, 0xcc, 0xcc, 0x8f, 0x34, 0xa8,
+	0xa6, 0x48, 0xdb, 0x4e, 0x87, 0x13, 0xfd, 0x02, 0x54, 0x22, 0xf1, 0xe1, 0xd9, 0xcd, 0x9e, 0x12,
+	0xa3, 0x2c, 0x01, 0x37, 0x7a, 0x22, 0x6a, 0x90, 0x80, 0xc4, 0x2d, 0x12, 0xba, 0x3d, 0x14, 0xa4,
+	0x6c, 0xe5, 0x00, 0xfd, 0x1c, 0x8c, 0xc7, 0xc4, 0xe1, 0x2c, 0x54, 0xb7, 0xa0, 0x56, 0xc2, 0x2a,
+	0xc8, 0xa1, 0x98, 0xc4, 0x47, 0xad, 0x49, 0x84, 0xa9, 0x04, 0xbd, 0x09, 0x20, 0x51, 0x30, 0xb9,
+	0x8e, 0x9d, 0x24, 0xb9, 0x22, 0x9d, 0xd8, 0x31, 0xbf, 0x07, 0xd3, 0xa8, 0x83, 0x97, 0x6a, 0xf4,
+	0x38, 0x93, 0xde, 0x83, 0x31, 0xa4, 0x54, 0xf6, 0xbc, 0x7a, 0x22, 0x7b, 0xe2, 0x31, 0xca, 0x98,
+	0x92, 0x8d, 0xf9, 0x4f, 0x0d, 0x66, 0x76, 0x12, 0x16, 0x45, 0x85, 0xe3, 0xdb, 0x50, 0x4d, 0x9f,
+	0xa6, 0x7c, 0x68, 0x1a, 0x9e, 0xf5, 0xe2, 0x89, 0xce, 0x1a, 0x2c, 0xb4, 0xd4, 0xb1, 0x53, 0xea,
+	0x61, 0x23, 0x63, 0x71, 0x6b, 0xb2, 0x12, 0x12, 0x9a, 0xca, 0x17, 0x52, 0x96, 0x80, 0x2d, 0x4f,
+	0xdf, 0x80, 0x0a, 0x17, 0xf9, 0x05, 0x6d, 0x3b, 0x7a, 0x02, 0xdb, 0x96, 0x05, 0x19, 0x9a, 0xf6,
+	0x5b, 0xb9, 0x9b, 0xdc, 0xc7, 0xb8, 0xfb, 0x18, 0xcb, 0x66, 0x81, 0x7a, 0xa4, 0x10, 0xa8, 0xcd,
+	0xbf, 0x69, 0x70, 0x7e, 0x33, 0x4b, 0xe9, 0x01, 0xeb, 0x3a, 0xfe, 0x97, 0x59, 0x3a, 0xf6, 0xe9,
+	0x5c, 0xfa, 0x3c, 0x3a, 0x5f, 0x5b, 0x7e, 0x42, 0x00, 0xfb, 0xd5, 0x08, 0x2c, 0x65, 0x0f, 0x8c,
+	0x79, 0x74, 0x9f, 0xba, 0xce, 0x97, 0x5d, 0x11, 0x67, 0x95, 0x42, 0x69, 0x88, 0x4a, 0x61, 0xec,
+	0x64, 0x95, 0xc2, 0xf8, 0x10, 0x95, 0xc2, 0xc4, 0xe3, 0x2a, 0x85, 0x72, 0x7f, 0xa5, 0x60, 0xfe,
+	0x5a, 0x83, 0xf9, 0x5b, 0x6f, 0x75, 0x68, 0x97, 0x7d, 0x41, 0x86, 0xb9, 0x0b, 0x55, 0x52, 0xe0,
+	0xc7, 0x8d, 0xd1, 0xd5, 0xd1, 0xb5, 0xc9, 0xab, 0x97, 0xea, 0xea, 0x96, 0xb2, 0xe6, 0x27, 0xbd,
+	0xaa, 0xe2, 0xe9, 0x56, 0x3f, 0xed, 0xb5, 0x11, 0x43, 0x33, 0xff, 0xac, 0xc1, 0xa2, 0x28, 0xc2,
+	0x5a, 0xc4, 0x22, 0x87, 0x4e, 0xec, 0xdd, 0x24, 0x21, 0x0b, 0xf8, 0xa9, 0xe5, 0x34, 0xa1, 0xea,
+	0x21, 0x27, 0x3b, 0x61, 0xb6, 0xe3, 0x79, 0x28, 0x27, 0xe2, 0x08, 0xe0, 0x2e, 0xdb, 0xf0, 0x3c,
+	0x7d, 0x0d, 0x6a, 0x39, 0x4e, 0x2c, 0x1e, 0x84, 0xf0, 0x53, 0x81, 0x36, 0x9d, 0xa2, 0xe1, 0x33,
+	0x79, 0xb2, 0x1f, 0xfe, 0x47, 0x83, 0xda, 0xcb, 0x3e, 0x6b, 0x3a, 0xfe, 0x8e, 0xef, 0xf0, 0xb6,
+	0x28, 0x50, 0x7b, 0xc2, 0xff, 0x63, 0xa2, 0x3a, 0x03, 0x15, 0x76, 0x86, 0xf4, 0x7f, 0x41, 0x86,
+	0xbd, 0xca, 0x75, 0x98, 0xcd, 0x6a, 0xf5, 0xcc, 0x1f, 0x51, 0xdb, 0x1b, 0x73, 0x0f, 0x3f, 0x59,
+	0x99, 0xe9, 0xcb, 0x62, 0x5b, 0x37, 0xad, 0x19, 0xb7, 0x0f, 0xe0, 0xe9, 0xcb, 0x30, 0x49, 0x9b,
+	0xae, 0xcd, 0xc9, 0x5b, 0x76, 0xd8, 0x09, 0xd0, 0x95, 0x4b, 0x56, 0x85, 0x36, 0xdd, 0x1d, 0xf2,
+	0xd6, 0xbd, 0x4e, 0xa0, 0x3f, 0x0f, 0xe7, 0xd2, 0x80, 0x67, 0x77, 0x1d, 0xdf, 0x16, 0xf4, 0xc2,
+	0x5c, 0x31, 0x7a, 0xf7, 0x94, 0x35, 0x97, 0xee, 0xee, 0x39, 0xbe, 0x38, 0x6c, 0xc3, 0xf3, 0x62,
+	0xf3, 0xbd, 0x32, 0x8c, 0xab, 0xa0, 0xb7, 0x0b, 0x33, 0x09, 0x09, 0x22, 0xdf, 0x49, 0x88, 0x2d,
+	0x83, 0x9d, 0xd2, 0xf4, 0x32, 0xf6, 0x87, 0xc5, 0x6e, 0xbb, 0x5e, 0xe8, 0xaf, 0x45, 0x6c, 0x45,
+	0xe8, 0x4e, 0xe2, 0x24, 0xc4, 0x9a, 0x4e, 0x79, 0x48, 0xa0, 0xfe, 0x02, 0x18, 0x49, 0xdc, 0xe1,
+	0x49, 0xde, 0xa1, 0xe5, 0xad, 0x89, 0xbc, 0xeb, 0x73, 0xe9, 0xbe, 0x6c, 0x6a, 0xb2, 0x96, 0xe4,
+	0xf8, 0x66, 0x6c, 0xf4, 0x34, 0xcd, 0x98, 0x07, 0x4b, 0x5c, 0x5c, 0xaa, 0x1d, 0x90, 0x04, 0x5b,
+	0xa6, 0xc8, 0x27, 0x21, 0xe5, 0xed, 0x94, 0xf9, 0xf8, 0xf0, 0xcc, 0x17, 0x90, 0xd1, 0xab, 0x82,
+	0x8f, 0x95, 0xb2, 0x51, 0xa7, 0x6c, 0xc2, 0xf2, 0xf1, 0xa7, 0x64, 0x8a, 0xcb, 0x42, 0xe6, 0xc2,
+	0x31, 0x2c, 0x32, 0xed, 0x39, 0x3c, 0x53, 0x68, 0xed, 0xc4, 0x6b, 0xb2, 0xd1, 0x91, 0xed, 0x98,
+	0xb4, 0x44, 0xff, 0xe3, 0xc8, 0x2e, 0x8f, 0x90, 0xac, 0x3d, 0x55, 0x3e, 0xdd, 0x74, 0x38, 0x29,
+	0x38, 0x35, 0x0d, 0x55, 0x86, 0x33, 0xf3, 0x0e, 0x30, 0x7b, 0x9b, 0x56, 0x81, 0xd7, 0x4b, 0x84,
+	0x88, 0x57, 0x54, 0xe8, 0x02, 0xb1, 0x0c, 0xc5, 0x2e, 0x75, 0xd4, 0x9a, 0xce, 0x3a, 0x3e, 0xac,
+	0x54, 0xf5, 0xd7, 0xe1, 0x72, 0xd8, 0x09, 0x9a, 0x24, 0xb6, 0xd9, 0xbe, 0x44, 0xc4, 0x97, 0xc7,
+	0x13, 0x27, 0x4e, 0xec, 0x98, 0xb8, 0x84, 0x76, 0xc5, 0x8d, 0x4b, 0xc9, 0x39, 0x36, 0xa1, 0xa3,
+	0xd6, 0x25, 0x49, 0x72, 0x7f, 0x1f, 0x79, 0xf0, 0x5d, 0xb6, 0x23, 0xd0, 0xad, 0x14, 0x5b, 0x0a,
+	0xc6, 0xf5, 0x2b, 0x30, 0x17, 0x38, 0x0f, 0xec, 0x2e, 0x77, 0xed, 0xc8, 0x71, 0x0f, 0x48, 0x62,
+	0x73, 0xfa, 0x36, 0x51, 0xad, 0x67, 0x2d, 0x70, 0x1e, 0xec, 0x71, 0x77, 0x1b, 0x37, 0x76, 0xe8,
+	0xdb, 0x44, 0xdf, 0x82, 0xb9, 0xac, 0x68, 0xb2, 0x9d, 0x4e, 0xd2, 0x66, 0xa2, 0x00, 0xc0, 0x56,
+	0xb3, 0x72, 0xc3, 0xf8, 0xfb, 0x07, 0x57, 0xe6, 0x95, 0x65, 0x84, 0xc3, 0x13, 0xce, 0x77, 0x92,
+	0x58, 0x1c, 0xa6, 0x67, 0x44, 0x1b, 0x29, 0x8d, 0xfe, 0x22, 0x5c, 0x10, 0xad, 0x8d, 0xc3, 0x39,
+	0x6d, 0x85, 0xa2, 0xb8, 0xb7, 0x65, 0xa7, 0xda, 0x93, 0x12, 0x4c, 0xa3, 0x04, 0xc6, 0x01, 0xe9,
+	0x6d, 0x64, 0x18, 0xb7, 0x25, 0x02, 0x4a, 0x72, 0x07, 0xa6, 0x65, 0x23, 0x90, 0x8e, 0xb3, 0xb0,
+	0x03, 0x1d, 0xd2, 0xa1, 0xaa, 0x48, 0x9a, 0x6e, 0xe8, 0x21, 0x5c, 0xe4, 0xb2, 0xfe, 0xb1, 0x0b,
+	0x7e, 0x20, 0x22, 0x94, 0xb8, 0x77, 0xe5, 0xaf, 0xb5, 0xe1, 0xd9, 0x2f, 0xf3, 0xfe, 0x6a, 0xca,
+	0x4a, 0x79, 0x49, 0xa7, 0xbd, 0x53, 0x2a, 0x97, 0x6a, 0x63, 0x77, 0x4a, 0xe5, 0xb1, 0xda, 0xf8,
+	0x9d, 0x52, 0xb9, 0x5c, 0xab, 0x98, 0x5f, 0x81, 0x0a, 0x46, 0xc0, 0x0d, 0xf7, 0x80, 0x63, 0xda,
+	0x92, 0xe6, 0x23, 0xa2, 0xee, 0x92, 0x69, 0x2b, 0x05, 0x98, 0x09, 0x2c, 0x3c, 0xaa, 0xbe, 0xe2,
+	0xfa, 0x6b, 0x30, 0x11, 0x11, 0x9c, 0xb2, 0x20, 0xe1, 0x69, 0x0b, 0x36, 0x2b, 0xe5, 0x66, 0xc6,
+	0xf9, 0xf8, 0x6c, 0xa0, 0x04, 0xe2, 0xfa, 0xde, 0xe0, 0xa1, 0xdf, 0x3c, 0xd1, 0xa1, 0x03, 0xfc,
+	0xf2, 0x33, 0x2f, 0xc3, 0xa4, 0x72, 0xa3, 0x57, 0x44, 0xbe, 0x3e, 0x62, 0x96, 0xa9, 0xa2, 0x59,
+	0xee, 0xc0, 0xb4, 0x9a, 0x49, 0xec, 0x32, 0x8c, 0xe2, 0xfa, 0xff, 0x01, 0xa8, 0x61, 0x46, 0x5e,
+	0xe9, 0x55, 0x14, 0x64, 0xcb, 0xeb, 0x2b, 0x55, 0x46, 0xfa, 0x4a, 0x15, 0x93, 0xc1, 0xc2, 0x5e,
+	0xb1, 0x94, 0xc0, 0x34, 0x2b, 0x5f, 0x01, 0xd7, 0x2d, 0x28, 0x61, 0xc9, 0x20, 0x55, 0x7d, 0x74,
+	0xe7, 0xd9, 0x5d, 0xaf, 0x3f, 0x8a, 0xc9, 0xcd, 0xbc, 0x9f, 0x41, 0x5e, 0xe6, 0xcf, 0x35, 0x30,
+	0xee, 0x16, 0x3d, 0x5d, 0xc4, 0x28, 0xc7, 0xc5, 0x9e, 0x57, 0x7f, 0x0a, 0xaa, 0x59, 0xae, 0xc1,
+	0x14, 0xa3, 0x61, 0x8a, 0x99, 0x4a, 0x81, 0xc2, 0x46, 0xfa, 0x35, 0x80, 0x28, 0x26, 0x5d, 0xdb,
+	0xb5, 0x0f, 0x48, 0x4f, 0x35, 0x06, 0x4b, 0xc5, 0xd4, 0x21, 0x07, 0xb1, 0xf5, 0xed, 0x4e, 0xd3,
+	0xa7, 0xee, 0x5d, 0xd2, 0xb3, 0xca, 0x02, 0x7f, 0xf3, 0x2e, 0xe9, 0x89, 0x5a, 0x01, 0x2b, 0x2f,
+	0x8c, 0xf7, 0xa3, 0x96, 0x5c, 0x98, 0xbf, 0xd0, 0xe0, 0x7c, 0xa6, 0x40, 0xd6, 0x3d, 0x74, 0x9a,
+	0x82, 0xe2, 0x31, 0x25, 0xf4, 0x11, 0x69, 0x47, 0x8e, 0x91, 0xf6, 0x3a, 0x4c, 0x65, 0x0f, 0x4d,
+	0xc8, 0x3b, 0x3a, 0x84, 0xbc, 0x93, 0x29, 0xc5, 0x5d, 0xd2, 0x33, 0xdf, 0x04, 0xbd, 0xcf, 0x5e,
+	0xf7, 0x58, 0xe8, 0x92, 0x53, 0x8b, 0x35, 0x0f, 0x63, 0xa1, 0x60, 0xa4, 0xf2, 0xbd, 0x5c, 0x98,
+	0x3f, 0x80, 0xb9, 0xcd, 0xfc, 0x68, 0x8b, 0x25, 0x32, 0x68, 0xdc, 0x1a, 0xd0, 0x41, 0x7b, 0xb2,
+	0x0e, 0xea, 0xce, 0x8b, 0x9a, 0x88, 0x0e, 0xd3, 0x89, 0x22, 0xbf, 0x97, 0x76, 0x98, 0x23, 0xb2,
+	0xc3, 0x44, 0x98, 0xec, 0x30, 0xcd, 0x3f, 0x69, 0xb0, 0xb4, 0xe3, 0xb6, 0x89, 0xd7, 0xf1, 0xf3,
+	0x98, 0x52, 0x14, 0xe5, 0xb4, 0x7a, 0xbf, 0x0e, 0xe5, 0x58, 0xf1, 0x52, 0x57, 0x71, 0xb2, 0x81,
+	0x4a, 0x41, 0x96, 0xb4, 0x4d, 0x4f, 0xf9, 0x99, 0xef, 0x8c, 0xc0, 0xdc, 0x80, 0x6b, 0xbb, 0x2c,
+	0xf6, 0xbe, 0x28, 0xf3, 0xfd, 0x3f, 0xcc, 0xc8, 0x0c, 0x42, 0xbc, 0x7e, 0x0b, 0x4e, 0xa7, 0x60,
+	0xd5, 0xa6, 0xaf, 0x41, 0x8d, 0xec, 0xef, 0x13, 0x37, 0xa1, 0x5d, 0x82, 0xe9, 0x4e, 0x75, 0x28,
+	0x25, 0x6b, 0x3a, 0x83, 0xef, 0x71, 0x77, 0xcb, 0xd3, 0x2f, 0xc3, 0x2c, 0xef, 0x44, 0x24, 0xe6,
+	0xc4, 0xcb, 0x99, 0xca, 0xc6, 0xbf, 0x96, 0x6f, 0x28, 0xb6, 0xcf, 0xf6, 0x21, 0x2b, 0xbe, 0x63,
+	0xc8, 0x77, 0x26, 0xdf, 0x40, 0xc6, 0xe6, 0x5f, 0x34, 0x58, 0xb8, 0x7b, 0x4c, 0x3e, 0x93, 0x65,
+	0xef, 0x17, 0xe0, 0xbc, 0x34, 0xf4, 0xc8, 0x83, 0xd4, 0x79, 0x71, 0xa1, 0xef, 0xc1, 0x78, 0x8c,
+	0x06, 0x57, 0x9d, 0xe4, 0x70, 0x17, 0x7b, 0xcc, 0x85, 0x29, 0xe3, 0x2b, 0x6e, 0xe6, 0x1f, 0x46,
+	0xa0, 0x36, 0x38, 0xe1, 0xd3, 0x2f, 0xc1, 0x74, 0x40, 0x43, 0x3b, 0xef, 0xda, 0x50, 0x91, 0xaa,
+	0x55, 0x0d, 0x68, 0x98, 0x85, 0x12, 0x2e, 0x9a, 0xbe, 0x00, 0xa7, 0x95, 0xa2, 0xdb, 0x8b, 0x48,
+	0xec, 0x92, 0x30, 0x71, 0x5a, 0x72, 0x9c, 0x51, 0xb5, 0xf4, 0x80, 0x86, 0xd8, 0xed, 0x6d, 0x67,
+	0x3b, 0xfa, 0x77, 0xe1, 0x6c, 0x71, 0x42, 0x68, 0xa3, 0x0e, 0x5d, 0xc7, 0x3f, 0x49, 0x85, 0x3a,
+	0x5f, 0xe4, 0xb0, 0xa5, 0x18, 0x88, 0xcb, 0x16, 0xf5, 0x4f, 0xff, 0xfc, 0x51, 0x76, 0xa8, 0xa2,
+	0xfa, 0xe9, 0x1f, 0x3e, 0x7e, 0x03, 0x16, 0x85, 0xe0, 0x31, 0x71, 0xbc, 0xde, 0x51, 0xf1, 0x65,
+	0xcf, 0x7a, 0x3e, 0xa0, 0xa1, 0x25, 0x10, 0x06, 0x74, 0x30, 0xbb, 0x30, 0x59, 0x18, 0xb6, 0xea,
+	0x57, 0xe1, 0xac, 0xe0, 0xe5, 0xb2, 0x20, 0xa0, 0x09, 0x2a, 0x25, 0x2b, 0x3b, 0x65, 0xb2, 0xb9,
+	0x80, 0x86, 0x9b, 0xd9, 0x9e, 0xac, 0xe1, 0x44, 0xd7, 0xa1, 0x26, 0xbd, 0x2e, 0x63, 0xbe, 0xc7,
+	0x0e, 0xc3, 0x94, 0x48, 0x9a, 0x6e, 0x0e, 0x07, 0xb8, 0x9b, 0x6a, 0x4f, 0x12, 0x99, 0xd7, 0xf3,
+	0xf0, 0x55, 0x18, 0x7c, 0x1e, 0x5b, 0x7e, 0x6a, 0xc7, 0x95, 0x9f, 0xe6, 0x4f, 0x35, 0x00, 0xfc,
+	0xc2, 0xa6, 0x43, 0x3f, 0x07, 0xe3, 0xb2, 0xb4, 0x44, 0xf4, 0x92, 0xa5, 0x56, 0x22, 0x90, 0xc9,
+	0x8a, 0xb4, 0x3f, 0x90, 0x21, 0x2c, 0x1f, 0x95, 0x49, 0x94, 0x13, 0x8f, 0x73, 0x2a, 0x48, 0x87,
+	0xf3, 0x9c, 0x1f, 0x69, 0x50, 0xc3, 0x04, 0x5f, 0xd4, 0xe6, 0x31, 0x8f, 0x67, 0x0f, 0xc6, 0xd5,
+	0x08, 0x6b, 0xe4, 0x74, 0xb3, 0xe2, 0xf4, 0x05, 0x48, 0x6e, 0xe6, 0x0f, 0xb5, 0xdc, 0xb0, 0xc5,
+	0x8b, 0x7d, 0xec, 0xe0, 0x6e, 0x5c, 0x8d, 0xe8, 0x47, 0x3e, 0xdf, 0x88, 0x3e, 0x13, 0x01, 0x57,
+	0xe6, 0x2f, 0x35, 0xe5, 0x53, 0x2a, 0xa6, 0x9a, 0xd9, 0x2f, 0x01, 0xea, 0x0e, 0xe4, 0x85, 0xca,
+	0xb9, 0xbe, 0xba, 0x83, 0xa7, 0x61, 0x5a, 0xe0, 0x88, 0x2e, 0xaf, 0xef, 0xa2, 0xa6, 0x58, 0x94,
+	0xdc, 0xef, 0xa4, 0x37, 0xb5, 0x0a, 0x53, 0x8a, 0x93, 0xf4, 0x0c, 0x19, 0x53, 0x00, 0x19, 0xc9,
+	0xa6, 0x44, 0x9d, 0x25, 0xf8, 0x48, 0x94, 0x12, 0xa2, 0x4c, 0x4a, 0x36, 0xd2, 0x73, 0x7e, 0xa3,
+	0xc1, 0x7c, 0xf6, 0xee, 0x8b, 0x82, 0x9e, 0x36, 0xd6, 0xdd, 0xcb, 0xa2, 0xda, 0xe8, 0x49, 0x0d,
+	0x79, 0x6c, 0x34, 0x0b, 0x60, 0x46, 0xbc, 0x59, 0x1a, 0x8a, 0x96, 0x85, 0xb6, 0x42, 0xc7, 0x3f,
+	0x32, 0x0f, 0xd7, 0x9e, 0xf8, 0xbb, 0xeb, 0xc8, 0x91, 0xdf, 0x5d, 0xcf, 0xc1, 0xb8, 0xb2, 0xb1,
+	0xac, 0xac, 0xd4, 0xca, 0xfc, 0xbd, 0x06, 0x46, 0x66, 0x97, 0xc1, 0x83, 0x4f, 0x6b, 0x1b, 0x0b,
+	0xc6, 0x39, 0x72, 0x52, 0xb6, 0xf9, 0xda, 0x50, 0xb6, 0x19, 0x90, 0x22, 0xb5, 0x8f, 0xe4, 0x64,
+	0x7e, 0xbf, 0x50, 0x0a, 0xde, 0xe8, 0x15, 0xba, 0x85, 0xf8, 0x09, 0xe2, 0x66, 0x29, 0xbe, 0x28,
+	0xae, 0x5b, 0xa4, 0x3f, 0xa2, 0xd3, 0xe8, 0x51, 0x9d, 0xcc, 0xbf, 0x6a, 0x70, 0xae, 0x78, 0x2a,
+	0xdf, 0x65, 0xdb, 0x71, 0x27, 0x24, 0x7b, 0x57, 0x1f, 0x77, 0xfe, 0x75, 0x28, 0x47, 0x02, 0xcb,
+	0x4e, 0xd2, 0xb7, 0x3f, 0x5c, 0xb0, 0x99, 0x40, 0xaa, 0x5d, 0xd1, 0x4d, 0x4d, 0xf7, 0x29, 0xc0,
+	0x4f, 0xe4, 0x6e, 0x85, 0xde, 0xc5, 0xaa, 0x16, 0x75, 0xe6, 0xe6, 0x8f, 0x47, 0x60, 0x36, 0xd5,
+	0x27, 0x33, 0xac, 0xfe, 0x55, 0xd0, 0x33, 0x53, 0xe4, 0x03, 0x25, 0xe9, 0x78, 0xb5, 0x74, 0x27,
+	0x9d, 0x26, 0xe5, 0x55, 0xfb, 0x48, 0xa1, 0x6a, 0xd7, 0x5f, 0x81, 0xb9, 0x4c, 0xe4, 0x08, 0x0b,
+	0xa7, 0xa1, 0x0b, 0xec, 0x6c, 0x64, 0x96, 0x81, 0x84, 0x87, 0xbf, 0xc9, 0xf2, 0x70, 0x22, 0x8b,
+	0x20, 0x10, 0x20, 0x15, 0x27, 0x36, 0xa0, 0x82, 0x08, 0x27, 0xfe, 0xed, 0xa3, 0x2c, 0xc8, 0x30,
+	0x9e, 0xff, 0x4c, 0xcb, 0x1b, 0x5a, 0x35, 0x95, 0xd8, 0xf0, 0x7d, 0x35, 0xeb, 0xd4, 0x23, 0x98,
+	0x48, 0xe7, 0x1a, 0xb2, 0xe1, 0x5a, 0x3a, 0x76, 0xf6, 0x72, 0x93, 0xb8, 0x38, 0x7e, 0x79, 0x41,
+	0x1c, 0xf0, 0xbb, 0x4f, 0x57, 0x2e, 0xb7, 0x68, 0xd2, 0xee, 0x34, 0xeb, 0x2e, 0x0b, 0xd4, 0x9f,
+	0x86, 0xa8, 0xff, 0xae, 0x70, 0xef, 0xa0, 0x81, 0x3f, 0xa7, 0xa6, 0x34, 0xfc, 0xb7, 0xff, 0xfe,
+	0xe3, 0xb3, 0x9a, 0x95, 0x1e, 0x73, 0xe3, 0xb5, 0x0f, 0x1f, 0x2e, 0x6b, 0x1f, 0x3f, 0x5c, 0xd6,
+	0xfe, 0xf5, 0x70, 0x59, 0x7b, 0xf7, 0xb3, 0xe5, 0x33, 0x1f, 0x7f, 0xb6, 0x7c, 0xe6, 0x1f, 0x9f,
+	0x2d, 0x9f, 0x79, 0xfd, 0xc5, 0x02, 0x53, 0xc7, 0xf7, 0x69, 0xd8, 0xa4, 0x09, 0x6f, 0xe4, 0xae,
+	0x70, 0x25, 0xfb, 0xe3, 0x9d, 0x07, 0xfd, 0x7f, 0x17, 0x84, 0xe7, 0x35, 0xc7, 0xd1, 0x20, 0xcf,
+	0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x19, 0xc0, 0x4b, 0xe1, 0x48, 0x24, 0x00, 0x00,
+}
+
+func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2327,19 +3110,19 @@ func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConsumerKey != nil {
+	if m.EpochParams != nil {
 		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.EpochParams.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2347,92 +3130,34 @@ func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintProvider(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xe2
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+	if m.LaunchPostponements != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.LaunchPostponements))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xd8
 	}
-	if len(m.ConsumerAddr) > 0 {
-		i -= len(m.ConsumerAddr)
-		copy(dAtA[i:], m.ConsumerAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddr)))
+	if m.LaunchThresholds != nil {
+		{
+			size, err := m.LaunchThresholds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConsumerAddrsToPruneV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0xd2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConsumerAddrsToPruneV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerAddrs != nil {
+	if m.OptInPolicy != nil {
 		{
-			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OptInPolicy.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2440,54 +3165,45 @@ func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintProvider(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
-	if err15 != nil {
-		return 0, err15
+	if len(m.AllowedKeyTypes) > 0 {
+		for iNdEx := len(m.AllowedKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedKeyTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedKeyTypes[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.AllowedKeyTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	i -= n15
-	i = encodeVarintProvider(dAtA, i, uint64(n15))
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+	if m.Relaunch {
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConsumerValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		if m.Relaunch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConsumerValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConsumerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.JoinHeight != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.JoinHeight))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.ConsumerPublicKey != nil {
+	if m.Metadata != nil {
 		{
-			size, err := m.ConsumerPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2495,539 +3211,5709 @@ func (m *ConsumerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintProvider(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xaa
 	}
-	if len(m.ProviderConsAddr) > 0 {
-		i -= len(m.ProviderConsAddr)
-		copy(dAtA[i:], m.ProviderConsAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConnectionId)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProvider(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ConsumerAdditionProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.Top_N != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.DistributionTransmissionChannel) > 0 {
+		i -= len(m.DistributionTransmissionChannel)
+		copy(dAtA[i:], m.DistributionTransmissionChannel)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
+		i--
+		dAtA[i] = 0x72
 	}
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerDistributionTransmission != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ConsumerRedistributionFraction) > 0 {
+		i -= len(m.ConsumerRedistributionFraction)
+		copy(dAtA[i:], m.ConsumerRedistributionFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	i -= n5
+	i = encodeVarintProvider(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x52
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	l = len(m.DistributionTransmissionChannel)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n6
+	i = encodeVarintProvider(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x4a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
+	i -= n7
+	i = encodeVarintProvider(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x42
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 2 + sovProvider(uint64(m.ValidatorsPowerCap))
+	i -= n8
+	i = encodeVarintProvider(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 2 + sovProvider(uint64(m.ValidatorSetCap))
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 2 + l + sovProvider(uint64(l))
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 2 + l + sovProvider(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 2 + l + sovProvider(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerRemovalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
-	n += 1 + l + sovProvider(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConsumerModificationProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
+	if len(m.GenesisUrl) > 0 {
+		i -= len(m.GenesisUrl)
+		copy(dAtA[i:], m.GenesisUrl)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisUrl)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	if len(m.BinaryRepository) > 0 {
+		i -= len(m.BinaryRepository)
+		copy(dAtA[i:], m.BinaryRepository)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryRepository)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	if len(m.Website) > 0 {
+		i -= len(m.Website)
+		copy(dAtA[i:], m.Website)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Website)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EquivocationProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if len(m.Equivocations) > 0 {
-		for _, e := range m.Equivocations {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ConsumerChainMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChangeRewardDenomsProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerChainMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerChainMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if len(m.DenomsToAdd) > 0 {
-		for _, s := range m.DenomsToAdd {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	if len(m.DenomsToRemove) > 0 {
-		for _, s := range m.DenomsToRemove {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobalSlashEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerChainID)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.IbcSeqNum != 0 {
-		n += 1 + sovProvider(uint64(m.IbcSeqNum))
-	}
-	l = len(m.ProviderValConsAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+func (m *ConsumerPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TemplateClient != nil {
-		l = m.TemplateClient.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PauseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PauseTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	l = len(m.TrustingPeriodFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n11
+	i = encodeVarintProvider(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x2a
+	if m.PauseHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.PauseHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.SlashMeterReplenishFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.ConsumerRewardDenomRegistrationFee.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	if m.BlocksPerEpoch != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
+	if m.Emergency {
+		i--
+		if m.Emergency {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.NumberOfEpochsToStartReceivingRewards != 0 {
-		n += 1 + sovProvider(uint64(m.NumberOfEpochsToStartReceivingRewards))
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SlashAcks) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *PausedConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConsumerAdditionProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PausedConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerRemovalProposals) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoppedConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StoppedConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoppedConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintProvider(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LaunchParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AddressList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ConsumerOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChannelToChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorSetChangePackets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.List) > 0 {
-		for _, e := range m.List {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *KeyAssignmentReplacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	if m.PrevCKey != nil {
-		l = m.PrevCKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n15
+	i = encodeVarintProvider(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorConsumerPubKey) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerModificationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerModificationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerModificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *ValidatorByConsumerAddr) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.Top_N != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ConsumerAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerAddrsToPruneV2) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs)
-	n += 1 + l + sovProvider(uint64(l))
-	if m.ConsumerAddrs != nil {
-		l = m.ConsumerAddrs.Size()
-		n += 1 + l + sovProvider(uint64(l))
+func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConsumerValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProviderConsAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Equivocations) > 0 {
+		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ConsumerPublicKey != nil {
-		l = m.ConsumerPublicKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.JoinHeight != 0 {
-		n += 1 + sovProvider(uint64(m.JoinHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConsumerRewardsAllocation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomsToRemove) > 0 {
+		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToRemove[iNdEx])
+			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DenomsToAdd) > 0 {
+		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToAdd[iNdEx])
+			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.ProviderValConsAddr) > 0 {
+		i -= len(m.ProviderValConsAddr)
+		copy(dAtA[i:], m.ProviderValConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderValConsAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IbcSeqNum != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsumerChainID) > 0 {
+		i -= len(m.ConsumerChainID)
+		copy(dAtA[i:], m.ConsumerChainID)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintProvider(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StoppedConsumerRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StoppedConsumerRetentionPeriod):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintProvider(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintProvider(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x7a
+	if m.KeyAssignmentHistorySize != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.KeyAssignmentHistorySize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxVscPacketSize != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxVscPacketSize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NumberOfEpochsToStartReceivingRewards != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToStartReceivingRewards))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BlocksPerEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.ConsumerRewardDenomRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SlashMeterReplenishFraction) > 0 {
+		i -= len(m.SlashMeterReplenishFraction)
+		copy(dAtA[i:], m.SlashMeterReplenishFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintProvider(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x32
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintProvider(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TrustingPeriodFraction) > 0 {
+		i -= len(m.TrustingPeriodFraction)
+		copy(dAtA[i:], m.TrustingPeriodFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TemplateClient != nil {
+		{
+			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetChangePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChangePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChangePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevCKey != nil {
+		{
+			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplyHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ApplyHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledConsumerKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledConsumerKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledConsumerKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rotation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SupersededVscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SupersededVscId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SupersededHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SupersededHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EffectiveVscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.EffectiveVscId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AssignedHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.AssignedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Index != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LaunchThresholds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LaunchThresholds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LaunchThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinReadyPowerPercentage != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinReadyPowerPercentage))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPostponements != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxPostponements))
+		i--
+		dAtA[i] = 0x20
+	}
+	n29, err29 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PostponementInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PostponementInterval):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintProvider(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x1a
+	if m.MinPowerPercentage != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinPowerPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinValidators != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptInPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptInPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptInPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptInCooldownEpochs != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OptInCooldownEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinCommitmentEpochs != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinCommitmentEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerEpochParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerEpochParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerEpochParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintProvider(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainEpochParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainEpochParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainEpochParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerOptInPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerOptInPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerOptInPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptInRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptInRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptInRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptOutEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OptOutEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OptInEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OptInEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OptOutHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OptOutHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OptInHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OptInHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOptInRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOptInRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOptInRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadinessSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorReadinessSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReadinessSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReadinessSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Signal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddr) > 0 {
+		i -= len(m.ConsumerAddr)
+		copy(dAtA[i:], m.ConsumerAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAddrsToPruneV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAddrsToPruneV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerAddrs != nil {
+		{
+			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n36, err36 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintProvider(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n37, err37 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JoinTime):])
+	if err37 != nil {
+		return 0, err37
+	}
+	i -= n37
+	i = encodeVarintProvider(dAtA, i, uint64(n37))
+	i--
+	dAtA[i] = 0x2a
+	if m.JoinHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.JoinHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsumerPublicKey != nil {
+		{
+			size, err := m.ConsumerPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderConsAddr) > 0 {
+		i -= len(m.ProviderConsAddr)
+		copy(dAtA[i:], m.ProviderConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConsumerAdditionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if m.Relaunch {
+		n += 3
+	}
+	if len(m.AllowedKeyTypes) > 0 {
+		for _, s := range m.AllowedKeyTypes {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.OptInPolicy != nil {
+		l = m.OptInPolicy.Size()
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if m.LaunchThresholds != nil {
+		l = m.LaunchThresholds.Size()
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if m.LaunchPostponements != 0 {
+		n += 2 + sovProvider(uint64(m.LaunchPostponements))
+	}
+	if m.EpochParams != nil {
+		l = m.EpochParams.Size()
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Website)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryRepository)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.GenesisUrl)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerChainMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Emergency {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.PauseHeight != 0 {
+		n += 1 + sovProvider(uint64(m.PauseHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PauseTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *PausedConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Pause.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *StoppedConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LaunchParams.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerModificationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EquivocationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Equivocations) > 0 {
+		for _, e := range m.Equivocations {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChangeRewardDenomsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.DenomsToAdd) > 0 {
+		for _, s := range m.DenomsToAdd {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.DenomsToRemove) > 0 {
+		for _, s := range m.DenomsToRemove {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GlobalSlashEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerChainID)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.IbcSeqNum != 0 {
+		n += 1 + sovProvider(uint64(m.IbcSeqNum))
+	}
+	l = len(m.ProviderValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TemplateClient != nil {
+		l = m.TemplateClient.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.TrustingPeriodFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.SlashMeterReplenishFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.ConsumerRewardDenomRegistrationFee.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerEpoch != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
+	}
+	if m.NumberOfEpochsToStartReceivingRewards != 0 {
+		n += 1 + sovProvider(uint64(m.NumberOfEpochsToStartReceivingRewards))
+	}
+	if m.MaxVscPacketSize != 0 {
+		n += 1 + sovProvider(uint64(m.MaxVscPacketSize))
+	}
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.KeyAssignmentHistorySize != 0 {
+		n += 1 + sovProvider(uint64(m.KeyAssignmentHistorySize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StoppedConsumerRetentionPeriod)
+	n += 2 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *SlashAcks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerAdditionProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelToChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetChangePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyAssignmentReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.PrevCKey != nil {
+		l = m.PrevCKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorConsumerPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyAssignmentNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProvider(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ConsumerKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsumerKey.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.ApplyHeight != 0 {
+		n += 1 + sovProvider(uint64(m.ApplyHeight))
+	}
+	return n
+}
+
+func (m *ScheduledConsumerKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Rotation.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *KeyAssignmentRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsumerKey.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.AssignedHeight != 0 {
+		n += 1 + sovProvider(uint64(m.AssignedHeight))
+	}
+	if m.EffectiveVscId != 0 {
+		n += 1 + sovProvider(uint64(m.EffectiveVscId))
+	}
+	if m.SupersededHeight != 0 {
+		n += 1 + sovProvider(uint64(m.SupersededHeight))
+	}
+	if m.SupersededVscId != 0 {
+		n += 1 + sovProvider(uint64(m.SupersededVscId))
+	}
+	return n
+}
+
+func (m *KeyAssignmentHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovProvider(uint64(m.Index))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *LaunchThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinValidators != 0 {
+		n += 1 + sovProvider(uint64(m.MinValidators))
+	}
+	if m.MinPowerPercentage != 0 {
+		n += 1 + sovProvider(uint64(m.MinPowerPercentage))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PostponementInterval)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.MaxPostponements != 0 {
+		n += 1 + sovProvider(uint64(m.MaxPostponements))
+	}
+	if m.MinReadyPowerPercentage != 0 {
+		n += 1 + sovProvider(uint64(m.MinReadyPowerPercentage))
+	}
+	return n
+}
+
+func (m *OptInPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinCommitmentEpochs != 0 {
+		n += 1 + sovProvider(uint64(m.MinCommitmentEpochs))
+	}
+	if m.OptInCooldownEpochs != 0 {
+		n += 1 + sovProvider(uint64(m.OptInCooldownEpochs))
+	}
+	return n
+}
+
+func (m *ConsumerEpochParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksPerEpoch != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
+	}
+	return n
+}
+
+func (m *EpochState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovProvider(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovProvider(uint64(m.StartHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ChainEpochParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerOptInPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *OptInRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptInHeight != 0 {
+		n += 1 + sovProvider(uint64(m.OptInHeight))
+	}
+	if m.OptOutHeight != 0 {
+		n += 1 + sovProvider(uint64(m.OptOutHeight))
+	}
+	if m.OptInEpoch != 0 {
+		n += 1 + sovProvider(uint64(m.OptInEpoch))
+	}
+	if m.OptOutEpoch != 0 {
+		n += 1 + sovProvider(uint64(m.OptOutEpoch))
+	}
+	return n
+}
+
+func (m *ValidatorOptInRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ReadinessSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ValidatorReadinessSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.Signal.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ValidatorByConsumerAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAddrsToPruneV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.ConsumerAddrs != nil {
+		l = m.ConsumerAddrs.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	if m.ConsumerPublicKey != nil {
+		l = m.ConsumerPublicKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.JoinHeight != 0 {
+		n += 1 + sovProvider(uint64(m.JoinHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerRewardsAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvider(x uint64) (n int) {
+	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+			}
+			m.BlocksPerDistributionTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ConsumerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relaunch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Relaunch = bool(v != 0)
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedKeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedKeyTypes = append(m.AllowedKeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptInPolicy == nil {
+				m.OptInPolicy = &OptInPolicy{}
+			}
+			if err := m.OptInPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LaunchThresholds == nil {
+				m.LaunchThresholds = &LaunchThresholds{}
+			}
+			if err := m.LaunchThresholds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchPostponements", wireType)
+			}
+			m.LaunchPostponements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchPostponements |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochParams == nil {
+				m.EpochParams = &ConsumerEpochParams{}
+			}
+			if err := m.EpochParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryRepository", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryRepository = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerChainMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerChainMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerChainMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Emergency = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseHeight", wireType)
+			}
+			m.PauseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PauseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoppedConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoppedConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoppedConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LaunchParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerModificationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerModificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
+			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
+			}
+			m.IbcSeqNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSeqNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderValConsAddr = append(m.ProviderValConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderValConsAddr == nil {
+				m.ProviderValConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovProvider(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProvider(x uint64) (n int) {
-	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,15 +8936,51 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateClient == nil {
+				m.TemplateClient = &_07_tendermint.ClientState{}
+			}
+			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3086,11 +9008,77 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3118,11 +9106,101 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenomRegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerRewardDenomRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
+			}
+			m.BlocksPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToStartReceivingRewards", wireType)
+			}
+			m.NumberOfEpochsToStartReceivingRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfEpochsToStartReceivingRewards |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVscPacketSize", wireType)
+			}
+			m.MaxVscPacketSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVscPacketSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3150,11 +9228,30 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentHistorySize", wireType)
+			}
+			m.KeyAssignmentHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyAssignmentHistorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3181,15 +9278,15 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoppedConsumerRetentionPeriod", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3199,31 +9296,80 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StoppedConsumerRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3233,29 +9379,77 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3282,13 +9476,64 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3315,15 +9560,66 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3333,30 +9629,79 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3366,28 +9711,27 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3415,51 +9759,63 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlocksPerDistributionTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-		case 14:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3469,86 +9825,81 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 18:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3558,29 +9909,31 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3590,29 +9943,33 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			if m.PrevCKey == nil {
+				m.PrevCKey = &crypto.PublicKey{}
+			}
+			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var stringLen uint64
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3622,24 +9979,11 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -3661,7 +10005,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
+func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3684,15 +10028,15 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3720,13 +10064,13 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3736,59 +10080,29 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3815,7 +10129,10 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3840,7 +10157,7 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
+func (m *KeyAssignmentNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3863,15 +10180,15 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerModificationProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAssignmentNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerModificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAssignmentNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3899,13 +10216,13 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3915,29 +10232,31 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -3947,86 +10266,66 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
-			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
-			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4036,29 +10335,30 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyHeight", wireType)
 			}
-			var stringLen uint64
+			m.ApplyHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4068,24 +10368,11 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ApplyHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4107,7 +10394,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
+func (m *ScheduledConsumerKeyRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,15 +10417,15 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledConsumerKeyRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledConsumerKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4166,13 +10453,13 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4182,27 +10469,29 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4229,8 +10518,7 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
-			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4255,7 +10543,7 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+func (m *KeyAssignmentRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4278,17 +10566,17 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAssignmentRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAssignmentRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4298,29 +10586,30 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedHeight", wireType)
 			}
-			var stringLen uint64
+			m.AssignedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4330,29 +10619,16 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AssignedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveVscId", wireType)
 			}
-			var stringLen uint64
+			m.EffectiveVscId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4362,29 +10638,16 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EffectiveVscId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededHeight", wireType)
 			}
-			var stringLen uint64
+			m.SupersededHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4394,24 +10657,30 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SupersededHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededVscId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SupersededVscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SupersededVscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -4433,7 +10702,7 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+func (m *KeyAssignmentHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4456,17 +10725,17 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyAssignmentHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyAssignmentHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4476,30 +10745,29 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4509,29 +10777,31 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.IbcSeqNum = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4541,16 +10811,16 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IbcSeqNum |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4560,24 +10830,23 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderValConsAddr = append(m.ProviderValConsAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderValConsAddr == nil {
-				m.ProviderValConsAddr = []byte{}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4601,7 +10870,7 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *LaunchThresholds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4624,17 +10893,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: LaunchThresholds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LaunchThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
 			}
-			var msglen int
+			m.MinValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4644,33 +10913,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TemplateClient == nil {
-				m.TemplateClient = &_07_tendermint.ClientState{}
-			}
-			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerPercentage", wireType)
 			}
-			var stringLen uint64
+			m.MinPowerPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4680,27 +10932,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinPowerPercentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostponementInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4727,15 +10966,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PostponementInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPostponements", wireType)
 			}
-			var msglen int
+			m.MaxPostponements = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4745,30 +10984,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPostponements |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReadyPowerPercentage", wireType)
 			}
-			var stringLen uint64
+			m.MinReadyPowerPercentage = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4778,29 +11003,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinReadyPowerPercentage |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenomRegistrationFee", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptInPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			var msglen int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptInPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptInPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommitmentEpochs", wireType)
+			}
+			m.MinCommitmentEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4810,30 +11072,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinCommitmentEpochs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConsumerRewardDenomRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInCooldownEpochs", wireType)
 			}
-			m.BlocksPerEpoch = 0
+			m.OptInCooldownEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4843,16 +11091,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerEpoch |= int64(b&0x7F) << shift
+				m.OptInCooldownEpochs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerEpochParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerEpochParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerEpochParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToStartReceivingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
 			}
-			m.NumberOfEpochsToStartReceivingRewards = 0
+			m.BlocksPerEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4862,7 +11160,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumberOfEpochsToStartReceivingRewards |= int64(b&0x7F) << shift
+				m.BlocksPerEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4888,7 +11186,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+func (m *EpochState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4911,17 +11209,55 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4931,23 +11267,24 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4970,7 +11307,7 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+func (m *ChainEpochParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4993,15 +11330,47 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainEpochParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainEpochParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5028,8 +11397,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5054,7 +11422,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+func (m *ConsumerOptInPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5077,15 +11445,47 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerOptInPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerOptInPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5112,8 +11512,7 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5138,7 +11537,7 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressList) Unmarshal(dAtA []byte) error {
+func (m *OptInRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5161,17 +11560,17 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptInRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptInRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInHeight", wireType)
 			}
-			var byteLen int
+			m.OptInHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5181,24 +11580,68 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.OptInHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptOutHeight", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+			m.OptOutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptOutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInEpoch", wireType)
+			}
+			m.OptInEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptInEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptOutEpoch", wireType)
+			}
+			m.OptOutEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptOutEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -5220,7 +11663,7 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOptInRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5243,15 +11686,15 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOptInRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOptInRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5279,13 +11722,13 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5295,77 +11738,29 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5392,8 +11787,7 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
-			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5418,7 +11812,7 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+func (m *ReadinessSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5441,15 +11835,15 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadinessSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadinessSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5476,16 +11870,16 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5495,33 +11889,31 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCKey == nil {
-				m.PrevCKey = &crypto.PublicKey{}
-			}
-			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Power = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5531,7 +11923,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5557,7 +11949,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
+func (m *ValidatorReadinessSignal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5580,10 +11972,10 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorReadinessSignal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorReadinessSignal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5654,7 +12046,7 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5681,10 +12073,7 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Signal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6147,6 +12536,39 @@ func (m *ConsumerValidator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -0,0 +1,19 @@
+package types
+
+// Keys of the provider telemetry metrics, which are prefixed by the module name
+// and labelled by the consumer chain ID
+const (
+	// counters
+	MetricKeyVSCPacketsQueued     = "vsc_packets_queued"
+	MetricKeyVSCPacketsSent       = "vsc_packets_sent"
+	MetricKeySlashPacketsHandled  = "slash_packets_handled"
+	MetricKeySlashPacketsBounced  = "slash_packets_bounced"
+	MetricKeySlashPacketsDropped  = "slash_packets_dropped"
+	MetricKeyRewardsAllocated     = "consumer_rewards_allocated"
+	MetricKeyKeyAssignmentsPruned = "key_assignments_pruned"
+
+	// gauges
+	MetricKeyPendingVSCPackets = "pending_vsc_packets"
+	// the slash meter is not labelled, since it is shared by all consumer chains
+	MetricKeySlashMeter = "slash_meter"
+)
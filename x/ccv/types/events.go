@@ -1,6 +1,10 @@
 package types
 
 // CCV events
+//
+// The string events of the CCV modules, including the ones of the provider and consumer
+// modules, are kept during a deprecation window and will be removed in a future release.
+// Use the typed events of the provider and consumer modules instead.
 const (
 	EventTypeTimeout                    = "timeout"
 	EventTypePacket                     = "ccv_packet"
@@ -20,6 +20,7 @@ import (
 	// Capabilities removed in IBC v10
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 )
 
 // StakingKeeper defines the contract expected by provider-chain ccv module from a Staking Module that will keep track
@@ -114,9 +115,56 @@ type DistributionKeeper interface {
 	AllocateTokensToValidator(ctx context.Context, validator stakingtypes.ValidatorI, reward sdk.DecCoins) error
 }
 
-// ConsumerHooks event hooks for newly bonded cross-chain validators
+// ConsumerHooks event hooks for the changes of the cross-chain validator set, for the slash
+// requests sent to the provider chain, and for the CCV channel. Hooks that only need to react
+// to newly bonded cross-chain validators (e.g., the slashing module hooks) can be wrapped
+// with NewValidatorBondedConsumerHooks.
 type ConsumerHooks interface {
+	// AfterValidatorBonded is called once a new cross-chain validator is added.
+	// An error panics, since the validator set changes cannot be reverted.
 	AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddresses sdk.ValAddress) error
+	// AfterValidatorRemoved is called once a cross-chain validator is removed.
+	// An error panics, since the validator set changes cannot be reverted.
+	AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress) error
+	// AfterValidatorPowerChanged is called once the power of an existing cross-chain validator is updated.
+	// An error panics, since the validator set changes cannot be reverted.
+	AfterValidatorPowerChanged(ctx context.Context, consAddr sdk.ConsAddress, oldPower, newPower int64) error
+	// AfterValidatorSetChangesApplied is called once a batch of validator set changes is applied,
+	// with the validator updates forwarded to CometBFT. An error panics.
+	AfterValidatorSetChangesApplied(ctx context.Context, valUpdates []abci.ValidatorUpdate) error
+	// AfterSlashPacketQueued is called once a slash packet is queued to be sent to the provider chain.
+	// An error is logged and the hook state discarded.
+	AfterSlashPacketQueued(ctx context.Context, validator abci.Validator, vscID uint64, infraction stakingtypes.Infraction) error
+	// AfterSlashPacketAcknowledged is called once the provider chain acknowledges that it handled a slash packet.
+	// Bounced slash packets are retried and do not call the hook. An error is logged and the hook state discarded.
+	AfterSlashPacketAcknowledged(ctx context.Context, validator abci.Validator, vscID uint64, infraction stakingtypes.Infraction) error
+	// AfterCCVChannelEstablished is called once the first VSC packet is received on the CCV channel.
+	// An error is logged and the hook state discarded.
+	AfterCCVChannelEstablished(ctx context.Context, channelID string) error
+}
+
+// ProviderHooks event hooks for the lifecycle of consumer chains and for the participation
+// of provider validators in them. Validators are identified by their provider consensus address.
+type ProviderHooks interface {
+	// AfterConsumerLaunched is called once the client to the consumer chain is created and its
+	// genesis is ready. An error is logged and the hook state discarded, as a hook cannot veto
+	// a launch approved by governance.
+	AfterConsumerLaunched(ctx context.Context, chainID, clientID string) error
+	// AfterConsumerStopped is called once the consumer chain state is removed from the provider.
+	// An error is logged, since the consumer chain cannot be kept running.
+	AfterConsumerStopped(ctx context.Context, chainID string) error
+	// AfterConsumerValidatorSetChanged is called at the end of an epoch once the validator set
+	// changes of the consumer chain are queued. An error is logged and the hook state discarded.
+	AfterConsumerValidatorSetChanged(ctx context.Context, chainID string, vscID uint64, valUpdates []abci.ValidatorUpdate) error
+	// AfterConsumerKeyAssigned is called once a validator assigns a consumer key, also when a
+	// scheduled key rotation is applied. An error is logged and the hook state discarded.
+	AfterConsumerKeyAssigned(ctx context.Context, chainID string, providerAddr sdk.ConsAddress, consumerKey tmprotocrypto.PublicKey) error
+	// AfterValidatorOptedIn is called once a validator opts in to a consumer chain through a MsgOptIn.
+	// An error is logged and the hook state discarded.
+	AfterValidatorOptedIn(ctx context.Context, chainID string, providerAddr sdk.ConsAddress) error
+	// AfterValidatorOptedOut is called once a validator opts out from a consumer chain through a MsgOptOut.
+	// An error is logged and the hook state discarded.
+	AfterValidatorOptedOut(ctx context.Context, chainID string, providerAddr sdk.ConsAddress) error
 }
 
 // Proposal defines the minimal proposal type needed by ICS
@@ -141,11 +189,13 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // AccountKeeper defines the expected account keeper used for simulations
 type AccountKeeper interface {
 	GetModuleAccount(ctx context.Context, name string) sdk.ModuleAccountI
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 }
 
 // IBCTransferKeeper defines the expected interface needed for distribution transfer
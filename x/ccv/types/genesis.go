@@ -41,6 +41,9 @@ func (gs ConsumerGenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateValidatorKeyTypes(gs.Provider.InitialValSet, gs.Params.AllowedKeyTypes); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "initial validator set: %s", err)
+	}
 
 	if gs.NewChain {
 		if gs.Provider.ClientState == nil {
@@ -55,8 +58,13 @@ func (gs ConsumerGenesisState) Validate() error {
 		if err := gs.Provider.ConsensusState.ValidateBasic(); err != nil {
 			return errorsmod.Wrapf(ErrInvalidGenesis, "provider consensus state invalid for new chain %s", err.Error())
 		}
-	} else if gs.Provider.ClientState != nil || gs.Provider.ConsensusState != nil {
-		return errorsmod.Wrap(ErrInvalidGenesis, "provider client state and consensus state must be nil for a restarting genesis state")
+	} else {
+		if gs.Relaunch {
+			return errorsmod.Wrap(ErrInvalidGenesis, "a relaunched consumer chain must start as a new chain")
+		}
+		if gs.Provider.ClientState != nil || gs.Provider.ConsensusState != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, "provider client state and consensus state must be nil for a restarting genesis state")
+		}
 	}
 	return nil
 }
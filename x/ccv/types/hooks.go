@@ -0,0 +1,209 @@
+package types
+
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+var _ ProviderHooks = MultiProviderHooks{}
+
+// MultiProviderHooks combines multiple provider hooks, which are called in order.
+// The first error stops the iteration and is returned.
+type MultiProviderHooks []ProviderHooks
+
+// NewMultiProviderHooks returns the provider hooks that call all the given hooks in order
+func NewMultiProviderHooks(hooks ...ProviderHooks) MultiProviderHooks {
+	return hooks
+}
+
+func (h MultiProviderHooks) AfterConsumerLaunched(ctx context.Context, chainID, clientID string) error {
+	for i := range h {
+		if err := h[i].AfterConsumerLaunched(ctx, chainID, clientID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterConsumerStopped(ctx context.Context, chainID string) error {
+	for i := range h {
+		if err := h[i].AfterConsumerStopped(ctx, chainID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterConsumerValidatorSetChanged(ctx context.Context, chainID string, vscID uint64, valUpdates []abci.ValidatorUpdate) error {
+	for i := range h {
+		if err := h[i].AfterConsumerValidatorSetChanged(ctx, chainID, vscID, valUpdates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterConsumerKeyAssigned(ctx context.Context, chainID string, providerAddr sdk.ConsAddress, consumerKey tmprotocrypto.PublicKey) error {
+	for i := range h {
+		if err := h[i].AfterConsumerKeyAssigned(ctx, chainID, providerAddr, consumerKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterValidatorOptedIn(ctx context.Context, chainID string, providerAddr sdk.ConsAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorOptedIn(ctx, chainID, providerAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterValidatorOptedOut(ctx context.Context, chainID string, providerAddr sdk.ConsAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorOptedOut(ctx, chainID, providerAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	_ ConsumerHooks = MultiConsumerHooks{}
+	_ ConsumerHooks = BaseConsumerHooks{}
+	_ ConsumerHooks = validatorBondedConsumerHooks{}
+)
+
+// MultiConsumerHooks combines multiple consumer hooks, which are called in order.
+// The first error stops the iteration and is returned.
+type MultiConsumerHooks []ConsumerHooks
+
+// NewMultiConsumerHooks returns the consumer hooks that call all the given hooks in order
+func NewMultiConsumerHooks(hooks ...ConsumerHooks) MultiConsumerHooks {
+	return hooks
+}
+
+func (h MultiConsumerHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiConsumerHooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorRemoved(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiConsumerHooks) AfterValidatorPowerChanged(ctx context.Context, consAddr sdk.ConsAddress, oldPower, newPower int64) error {
+	for i := range h {
+		if err := h[i].AfterValidatorPowerChanged(ctx, consAddr, oldPower, newPower); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiConsumerHooks) AfterValidatorSetChangesApplied(ctx context.Context, valUpdates []abci.ValidatorUpdate) error {
+	for i := range h {
+		if err := h[i].AfterValidatorSetChangesApplied(ctx, valUpdates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiConsumerHooks) AfterSlashPacketQueued(ctx context.Context, validator abci.Validator, vscID uint64, infraction stakingtypes.Infraction) error {
+	for i := range h {
+		if err := h[i].AfterSlashPacketQueued(ctx, validator, vscID, infraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiConsumerHooks) AfterSlashPacketAcknowledged(ctx context.Context, validator abci.Validator, vscID uint64, infraction stakingtypes.Infraction) error {
+	for i := range h {
+		if err := h[i].AfterSlashPacketAcknowledged(ctx, validator, vscID, infraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiConsumerHooks) AfterCCVChannelEstablished(ctx context.Context, channelID string) error {
+	for i := range h {
+		if err := h[i].AfterCCVChannelEstablished(ctx, channelID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BaseConsumerHooks implements all the consumer hooks as no-ops.
+// It can be embedded by consumer hooks that only implement some of the hooks.
+type BaseConsumerHooks struct{}
+
+func (BaseConsumerHooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (BaseConsumerHooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress) error {
+	return nil
+}
+
+func (BaseConsumerHooks) AfterValidatorPowerChanged(context.Context, sdk.ConsAddress, int64, int64) error {
+	return nil
+}
+
+func (BaseConsumerHooks) AfterValidatorSetChangesApplied(context.Context, []abci.ValidatorUpdate) error {
+	return nil
+}
+
+func (BaseConsumerHooks) AfterSlashPacketQueued(context.Context, abci.Validator, uint64, stakingtypes.Infraction) error {
+	return nil
+}
+
+func (BaseConsumerHooks) AfterSlashPacketAcknowledged(context.Context, abci.Validator, uint64, stakingtypes.Infraction) error {
+	return nil
+}
+
+func (BaseConsumerHooks) AfterCCVChannelEstablished(context.Context, string) error {
+	return nil
+}
+
+// ValidatorBondedHooks are the hooks that only react to newly bonded validators,
+// e.g., the hooks of the slashing module.
+type ValidatorBondedHooks interface {
+	AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error
+}
+
+// validatorBondedConsumerHooks forwards AfterValidatorBonded to the wrapped hooks
+// and ignores all the other consumer hooks
+type validatorBondedConsumerHooks struct {
+	BaseConsumerHooks
+	hooks ValidatorBondedHooks
+}
+
+// NewValidatorBondedConsumerHooks returns the consumer hooks that only forward
+// AfterValidatorBonded to the given hooks, e.g., the hooks of the slashing module
+func NewValidatorBondedConsumerHooks(hooks ValidatorBondedHooks) ConsumerHooks {
+	return validatorBondedConsumerHooks{hooks: hooks}
+}
+
+func (h validatorBondedConsumerHooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.hooks.AfterValidatorBonded(ctx, consAddr, valAddr)
+}
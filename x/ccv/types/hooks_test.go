@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/allinbits/interchain-security/testutil/keeper"
+	"github.com/allinbits/interchain-security/x/ccv/types"
+)
+
+func TestMultiProviderHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hooks0 := testkeeper.NewMockProviderHooks(ctrl)
+	hooks1 := testkeeper.NewMockProviderHooks(ctrl)
+	multiHooks := types.NewMultiProviderHooks(hooks0, hooks1)
+	ctx := context.Background()
+
+	// all the hooks are called in order
+	gomock.InOrder(
+		hooks0.EXPECT().AfterConsumerLaunched(ctx, "chainID", "clientID").Return(nil),
+		hooks1.EXPECT().AfterConsumerLaunched(ctx, "chainID", "clientID").Return(nil),
+	)
+	require.NoError(t, multiHooks.AfterConsumerLaunched(ctx, "chainID", "clientID"))
+
+	// the first error stops the iteration
+	hookErr := errors.New("hook error")
+	hooks0.EXPECT().AfterConsumerStopped(ctx, "chainID").Return(hookErr)
+	require.ErrorIs(t, multiHooks.AfterConsumerStopped(ctx, "chainID"), hookErr)
+}
+
+func TestMultiConsumerHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hooks0 := testkeeper.NewMockConsumerHooks(ctrl)
+	hooks1 := testkeeper.NewMockConsumerHooks(ctrl)
+	multiHooks := types.NewMultiConsumerHooks(hooks0, hooks1)
+	ctx := context.Background()
+
+	// all the hooks are called in order
+	gomock.InOrder(
+		hooks0.EXPECT().AfterCCVChannelEstablished(ctx, "channel-0").Return(nil),
+		hooks1.EXPECT().AfterCCVChannelEstablished(ctx, "channel-0").Return(nil),
+	)
+	require.NoError(t, multiHooks.AfterCCVChannelEstablished(ctx, "channel-0"))
+
+	// the first error stops the iteration
+	hookErr := errors.New("hook error")
+	hooks0.EXPECT().AfterValidatorRemoved(ctx, sdk.ConsAddress("addr")).Return(hookErr)
+	require.ErrorIs(t, multiHooks.AfterValidatorRemoved(ctx, sdk.ConsAddress("addr")), hookErr)
+}
+
+func TestValidatorBondedConsumerHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bondedHooks := testkeeper.NewMockConsumerHooks(ctrl)
+	hooks := types.NewValidatorBondedConsumerHooks(bondedHooks)
+	ctx := context.Background()
+
+	// only AfterValidatorBonded is forwarded to the wrapped hooks
+	bondedHooks.EXPECT().AfterValidatorBonded(ctx, sdk.ConsAddress("addr"), nil).Return(nil)
+	require.NoError(t, hooks.AfterValidatorBonded(ctx, sdk.ConsAddress("addr"), nil))
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, sdk.ConsAddress("addr")))
+	require.NoError(t, hooks.AfterCCVChannelEstablished(ctx, "channel-0"))
+}
@@ -8,6 +8,8 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tmtypes "github.com/cometbft/cometbft/types"
 )
 
 const (
@@ -42,6 +44,10 @@ const (
 	DefaultRetryDelayPeriod = time.Hour
 )
 
+// DefaultAllowedKeyTypes are the consensus key types that the validators of a
+// consumer chain can use when the chain does not declare any
+var DefaultAllowedKeyTypes = []string{tmtypes.ABCIPubKeyTypeEd25519}
+
 // Reflection based keys for params subspace
 var (
 	KeyEnabled                           = []byte("Enabled")
@@ -55,6 +61,7 @@ var (
 	KeyRewardDenoms                      = []byte("RewardDenoms")
 	KeyProviderRewardDenoms              = []byte("ProviderRewardDenoms")
 	KeyRetryDelayPeriod                  = []byte("RetryDelayPeriod")
+	KeyAllowedKeyTypes                   = []byte("AllowedKeyTypes")
 )
 
 // helper interface
@@ -152,6 +159,9 @@ func (p ConsumerParams) Validate() error {
 	if err := ValidateDuration(p.RetryDelayPeriod); err != nil {
 		return err
 	}
+	if err := ValidateAllowedKeyTypes(p.AllowedKeyTypes); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -181,6 +191,8 @@ func (p *ConsumerParams) ParamSetPairs() paramtypes.ParamSetPairs {
 			p.ProviderRewardDenoms, ValidateDenoms),
 		paramtypes.NewParamSetPair(KeyRetryDelayPeriod,
 			p.RetryDelayPeriod, ValidateDuration),
+		paramtypes.NewParamSetPair(KeyAllowedKeyTypes,
+			p.AllowedKeyTypes, ValidateAllowedKeyTypes),
 	}
 }
 
@@ -213,3 +225,28 @@ func ValidateDenoms(i interface{}) error {
 
 	return nil
 }
+
+// ValidateAllowedKeyTypes checks that the given consensus key types are supported by
+// CometBFT for validators and that none of them is repeated. Note that BLS12-381 keys
+// are not supported, as CometBFT v0.38 has no BLS12-381 validator keys.
+func ValidateAllowedKeyTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, keyType := range v {
+		switch keyType {
+		case tmtypes.ABCIPubKeyTypeEd25519, tmtypes.ABCIPubKeyTypeSecp256k1:
+		default:
+			return fmt.Errorf("unsupported consensus key type: %s", keyType)
+		}
+		if seen[keyType] {
+			return fmt.Errorf("duplicate consensus key type: %s", keyType)
+		}
+		seen[keyType] = true
+	}
+
+	return nil
+}
@@ -73,6 +73,11 @@ type ConsumerParams struct {
 	ProviderRewardDenoms []string `protobuf:"bytes,12,rep,name=provider_reward_denoms,json=providerRewardDenoms,proto3" json:"provider_reward_denoms,omitempty"`
 	// The period after which a consumer can retry sending a throttled packet.
 	RetryDelayPeriod time.Duration `protobuf:"bytes,13,opt,name=retry_delay_period,json=retryDelayPeriod,proto3,stdduration" json:"retry_delay_period"`
+	// The consensus key types, e.g. "ed25519" or "secp256k1", that the
+	// validators of the consumer chain can use. If empty, only ed25519 keys are
+	// allowed. BLS12-381 keys are not supported. The consensus params of the
+	// consumer chain must accept the same validator key types.
+	AllowedKeyTypes []string `protobuf:"bytes,14,rep,name=allowed_key_types,json=allowedKeyTypes,proto3" json:"allowed_key_types,omitempty"`
 }
 
 func (m *ConsumerParams) Reset()         { *m = ConsumerParams{} }
@@ -192,6 +197,13 @@ func (m *ConsumerParams) GetRetryDelayPeriod() time.Duration {
 	return 0
 }
 
+func (m *ConsumerParams) GetAllowedKeyTypes() []string {
+	if m != nil {
+		return m.AllowedKeyTypes
+	}
+	return nil
+}
+
 // ConsumerGenesisState defines shared genesis information between provider and
 // consumer
 type ConsumerGenesisState struct {
@@ -203,6 +215,8 @@ type ConsumerGenesisState struct {
 	PreCCV bool `protobuf:"varint,4,opt,name=preCCV,proto3" json:"preCCV,omitempty"`
 	// The ID of the connection end on the consumer chain on top of which the CCV channel will be established
 	ConnectionId string `protobuf:"bytes,5,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// true if a stopped consumer chain is relaunched from its exported state
+	Relaunch bool `protobuf:"varint,6,opt,name=relaunch,proto3" json:"relaunch,omitempty"`
 }
 
 func (m *ConsumerGenesisState) Reset()         { *m = ConsumerGenesisState{} }
@@ -273,6 +287,13 @@ func (m *ConsumerGenesisState) GetConnectionId() string {
 	return ""
 }
 
+func (m *ConsumerGenesisState) GetRelaunch() bool {
+	if m != nil {
+		return m.Relaunch
+	}
+	return false
+}
+
 // ProviderInfo defines all information a consumer needs from a provider
 // Shared data type between provider and consumer
 type ProviderInfo struct {
@@ -349,59 +370,61 @@ func init() {
 }
 
 var fileDescriptor_d0a8be0efc64dfbc = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x73, 0x1b, 0x35,
-	0x14, 0xce, 0xc6, 0xc1, 0xb5, 0x65, 0xa7, 0x09, 0x9a, 0x50, 0x96, 0x74, 0xc6, 0x71, 0x03, 0x07,
-	0x0f, 0x4c, 0x77, 0x49, 0xe8, 0x89, 0x1b, 0x71, 0x28, 0x6d, 0x0e, 0xc1, 0xdd, 0x94, 0x30, 0x03,
-	0x07, 0x8d, 0x56, 0x7a, 0xb6, 0x35, 0xc8, 0x92, 0x47, 0xd2, 0x6e, 0xc8, 0x1f, 0x80, 0x2b, 0x47,
-	0x7e, 0x06, 0x3f, 0xa3, 0xc7, 0x1e, 0x39, 0x01, 0x93, 0xfc, 0x11, 0x66, 0xb5, 0xbb, 0xc9, 0x9a,
-	0x21, 0xd0, 0xde, 0xf4, 0xa4, 0xef, 0xfb, 0xa4, 0xf7, 0x3d, 0x3d, 0x09, 0x7d, 0x2a, 0x94, 0x03,
-	0xc3, 0xe6, 0x54, 0x28, 0x62, 0x81, 0x65, 0x46, 0xb8, 0xcb, 0x98, 0xb1, 0x3c, 0xce, 0x0f, 0x62,
-	0x3b, 0xa7, 0x06, 0x38, 0x61, 0x5a, 0xd9, 0x6c, 0x01, 0x26, 0x5a, 0x1a, 0xed, 0x34, 0xde, 0xfd,
-	0x17, 0x46, 0xc4, 0x58, 0x1e, 0xe5, 0x07, 0xbb, 0x0f, 0x1d, 0x28, 0x0e, 0x66, 0x21, 0x94, 0x8b,
-	0x69, 0xca, 0x44, 0xec, 0x2e, 0x97, 0x60, 0x4b, 0xe2, 0x6e, 0x2c, 0x52, 0x16, 0x4b, 0x31, 0x9b,
-	0x3b, 0x26, 0x05, 0x28, 0x67, 0xe3, 0x06, 0x3a, 0x3f, 0x68, 0x44, 0x15, 0x61, 0x30, 0xd3, 0x7a,
-	0x26, 0x21, 0xf6, 0x51, 0x9a, 0x4d, 0x63, 0x9e, 0x19, 0xea, 0x84, 0x56, 0xd5, 0xfa, 0xce, 0x4c,
-	0xcf, 0xb4, 0x1f, 0xc6, 0xc5, 0xa8, 0x9c, 0xdd, 0xff, 0xad, 0x8d, 0xee, 0x8f, 0xab, 0x23, 0x4f,
-	0xa8, 0xa1, 0x0b, 0x8b, 0x43, 0x74, 0x0f, 0x14, 0x4d, 0x25, 0xf0, 0x30, 0x18, 0x06, 0xa3, 0x4e,
-	0x52, 0x87, 0xf8, 0x6b, 0xf4, 0x51, 0x2a, 0x35, 0xfb, 0xc1, 0x92, 0x25, 0x18, 0xc2, 0x85, 0x75,
-	0x46, 0xa4, 0x59, 0xb1, 0x07, 0x71, 0x86, 0x2a, 0xbb, 0x10, 0xd6, 0x0a, 0xad, 0xc2, 0xf5, 0x61,
-	0x30, 0x6a, 0x25, 0x8f, 0x4a, 0xec, 0x04, 0xcc, 0x71, 0x03, 0xf9, 0xb2, 0x01, 0xc4, 0x27, 0xe8,
-	0xd1, 0x9d, 0x2a, 0x84, 0xcd, 0xa9, 0x52, 0x20, 0xc3, 0xd6, 0x30, 0x18, 0x75, 0x93, 0x3d, 0x7e,
-	0x87, 0xc8, 0xb8, 0x84, 0xe1, 0xcf, 0xd1, 0xee, 0xd2, 0xe8, 0x5c, 0x70, 0x30, 0x64, 0x0a, 0x40,
-	0x96, 0x5a, 0x4b, 0x42, 0x39, 0x37, 0xc4, 0x3a, 0x13, 0x6e, 0x78, 0x91, 0x07, 0x35, 0xe2, 0x29,
-	0xc0, 0x44, 0x6b, 0xf9, 0x05, 0xe7, 0xe6, 0xcc, 0x19, 0xfc, 0x02, 0x61, 0xc6, 0x72, 0xe2, 0xc4,
-	0x02, 0x74, 0xe6, 0x8a, 0xec, 0x84, 0xe6, 0xe1, 0x3b, 0xc3, 0x60, 0xd4, 0x3b, 0xfc, 0x20, 0x2a,
-	0x8d, 0x8d, 0x6a, 0x63, 0xa3, 0xe3, 0xca, 0xd8, 0xa3, 0xce, 0xab, 0x3f, 0xf6, 0xd6, 0x7e, 0xfd,
-	0x73, 0x2f, 0x48, 0xb6, 0x19, 0xcb, 0x5f, 0x96, 0xec, 0x89, 0x27, 0xe3, 0xef, 0xd1, 0xfb, 0x3e,
-	0x9b, 0x29, 0x98, 0x7f, 0xea, 0xb6, 0xdf, 0x5c, 0xf7, 0xbd, 0x5a, 0x63, 0x55, 0xfc, 0x19, 0x1a,
-	0xd6, 0xf7, 0x8c, 0x18, 0x58, 0xb1, 0x70, 0x6a, 0x28, 0x2b, 0x06, 0xe1, 0x3d, 0x9f, 0xf1, 0xa0,
-	0xc6, 0x25, 0x2b, 0xb0, 0xa7, 0x15, 0x0a, 0x3f, 0x46, 0x78, 0x2e, 0xac, 0xd3, 0x46, 0x30, 0x2a,
-	0x09, 0x28, 0x67, 0x04, 0xd8, 0xb0, 0xe3, 0x0b, 0xf8, 0xee, 0xed, 0xca, 0x97, 0xe5, 0x02, 0x3e,
-	0x45, 0xdb, 0x99, 0x4a, 0xb5, 0xe2, 0x42, 0xcd, 0xea, 0x74, 0xba, 0x6f, 0x9e, 0xce, 0xd6, 0x0d,
-	0xb9, 0x4a, 0xe4, 0x43, 0xb4, 0x69, 0xe0, 0x82, 0x1a, 0x4e, 0x38, 0x28, 0xbd, 0xb0, 0x61, 0x6f,
-	0xd8, 0x1a, 0x75, 0x93, 0x7e, 0x39, 0x79, 0xec, 0xe7, 0xf0, 0x13, 0x74, 0x53, 0x37, 0xb2, 0x8a,
-	0xee, 0x7b, 0xf4, 0x4e, 0xbd, 0x9a, 0x34, 0x59, 0x2f, 0x10, 0x36, 0xe0, 0xcc, 0x25, 0xe1, 0x20,
-	0xe9, 0x65, 0x7d, 0xd8, 0xcd, 0xb7, 0xa8, 0xa9, 0xa7, 0x1f, 0x17, 0xec, 0xf2, 0xb4, 0x27, 0x1b,
-	0x1d, 0xb4, 0xdd, 0xdb, 0xff, 0x69, 0x1d, 0xed, 0xd4, 0x2d, 0xf3, 0x15, 0x28, 0xb0, 0xc2, 0x9e,
-	0x39, 0xea, 0x00, 0x3f, 0x43, 0xed, 0xa5, 0x6f, 0x21, 0xdf, 0x37, 0xbd, 0xc3, 0x8f, 0xa3, 0xbb,
-	0x9b, 0x3f, 0x5a, 0x6d, 0xba, 0xa3, 0x8d, 0x62, 0xdb, 0xa4, 0xe2, 0xe3, 0x13, 0xd4, 0xa9, 0x73,
-	0xf2, 0xcd, 0xd4, 0x3b, 0x1c, 0xfd, 0x97, 0xd6, 0xa4, 0xc2, 0x3e, 0x57, 0x53, 0x5d, 0x29, 0xdd,
-	0xf0, 0xf1, 0x43, 0xd4, 0x55, 0x70, 0x41, 0x3c, 0xd3, 0xf7, 0x52, 0x27, 0xe9, 0x28, 0xb8, 0x18,
-	0x17, 0x31, 0x7e, 0x80, 0xda, 0x4b, 0x03, 0xe3, 0xf1, 0xb9, 0x6f, 0x90, 0x4e, 0x52, 0x45, 0x45,
-	0x5d, 0x98, 0x56, 0x0a, 0xfc, 0x25, 0x21, 0xa2, 0xec, 0x85, 0x6e, 0xd2, 0xbf, 0x9d, 0x7c, 0xce,
-	0xf7, 0x7f, 0x5e, 0x47, 0xfd, 0xe6, 0xd6, 0xf8, 0x14, 0xf5, 0xcb, 0xc7, 0x8a, 0xd8, 0xc2, 0x90,
-	0xca, 0x86, 0x4f, 0x22, 0x91, 0xb2, 0xa8, 0xf9, 0x94, 0x45, 0x8d, 0xc7, 0xab, 0xb0, 0xc2, 0xcf,
-	0x7a, 0x0f, 0x93, 0x1e, 0xbb, 0x0d, 0xf0, 0xb7, 0x68, 0xab, 0xb8, 0xbe, 0xa0, 0x6c, 0x66, 0x2b,
-	0xc9, 0xd2, 0x8d, 0xe8, 0x7f, 0x25, 0x6b, 0x5a, 0xa9, 0x7a, 0x9f, 0xad, 0xc4, 0xf8, 0x14, 0x6d,
-	0x09, 0x25, 0x9c, 0xa0, 0x92, 0xe4, 0x54, 0x12, 0x0b, 0x2e, 0x6c, 0x0d, 0x5b, 0xa3, 0xde, 0xe1,
-	0xb0, 0xa9, 0x53, 0xbc, 0xc9, 0xd1, 0x39, 0x95, 0x82, 0x53, 0xa7, 0xcd, 0x37, 0x4b, 0x4e, 0x1d,
-	0x54, 0xf6, 0x6e, 0x56, 0xf4, 0x73, 0x2a, 0xcf, 0xc0, 0x1d, 0x9d, 0xbe, 0xba, 0x1a, 0x04, 0xaf,
-	0xaf, 0x06, 0xc1, 0x5f, 0x57, 0x83, 0xe0, 0x97, 0xeb, 0xc1, 0xda, 0xeb, 0xeb, 0xc1, 0xda, 0xef,
-	0xd7, 0x83, 0xb5, 0xef, 0x9e, 0xcc, 0x84, 0x9b, 0x67, 0x69, 0xc4, 0xf4, 0x22, 0xa6, 0x52, 0x0a,
-	0x95, 0x0a, 0x67, 0xe3, 0xdb, 0x5a, 0x3e, 0xbe, 0xf9, 0x46, 0x7e, 0xf4, 0x1f, 0x89, 0xff, 0x02,
-	0xd2, 0xb6, 0xbf, 0x97, 0x9f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x55, 0xb5, 0x71, 0x70,
-	0x06, 0x00, 0x00,
+	// 860 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x73, 0x1b, 0x35,
+	0x14, 0x8e, 0x93, 0xe0, 0xae, 0x65, 0xe7, 0x47, 0x35, 0xa1, 0x2c, 0xee, 0x8c, 0xe3, 0x06, 0x0e,
+	0x9e, 0x32, 0xdd, 0x25, 0xa1, 0x27, 0x6e, 0xc4, 0xa1, 0xb4, 0x61, 0x26, 0xb8, 0x9b, 0x10, 0x66,
+	0xe0, 0xa0, 0xd1, 0x4a, 0xcf, 0xb6, 0xa6, 0xb2, 0xe4, 0x91, 0xb4, 0x0e, 0xfe, 0x0b, 0xb8, 0x72,
+	0xe4, 0xcc, 0x5f, 0xd3, 0x63, 0x8f, 0x1c, 0x18, 0x60, 0x92, 0x7f, 0x84, 0x59, 0xed, 0xae, 0x63,
+	0x33, 0x04, 0xda, 0x9b, 0xde, 0xd3, 0xf7, 0x7d, 0xfb, 0xde, 0x93, 0x3e, 0x2d, 0xfa, 0x54, 0x28,
+	0x07, 0x86, 0x8d, 0xa9, 0x50, 0xc4, 0x02, 0xcb, 0x8c, 0x70, 0xf3, 0x98, 0xb1, 0x59, 0x3c, 0x3b,
+	0x8c, 0xed, 0x98, 0x1a, 0xe0, 0x84, 0x69, 0x65, 0xb3, 0x09, 0x98, 0x68, 0x6a, 0xb4, 0xd3, 0xb8,
+	0xfd, 0x2f, 0x8c, 0x88, 0xb1, 0x59, 0x34, 0x3b, 0x6c, 0x3f, 0x74, 0xa0, 0x38, 0x98, 0x89, 0x50,
+	0x2e, 0xa6, 0x29, 0x13, 0xb1, 0x9b, 0x4f, 0xc1, 0x16, 0xc4, 0x76, 0x2c, 0x52, 0x16, 0x4b, 0x31,
+	0x1a, 0x3b, 0x26, 0x05, 0x28, 0x67, 0xe3, 0x25, 0xf4, 0xec, 0x70, 0x29, 0x2a, 0x09, 0x9d, 0x91,
+	0xd6, 0x23, 0x09, 0xb1, 0x8f, 0xd2, 0x6c, 0x18, 0xf3, 0xcc, 0x50, 0x27, 0xb4, 0x2a, 0xf7, 0xf7,
+	0x46, 0x7a, 0xa4, 0xfd, 0x32, 0xce, 0x57, 0x45, 0xf6, 0xe0, 0xf7, 0x3a, 0xda, 0xee, 0x97, 0x25,
+	0x0f, 0xa8, 0xa1, 0x13, 0x8b, 0x43, 0x74, 0x0f, 0x14, 0x4d, 0x25, 0xf0, 0xb0, 0xd6, 0xad, 0xf5,
+	0x82, 0xa4, 0x0a, 0xf1, 0x37, 0xe8, 0xe3, 0x54, 0x6a, 0xf6, 0xca, 0x92, 0x29, 0x18, 0xc2, 0x85,
+	0x75, 0x46, 0xa4, 0x59, 0xfe, 0x0d, 0xe2, 0x0c, 0x55, 0x76, 0x22, 0xac, 0x15, 0x5a, 0x85, 0xeb,
+	0xdd, 0x5a, 0x6f, 0x23, 0x79, 0x54, 0x60, 0x07, 0x60, 0x4e, 0x96, 0x90, 0x17, 0x4b, 0x40, 0x7c,
+	0x8a, 0x1e, 0xdd, 0xa9, 0x42, 0xd8, 0x98, 0x2a, 0x05, 0x32, 0xdc, 0xe8, 0xd6, 0x7a, 0x8d, 0x64,
+	0x9f, 0xdf, 0x21, 0xd2, 0x2f, 0x60, 0xf8, 0x73, 0xd4, 0x9e, 0x1a, 0x3d, 0x13, 0x1c, 0x0c, 0x19,
+	0x02, 0x90, 0xa9, 0xd6, 0x92, 0x50, 0xce, 0x0d, 0xb1, 0xce, 0x84, 0x9b, 0x5e, 0xe4, 0x41, 0x85,
+	0x78, 0x06, 0x30, 0xd0, 0x5a, 0x7e, 0xc1, 0xb9, 0x39, 0x77, 0x06, 0xbf, 0x44, 0x98, 0xb1, 0x19,
+	0x71, 0x62, 0x02, 0x3a, 0x73, 0x79, 0x77, 0x42, 0xf3, 0xf0, 0xbd, 0x6e, 0xad, 0xd7, 0x3c, 0xfa,
+	0x30, 0x2a, 0x06, 0x1b, 0x55, 0x83, 0x8d, 0x4e, 0xca, 0xc1, 0x1e, 0x07, 0xaf, 0xff, 0xd8, 0x5f,
+	0xfb, 0xe5, 0xcf, 0xfd, 0x5a, 0xb2, 0xcb, 0xd8, 0xec, 0xa2, 0x60, 0x0f, 0x3c, 0x19, 0xff, 0x80,
+	0x3e, 0xf0, 0xdd, 0x0c, 0xc1, 0xfc, 0x53, 0xb7, 0xfe, 0xf6, 0xba, 0xef, 0x57, 0x1a, 0xab, 0xe2,
+	0xcf, 0x51, 0xb7, 0xba, 0x67, 0xc4, 0xc0, 0xca, 0x08, 0x87, 0x86, 0xb2, 0x7c, 0x11, 0xde, 0xf3,
+	0x1d, 0x77, 0x2a, 0x5c, 0xb2, 0x02, 0x7b, 0x56, 0xa2, 0xf0, 0x13, 0x84, 0xc7, 0xc2, 0x3a, 0x6d,
+	0x04, 0xa3, 0x92, 0x80, 0x72, 0x46, 0x80, 0x0d, 0x03, 0x7f, 0x80, 0xf7, 0x6f, 0x77, 0xbe, 0x2c,
+	0x36, 0xf0, 0x19, 0xda, 0xcd, 0x54, 0xaa, 0x15, 0x17, 0x6a, 0x54, 0xb5, 0xd3, 0x78, 0xfb, 0x76,
+	0x76, 0x16, 0xe4, 0xb2, 0x91, 0x8f, 0xd0, 0x96, 0x81, 0x2b, 0x6a, 0x38, 0xe1, 0xa0, 0xf4, 0xc4,
+	0x86, 0xcd, 0xee, 0x46, 0xaf, 0x91, 0xb4, 0x8a, 0xe4, 0x89, 0xcf, 0xe1, 0xa7, 0x68, 0x71, 0x6e,
+	0x64, 0x15, 0xdd, 0xf2, 0xe8, 0xbd, 0x6a, 0x37, 0x59, 0x66, 0xbd, 0x44, 0xd8, 0x80, 0x33, 0x73,
+	0xc2, 0x41, 0xd2, 0x79, 0x55, 0xec, 0xd6, 0x3b, 0x9c, 0xa9, 0xa7, 0x9f, 0xe4, 0xec, 0xb2, 0xda,
+	0xc7, 0xe8, 0x3e, 0x95, 0x52, 0x5f, 0x01, 0x27, 0xaf, 0x60, 0x4e, 0xbc, 0x5d, 0xc3, 0x6d, 0x5f,
+	0xc3, 0x4e, 0xb9, 0xf1, 0x35, 0xcc, 0x2f, 0xf2, 0xf4, 0xe9, 0x66, 0x80, 0x76, 0x9b, 0x07, 0xbf,
+	0xae, 0xa3, 0xbd, 0xca, 0x5e, 0x5f, 0x81, 0x02, 0x2b, 0xec, 0xb9, 0xa3, 0x0e, 0xf0, 0x73, 0x54,
+	0x9f, 0x7a, 0xbb, 0x79, 0x8f, 0x35, 0x8f, 0x1e, 0x47, 0x77, 0x3f, 0x14, 0xd1, 0xaa, 0x41, 0x8f,
+	0x37, 0xf3, 0x12, 0x93, 0x92, 0x8f, 0x4f, 0x51, 0x50, 0xf5, 0xef, 0x8d, 0xd7, 0x3c, 0xea, 0xfd,
+	0x97, 0xd6, 0xa0, 0xc4, 0xbe, 0x50, 0x43, 0x5d, 0x2a, 0x2d, 0xf8, 0xf8, 0x21, 0x6a, 0x28, 0xb8,
+	0x22, 0x9e, 0xe9, 0x7d, 0x17, 0x24, 0x81, 0x82, 0xab, 0x7e, 0x1e, 0xe3, 0x07, 0xa8, 0x3e, 0x35,
+	0xd0, 0xef, 0x5f, 0x7a, 0x33, 0x05, 0x49, 0x19, 0xe5, 0x67, 0xc8, 0xb4, 0x52, 0xe0, 0x2f, 0x14,
+	0x11, 0x85, 0x6f, 0x1a, 0x49, 0xeb, 0x36, 0xf9, 0x82, 0xe3, 0x36, 0x0a, 0x0c, 0x48, 0x9a, 0x29,
+	0x36, 0xf6, 0xf7, 0x3f, 0x48, 0x16, 0xf1, 0xc1, 0x4f, 0xeb, 0xa8, 0xb5, 0x5c, 0x16, 0x3e, 0x43,
+	0xad, 0xe2, 0xd1, 0x23, 0x36, 0x1f, 0x56, 0x39, 0xa2, 0x4f, 0x22, 0x91, 0xb2, 0x68, 0xf9, 0x49,
+	0x8c, 0x96, 0x1e, 0xc1, 0x7c, 0x4c, 0x3e, 0xeb, 0xe7, 0x9b, 0x34, 0xd9, 0x6d, 0x80, 0xbf, 0x43,
+	0x3b, 0xb9, 0x0d, 0x40, 0xd9, 0xcc, 0x96, 0x92, 0xc5, 0xa4, 0xa2, 0xff, 0x95, 0xac, 0x68, 0x85,
+	0xea, 0x36, 0x5b, 0x89, 0xf1, 0x19, 0xda, 0x11, 0x4a, 0x38, 0x41, 0x25, 0x99, 0x51, 0x49, 0x2c,
+	0xb8, 0x70, 0xa3, 0xbb, 0xd1, 0x6b, 0x1e, 0x75, 0x97, 0x75, 0xf2, 0xb7, 0x3d, 0xba, 0xa4, 0x52,
+	0x70, 0xea, 0xb4, 0xf9, 0x76, 0xca, 0xa9, 0x83, 0x72, 0xf4, 0x5b, 0x25, 0xfd, 0x92, 0xca, 0x73,
+	0x70, 0xc7, 0x67, 0xaf, 0xaf, 0x3b, 0xb5, 0x37, 0xd7, 0x9d, 0xda, 0x5f, 0xd7, 0x9d, 0xda, 0xcf,
+	0x37, 0x9d, 0xb5, 0x37, 0x37, 0x9d, 0xb5, 0xdf, 0x6e, 0x3a, 0x6b, 0xdf, 0x3f, 0x1d, 0x09, 0x37,
+	0xce, 0xd2, 0x88, 0xe9, 0x49, 0x4c, 0xa5, 0x14, 0x2a, 0x15, 0xce, 0xc6, 0xb7, 0xe7, 0xfc, 0x64,
+	0xf1, 0x3b, 0xfa, 0xd1, 0xff, 0x90, 0xfc, 0xdd, 0x4c, 0xeb, 0xfe, 0x7e, 0x7f, 0xf6, 0x77, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xa4, 0xb5, 0xf3, 0xf4, 0xb8, 0x06, 0x00, 0x00,
 }
 
 func (m *ConsumerParams) Marshal() (dAtA []byte, err error) {
@@ -424,6 +447,15 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedKeyTypes) > 0 {
+		for iNdEx := len(m.AllowedKeyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedKeyTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedKeyTypes[iNdEx])
+			i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.AllowedKeyTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -538,6 +570,16 @@ func (m *ConsumerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Relaunch {
+		i--
+		if m.Relaunch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -707,6 +749,12 @@ func (m *ConsumerParams) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod)
 	n += 1 + l + sovSharedConsumer(uint64(l))
+	if len(m.AllowedKeyTypes) > 0 {
+		for _, s := range m.AllowedKeyTypes {
+			l = len(s)
+			n += 1 + l + sovSharedConsumer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -730,6 +778,9 @@ func (m *ConsumerGenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
+	if m.Relaunch {
+		n += 2
+	}
 	return n
 }
 
@@ -1141,6 +1192,38 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedKeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedKeyTypes = append(m.AllowedKeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
@@ -1329,6 +1412,26 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relaunch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Relaunch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
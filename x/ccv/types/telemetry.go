@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Labels of the CCV telemetry metrics
+const (
+	MetricLabelChainID = "chain_id"
+	MetricLabelDenom   = "denom"
+)
+
+// ChainIDMetricLabels returns the labels of a CCV telemetry metric for the given chain ID
+func ChainIDMetricLabels(chainID string) []metrics.Label {
+	return []metrics.Label{telemetry.NewLabel(MetricLabelChainID, chainID)}
+}
+
+// DenomMetricLabels returns the labels of a CCV telemetry metric for the given chain ID and denom
+func DenomMetricLabels(chainID, denom string) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel(MetricLabelChainID, chainID),
+		telemetry.NewLabel(MetricLabelDenom, denom),
+	}
+}
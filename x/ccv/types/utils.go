@@ -2,7 +2,9 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -19,6 +21,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
 )
 
 func AccumulateChanges(currentChanges, newChanges []abci.ValidatorUpdate) []abci.ValidatorUpdate {
@@ -60,6 +63,42 @@ func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, er
 	return sdk.GetConsAddress(sdkK), nil
 }
 
+// ConsensusKeyType returns the CometBFT type of the given consensus public key, e.g. "ed25519"
+func ConsensusKeyType(k tmprotocrypto.PublicKey) (string, error) {
+	switch k.Sum.(type) {
+	case *tmprotocrypto.PublicKey_Ed25519:
+		return tmtypes.ABCIPubKeyTypeEd25519, nil
+	case *tmprotocrypto.PublicKey_Secp256K1:
+		return tmtypes.ABCIPubKeyTypeSecp256k1, nil
+	default:
+		return "", fmt.Errorf("unsupported consensus public key type: %T", k.Sum)
+	}
+}
+
+// IsKeyTypeAllowed returns true if the validators of a consumer chain that declares
+// `allowedKeyTypes` can use consensus keys of type `keyType`
+func IsKeyTypeAllowed(allowedKeyTypes []string, keyType string) bool {
+	if len(allowedKeyTypes) == 0 {
+		allowedKeyTypes = DefaultAllowedKeyTypes
+	}
+	return slices.Contains(allowedKeyTypes, keyType)
+}
+
+// ValidateValidatorKeyTypes checks that the public keys of the given validator updates
+// are of the given allowed key types
+func ValidateValidatorKeyTypes(updates []abci.ValidatorUpdate, allowedKeyTypes []string) error {
+	for _, update := range updates {
+		keyType, err := ConsensusKeyType(update.PubKey)
+		if err != nil {
+			return err
+		}
+		if !IsKeyTypeAllowed(allowedKeyTypes, keyType) {
+			return fmt.Errorf("consensus key type %s is not allowed", keyType)
+		}
+	}
+	return nil
+}
+
 // SendIBCPacket sends an IBC packet with packetData
 // over the source channelID and portID
 // IBC v10: Removed scopedKeeper parameter as capabilities are no longer used
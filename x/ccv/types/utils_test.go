@@ -9,6 +9,9 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/allinbits/interchain-security/x/ccv/types"
 )
@@ -88,3 +91,23 @@ func TestAccumulateChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestConsensusKeyType(t *testing.T) {
+	ed25519Key := tmprotocrypto.PublicKey{Sum: &tmprotocrypto.PublicKey_Ed25519{Ed25519: ed25519.GenPrivKey().PubKey().Bytes()}}
+	secp256k1Key := tmprotocrypto.PublicKey{Sum: &tmprotocrypto.PublicKey_Secp256K1{Secp256K1: secp256k1.GenPrivKey().PubKey().Bytes()}}
+
+	keyType, err := types.ConsensusKeyType(ed25519Key)
+	require.NoError(t, err)
+	require.Equal(t, "ed25519", keyType)
+	keyType, err = types.ConsensusKeyType(secp256k1Key)
+	require.NoError(t, err)
+	require.Equal(t, "secp256k1", keyType)
+	_, err = types.ConsensusKeyType(tmprotocrypto.PublicKey{})
+	require.Error(t, err)
+
+	// only ed25519 keys are allowed by default
+	require.True(t, types.IsKeyTypeAllowed(nil, "ed25519"))
+	require.False(t, types.IsKeyTypeAllowed(nil, "secp256k1"))
+	require.True(t, types.IsKeyTypeAllowed([]string{"secp256k1"}, "secp256k1"))
+	require.False(t, types.IsKeyTypeAllowed([]string{"secp256k1"}, "ed25519"))
+}
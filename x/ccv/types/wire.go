@@ -31,14 +31,85 @@ func (vsc ValidatorSetChangePacketData) Validate() error {
 	if vsc.ValsetUpdateId == 0 {
 		return errorsmod.Wrap(ErrInvalidPacketData, "valset update id cannot be equal to zero")
 	}
+	if vsc.IsSplit() && vsc.PartIndex >= vsc.TotalParts {
+		return errorsmod.Wrapf(ErrInvalidPacketData,
+			"part index %d must be smaller than the total number of parts %d", vsc.PartIndex, vsc.TotalParts)
+	}
+	if !vsc.IsSplit() && vsc.PartIndex != 0 {
+		return errorsmod.Wrapf(ErrInvalidPacketData,
+			"part index %d must be zero for a packet that is not split", vsc.PartIndex)
+	}
 	return nil
 }
 
+// IsSplit returns true if the packet is one of several packets that together
+// carry a single validator set change.
+func (vsc ValidatorSetChangePacketData) IsSplit() bool {
+	return vsc.TotalParts > 1
+}
+
+// IsLastPart returns true if no more packets are expected for the validator set
+// change with this valset update id, i.e., if the packet was not split or if
+// it is the last part of a split validator set change.
+func (vsc ValidatorSetChangePacketData) IsLastPart() bool {
+	return !vsc.IsSplit() || vsc.PartIndex == vsc.TotalParts-1
+}
+
 // GetBytes marshals the ValidatorSetChangePacketData into JSON string bytes
-// to be sent over the wire with IBC.
+// to be sent over the wire with IBC. Packets that were not split are marshaled
+// without the split metadata to maintain over the wire compatibility with
+// previous versions.
 func (vsc ValidatorSetChangePacketData) GetBytes() []byte {
-	valUpdateBytes := ModuleCdc.MustMarshalJSON(&vsc)
-	return valUpdateBytes
+	if vsc.IsSplit() {
+		return ModuleCdc.MustMarshalJSON(&vsc)
+	}
+	vscv1 := ValidatorSetChangePacketDataV1{
+		ValidatorUpdates: vsc.ValidatorUpdates,
+		ValsetUpdateId:   vsc.ValsetUpdateId,
+		SlashAcks:        vsc.SlashAcks,
+	}
+	return ModuleCdc.MustMarshalJSON(&vscv1)
+}
+
+// SplitValidatorSetChangePacketData splits the validator updates and slash acks
+// of a validator set change into packets that contain at most maxPacketSize
+// entries each. All resulting packets share the same valset update id.
+// The split is deterministic: validator updates are packed first, in the given
+// order, followed by the slash acks. If maxPacketSize is not positive or if
+// everything fits into a single packet, a single unsplit packet is returned.
+func SplitValidatorSetChangePacketData(
+	valUpdates []abci.ValidatorUpdate,
+	valUpdateID uint64,
+	slashAcks []string,
+	maxPacketSize int64,
+) []ValidatorSetChangePacketData {
+	size := int64(len(valUpdates) + len(slashAcks))
+	if maxPacketSize <= 0 || size <= maxPacketSize {
+		return []ValidatorSetChangePacketData{NewValidatorSetChangePacketData(valUpdates, valUpdateID, slashAcks)}
+	}
+
+	totalParts := (size + maxPacketSize - 1) / maxPacketSize
+	packets := make([]ValidatorSetChangePacketData, 0, totalParts)
+	for i := int64(0); i < totalParts; i++ {
+		start, end := i*maxPacketSize, min((i+1)*maxPacketSize, size)
+
+		// validator updates must never be nil, even for parts that only carry slash acks
+		updates := []abci.ValidatorUpdate{}
+		var acks []string
+		numUpdates := int64(len(valUpdates))
+		if start < numUpdates {
+			updates = valUpdates[start:min(end, numUpdates)]
+		}
+		if end > numUpdates {
+			acks = slashAcks[max(start, numUpdates)-numUpdates : end-numUpdates]
+		}
+
+		packet := NewValidatorSetChangePacketData(updates, valUpdateID, acks)
+		packet.PartIndex = uint32(i)
+		packet.TotalParts = uint32(totalParts)
+		packets = append(packets, packet)
+	}
+	return packets
 }
 
 func NewVSCMaturedPacketData(valUpdateID uint64) *VSCMaturedPacketData {
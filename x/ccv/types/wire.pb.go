@@ -102,6 +102,12 @@ type ValidatorSetChangePacketData struct {
 	// consensus address of consumer chain validators
 	// successfully slashed on the provider chain
 	SlashAcks []string `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+	// index of this packet among the parts of a split validator set change;
+	// only meaningful if total_parts is greater than one
+	PartIndex uint32 `protobuf:"varint,4,opt,name=part_index,json=partIndex,proto3" json:"part_index,omitempty"`
+	// number of packets the validator set change with this valset_update_id
+	// was split into; zero or one for packets that were not split
+	TotalParts uint32 `protobuf:"varint,5,opt,name=total_parts,json=totalParts,proto3" json:"total_parts,omitempty"`
 }
 
 func (m *ValidatorSetChangePacketData) Reset()         { *m = ValidatorSetChangePacketData{} }
@@ -158,6 +164,20 @@ func (m *ValidatorSetChangePacketData) GetSlashAcks() []string {
 	return nil
 }
 
+func (m *ValidatorSetChangePacketData) GetPartIndex() uint32 {
+	if m != nil {
+		return m.PartIndex
+	}
+	return 0
+}
+
+func (m *ValidatorSetChangePacketData) GetTotalParts() uint32 {
+	if m != nil {
+		return m.TotalParts
+	}
+	return 0
+}
+
 // This packet is sent from the consumer chain to the provider chain
 // to notify that a VSC packet reached maturity on the consumer chain.
 type VSCMaturedPacketData struct {
@@ -514,6 +534,70 @@ func (*ConsumerPacketDataV1) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ValidatorSetChangePacketData without the split metadata. It is used for
+// sending VSC packets that were not split, so that they remain compatible
+// over the wire with consumers that predate packet splitting. It is not used
+// for internal storage.
+type ValidatorSetChangePacketDataV1 struct {
+	ValidatorUpdates []types.ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates" yaml:"validator_updates"`
+	ValsetUpdateId   uint64                  `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	SlashAcks        []string                `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+}
+
+func (m *ValidatorSetChangePacketDataV1) Reset()         { *m = ValidatorSetChangePacketDataV1{} }
+func (m *ValidatorSetChangePacketDataV1) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChangePacketDataV1) ProtoMessage()    {}
+func (*ValidatorSetChangePacketDataV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChangePacketDataV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChangePacketDataV1.Merge(m, src)
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChangePacketDataV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChangePacketDataV1 proto.InternalMessageInfo
+
+func (m *ValidatorSetChangePacketDataV1) GetValidatorUpdates() []types.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *ValidatorSetChangePacketDataV1) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ValidatorSetChangePacketDataV1) GetSlashAcks() []string {
+	if m != nil {
+		return m.SlashAcks
+	}
+	return nil
+}
+
 // This packet is sent from the consumer chain to the provider chain
 // It is backward compatible with the ICS v1 and v2 version of the packet.
 type SlashPacketDataV1 struct {
@@ -528,7 +612,7 @@ func (m *SlashPacketDataV1) Reset()         { *m = SlashPacketDataV1{} }
 func (m *SlashPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*SlashPacketDataV1) ProtoMessage()    {}
 func (*SlashPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{7}
 }
 func (m *SlashPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,6 +671,7 @@ func init() {
 	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
 	proto.RegisterType((*HandshakeMetadata)(nil), "interchain_security.ccv.v1.HandshakeMetadata")
 	proto.RegisterType((*ConsumerPacketDataV1)(nil), "interchain_security.ccv.v1.ConsumerPacketDataV1")
+	proto.RegisterType((*ValidatorSetChangePacketDataV1)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketDataV1")
 	proto.RegisterType((*SlashPacketDataV1)(nil), "interchain_security.ccv.v1.SlashPacketDataV1")
 }
 
@@ -595,60 +680,63 @@ func init() {
 }
 
 var fileDescriptor_8fd0dc67df6b10ed = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xb7, 0xd3, 0x6a, 0xa1, 0x13, 0x94, 0xba, 0xde, 0xb0, 0x32, 0x5e, 0xc8, 0x5a, 0x16, 0x48,
-	0x51, 0xd1, 0xda, 0x24, 0xdd, 0x13, 0x5c, 0xc8, 0x1f, 0x97, 0x1a, 0xb6, 0x69, 0x64, 0x27, 0x59,
-	0x2d, 0x17, 0x6b, 0x62, 0x4f, 0x93, 0x51, 0x1c, 0x8f, 0xe5, 0x99, 0x78, 0xc9, 0x37, 0x40, 0x39,
-	0xf1, 0x05, 0x72, 0x42, 0x1c, 0xf6, 0x63, 0x70, 0xdb, 0xe3, 0x4a, 0x5c, 0xf6, 0xc2, 0x0a, 0xb5,
-	0xdf, 0x80, 0x4f, 0x80, 0xec, 0x34, 0x4d, 0x9a, 0xb8, 0x95, 0x2a, 0x21, 0xc1, 0xcd, 0x7e, 0xf3,
-	0x7e, 0xbf, 0x79, 0xef, 0xf7, 0x7b, 0xa3, 0x07, 0xbe, 0xc0, 0x01, 0x43, 0x91, 0x3b, 0x84, 0x38,
-	0x70, 0x28, 0x72, 0x27, 0x11, 0x66, 0x53, 0xdd, 0x75, 0x63, 0x3d, 0xae, 0xe8, 0xaf, 0x70, 0x84,
-	0xb4, 0x30, 0x22, 0x8c, 0x88, 0x72, 0x46, 0x9a, 0xe6, 0xba, 0xb1, 0x16, 0x57, 0xe4, 0xcf, 0x5d,
-	0x42, 0xc7, 0x84, 0xea, 0x94, 0xc1, 0x11, 0x0e, 0x06, 0x7a, 0x5c, 0xe9, 0x23, 0x06, 0x2b, 0xcb,
-	0xff, 0x05, 0x83, 0x5c, 0x1c, 0x90, 0x01, 0x49, 0x3f, 0xf5, 0xe4, 0xeb, 0x2a, 0xfa, 0x98, 0xa1,
-	0xc0, 0x43, 0xd1, 0x18, 0x07, 0x4c, 0x87, 0x7d, 0x17, 0xeb, 0x6c, 0x1a, 0x22, 0xba, 0x38, 0x54,
-	0xdf, 0xf1, 0xe0, 0xd3, 0x1e, 0xf4, 0xb1, 0x07, 0x19, 0x89, 0x6c, 0xc4, 0x1a, 0x43, 0x18, 0x0c,
-	0x50, 0x1b, 0xba, 0x23, 0xc4, 0x9a, 0x90, 0x41, 0x91, 0x80, 0x83, 0x78, 0x79, 0xee, 0x4c, 0x42,
-	0x0f, 0x32, 0x44, 0x25, 0x5e, 0xd9, 0x29, 0xe7, 0xab, 0x8a, 0xb6, 0x62, 0xd6, 0x12, 0x66, 0xed,
-	0x9a, 0xa9, 0x9b, 0x26, 0xd6, 0x95, 0x37, 0xef, 0x9f, 0x70, 0x7f, 0xbf, 0x7f, 0x22, 0x4d, 0xe1,
-	0xd8, 0xff, 0x5a, 0xdd, 0x22, 0x52, 0x2d, 0x21, 0xbe, 0x09, 0xa1, 0x62, 0x19, 0x24, 0x31, 0x8a,
-	0xd8, 0x55, 0x92, 0x83, 0x3d, 0x29, 0xa7, 0xf0, 0xe5, 0x5d, 0xab, 0xb0, 0x88, 0x2f, 0x12, 0x4d,
-	0x4f, 0xfc, 0x0c, 0x00, 0xea, 0x43, 0x3a, 0x74, 0xa0, 0x3b, 0xa2, 0xd2, 0x8e, 0xb2, 0x53, 0xde,
-	0xb3, 0xf6, 0xd2, 0x48, 0xcd, 0x1d, 0x51, 0xf5, 0x5b, 0x50, 0xec, 0xd9, 0x8d, 0x53, 0xc8, 0x26,
-	0x11, 0xf2, 0xd6, 0x3a, 0xca, 0xba, 0x80, 0xcf, 0xba, 0x40, 0xfd, 0x83, 0x07, 0xfb, 0x76, 0xc2,
-	0xb7, 0x86, 0xb6, 0xc0, 0xde, 0x75, 0xc9, 0x29, 0x2c, 0x5f, 0x95, 0x6f, 0xd7, 0xa1, 0x2e, 0x5d,
-	0x29, 0x20, 0x6c, 0x28, 0xa0, 0x5a, 0x2b, 0x9a, 0x7b, 0xb4, 0x5c, 0x07, 0x00, 0x07, 0xe7, 0x11,
-	0x74, 0x19, 0x26, 0x81, 0xb4, 0xa3, 0xf0, 0xe5, 0x42, 0x55, 0xd5, 0x16, 0xc3, 0xa1, 0x2d, 0x87,
-	0xe1, 0x6a, 0x38, 0x34, 0xf3, 0x3a, 0xd3, 0x5a, 0x43, 0xa9, 0xbf, 0xe5, 0x80, 0xd8, 0x20, 0x01,
-	0x9d, 0x8c, 0x51, 0xb4, 0xd6, 0xd8, 0x31, 0xd8, 0x4d, 0x06, 0x23, 0xed, 0xa9, 0x50, 0xad, 0x6a,
-	0xb7, 0x4f, 0xa3, 0xb6, 0x8d, 0xee, 0x4c, 0x43, 0x64, 0xa5, 0x78, 0xf1, 0x05, 0xd8, 0xa7, 0x37,
-	0x35, 0x4b, 0x7b, 0xc9, 0x57, 0xbf, 0xbc, 0x8b, 0x72, 0x43, 0xe6, 0x13, 0xce, 0xda, 0x64, 0x11,
-	0xcf, 0x41, 0x31, 0xa6, 0xee, 0x96, 0x9f, 0xa9, 0x0a, 0xf9, 0xea, 0x57, 0x77, 0xb1, 0x67, 0xcd,
-	0xc1, 0x09, 0x67, 0x65, 0xf2, 0xd5, 0x1f, 0x80, 0x5d, 0x0f, 0x32, 0xa8, 0xf6, 0xc1, 0xc1, 0x09,
-	0x0c, 0x3c, 0x3a, 0x84, 0x23, 0x74, 0x8a, 0x18, 0x4c, 0x82, 0xe2, 0x11, 0x78, 0x14, 0x46, 0x24,
-	0xc6, 0x1e, 0x8a, 0x9c, 0x73, 0x84, 0x9c, 0x90, 0x10, 0xdf, 0x81, 0x9e, 0xb7, 0x98, 0x85, 0x3d,
-	0xeb, 0xe1, 0xf2, 0xf4, 0x18, 0xa1, 0x36, 0x21, 0x7e, 0xcd, 0xf3, 0x22, 0x51, 0x02, 0x1f, 0xc4,
-	0x28, 0xa2, 0x89, 0x65, 0xb9, 0x34, 0x6b, 0xf9, 0xab, 0xbe, 0xce, 0x81, 0xe2, 0xb6, 0x9a, 0xbd,
-	0xca, 0xbf, 0xe6, 0xc6, 0xcb, 0xdb, 0xdc, 0x78, 0x7a, 0x0f, 0x37, 0x7a, 0x95, 0xff, 0x83, 0x1f,
-	0x7f, 0xf2, 0xe0, 0x60, 0xab, 0xb0, 0xff, 0xf8, 0x3d, 0x7e, 0x9f, 0xf1, 0x1e, 0x0f, 0xef, 0xea,
-	0x7c, 0xf5, 0x26, 0x53, 0x93, 0xd6, 0xd0, 0x87, 0xbf, 0xf3, 0xe0, 0x51, 0xb6, 0x97, 0xe2, 0x37,
-	0x40, 0x69, 0x9c, 0xb5, 0xec, 0xee, 0xa9, 0x61, 0x39, 0xed, 0x5a, 0xe3, 0x07, 0xa3, 0xe3, 0x74,
-	0x5e, 0xb6, 0x0d, 0xa7, 0xdb, 0xb2, 0xdb, 0x46, 0xc3, 0x3c, 0x36, 0x8d, 0xa6, 0xc0, 0xc9, 0x1f,
-	0xcf, 0xe6, 0xca, 0x41, 0x37, 0xa0, 0x21, 0x72, 0xf1, 0x39, 0x5e, 0x6a, 0x28, 0xea, 0x40, 0xce,
-	0x04, 0xdb, 0xcf, 0x6b, 0xf6, 0x89, 0xc0, 0xcb, 0xfb, 0xb3, 0xb9, 0x92, 0x5f, 0x13, 0x56, 0x3c,
-	0x02, 0x9f, 0x64, 0x02, 0x12, 0xd7, 0x84, 0x9c, 0x5c, 0x9c, 0xcd, 0x15, 0xa1, 0xb7, 0xe1, 0x94,
-	0xbc, 0xfb, 0xf3, 0xaf, 0x25, 0xee, 0xf0, 0x35, 0x0f, 0x0a, 0x37, 0x5b, 0x14, 0x9f, 0x81, 0xc7,
-	0x66, 0xeb, 0xd8, 0xaa, 0x35, 0x3a, 0xe6, 0x59, 0x2b, 0xab, 0xec, 0x87, 0xb3, 0xb9, 0xb2, 0xbf,
-	0x02, 0x19, 0xe3, 0x90, 0x4d, 0x45, 0x7d, 0x1b, 0xd5, 0x3c, 0xeb, 0xd6, 0x9f, 0x1b, 0x8e, 0x6d,
-	0x7e, 0xd7, 0x12, 0x78, 0xb9, 0x30, 0x9b, 0x2b, 0xa0, 0x49, 0x26, 0x7d, 0x1f, 0xd9, 0x78, 0x10,
-	0x88, 0x87, 0x40, 0xda, 0x06, 0xbc, 0x68, 0x75, 0xcc, 0x53, 0x43, 0xc8, 0xc9, 0x1f, 0xcd, 0xe6,
-	0xca, 0x87, 0x4d, 0xf2, 0x2a, 0x60, 0x78, 0x8c, 0x16, 0xb5, 0xd6, 0x5b, 0x6f, 0x2e, 0x4a, 0xfc,
-	0xdb, 0x8b, 0x12, 0xff, 0xd7, 0x45, 0x89, 0xff, 0xe5, 0xb2, 0xc4, 0xbd, 0xbd, 0x2c, 0x71, 0xef,
-	0x2e, 0x4b, 0xdc, 0x8f, 0xcf, 0x06, 0x98, 0x0d, 0x27, 0x7d, 0xcd, 0x25, 0x63, 0x1d, 0xfa, 0x3e,
-	0x0e, 0xfa, 0x98, 0x51, 0x7d, 0xe5, 0xea, 0xd3, 0xeb, 0x2d, 0xfe, 0x53, 0xba, 0xc7, 0xd3, 0x85,
-	0xda, 0x7f, 0x90, 0x6e, 0xd4, 0xa3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xc3, 0xdc, 0x94,
-	0xef, 0x07, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x1c, 0x8d, 0xd3, 0xb0, 0x90, 0x09, 0xa4, 0xa9, 0x37, 0xac, 0x8c, 0x17, 0x52, 0xcb, 0x02, 0x29,
+	0x2a, 0x5a, 0x9b, 0xa4, 0x7b, 0x82, 0x0b, 0xf9, 0xe3, 0x52, 0xc3, 0x36, 0x8d, 0xec, 0x24, 0xab,
+	0xe5, 0x62, 0x4d, 0xec, 0x69, 0x32, 0x8a, 0xe3, 0xb1, 0x3c, 0x13, 0xef, 0xe6, 0x1b, 0xa0, 0x9c,
+	0x38, 0x72, 0xc9, 0x09, 0x71, 0xd8, 0x8f, 0xc1, 0x6d, 0x8f, 0x2b, 0x71, 0x41, 0x48, 0xac, 0x50,
+	0xfb, 0x0d, 0xf8, 0x04, 0xc8, 0x4e, 0xd3, 0xa4, 0x8d, 0x1b, 0xa9, 0x12, 0x12, 0xec, 0xcd, 0x7e,
+	0xf3, 0x7b, 0x4f, 0xf3, 0x7b, 0xbf, 0xa7, 0x9f, 0x06, 0x7c, 0x86, 0x3d, 0x86, 0x02, 0x7b, 0x08,
+	0xb1, 0x67, 0x51, 0x64, 0x4f, 0x02, 0xcc, 0xa6, 0xaa, 0x6d, 0x87, 0x6a, 0x58, 0x51, 0x9f, 0xe3,
+	0x00, 0x29, 0x7e, 0x40, 0x18, 0xe1, 0xc5, 0x84, 0x32, 0xc5, 0xb6, 0x43, 0x25, 0xac, 0x88, 0x9f,
+	0xda, 0x84, 0x8e, 0x09, 0x55, 0x29, 0x83, 0x23, 0xec, 0x0d, 0xd4, 0xb0, 0xd2, 0x47, 0x0c, 0x56,
+	0x96, 0xff, 0x0b, 0x05, 0xb1, 0x38, 0x20, 0x03, 0x12, 0x7f, 0xaa, 0xd1, 0xd7, 0x25, 0xfa, 0x90,
+	0x21, 0xcf, 0x41, 0xc1, 0x18, 0x7b, 0x4c, 0x85, 0x7d, 0x1b, 0xab, 0x6c, 0xea, 0x23, 0xba, 0x38,
+	0x94, 0x7f, 0x4a, 0x83, 0x8f, 0x7b, 0xd0, 0xc5, 0x0e, 0x64, 0x24, 0x30, 0x11, 0x6b, 0x0c, 0xa1,
+	0x37, 0x40, 0x6d, 0x68, 0x8f, 0x10, 0x6b, 0x42, 0x06, 0x79, 0x02, 0xf6, 0xc2, 0xe5, 0xb9, 0x35,
+	0xf1, 0x1d, 0xc8, 0x10, 0x15, 0x38, 0x69, 0xa7, 0x9c, 0xab, 0x4a, 0xca, 0x4a, 0x59, 0x89, 0x94,
+	0x95, 0x2b, 0xa5, 0x6e, 0x5c, 0x58, 0x97, 0x5e, 0xbd, 0xd9, 0x4f, 0xfd, 0xfd, 0x66, 0x5f, 0x98,
+	0xc2, 0xb1, 0xfb, 0xa5, 0xbc, 0x21, 0x24, 0x1b, 0x85, 0xf0, 0x3a, 0x85, 0xf2, 0x65, 0x10, 0x61,
+	0x14, 0xb1, 0xcb, 0x22, 0x0b, 0x3b, 0x42, 0x5a, 0xe2, 0xca, 0x19, 0x23, 0xbf, 0xc0, 0x17, 0x85,
+	0xba, 0xc3, 0x7f, 0x02, 0x00, 0x75, 0x21, 0x1d, 0x5a, 0xd0, 0x1e, 0x51, 0x61, 0x47, 0xda, 0x29,
+	0x67, 0x8d, 0x6c, 0x8c, 0xd4, 0xec, 0x11, 0x8d, 0x8e, 0x7d, 0x18, 0x30, 0x0b, 0x7b, 0x0e, 0x7a,
+	0x21, 0x64, 0x24, 0xae, 0xfc, 0x81, 0x91, 0x8d, 0x10, 0x3d, 0x02, 0xf8, 0x7d, 0x90, 0x63, 0x84,
+	0x41, 0xd7, 0x8a, 0x20, 0x2a, 0xbc, 0x13, 0x9f, 0x83, 0x18, 0x6a, 0x47, 0x88, 0xfc, 0x35, 0x28,
+	0xf6, 0xcc, 0xc6, 0x09, 0x64, 0x93, 0x00, 0x39, 0x6b, 0x8e, 0x24, 0x5d, 0x90, 0x4b, 0xba, 0xa0,
+	0xfc, 0x1b, 0x07, 0x76, 0xcd, 0xe8, 0x3e, 0x6b, 0x6c, 0x03, 0x64, 0xaf, 0x5a, 0x8e, 0x69, 0xb9,
+	0xaa, 0x78, 0xbb, 0x8f, 0x75, 0xe1, 0xd2, 0xc1, 0xc2, 0x0d, 0x07, 0x65, 0x63, 0x25, 0x73, 0x07,
+	0xcb, 0xea, 0x00, 0x60, 0xef, 0x2c, 0x80, 0x36, 0xc3, 0xc4, 0x13, 0x76, 0x24, 0xae, 0x9c, 0xaf,
+	0xca, 0xca, 0x22, 0x5c, 0xca, 0x32, 0x4c, 0x97, 0xe1, 0x52, 0xf4, 0xab, 0x4a, 0x63, 0x8d, 0x25,
+	0xff, 0x92, 0x06, 0x7c, 0x83, 0x78, 0x74, 0x32, 0x46, 0xc1, 0x5a, 0x63, 0x47, 0x20, 0x13, 0x05,
+	0x2b, 0xee, 0x29, 0x5f, 0xad, 0x2a, 0xb7, 0xa7, 0x59, 0xd9, 0x64, 0x77, 0xa6, 0x3e, 0x32, 0x62,
+	0x3e, 0xff, 0x14, 0xec, 0xd2, 0xeb, 0x9e, 0xc5, 0xbd, 0xe4, 0xaa, 0x9f, 0x6f, 0x93, 0xbc, 0x61,
+	0xf3, 0x71, 0xca, 0xb8, 0xa9, 0xc2, 0x9f, 0x81, 0x62, 0x48, 0xed, 0x8d, 0x79, 0xc6, 0x2e, 0xe4,
+	0xaa, 0x5f, 0x6c, 0x53, 0x4f, 0xca, 0xc1, 0x71, 0xca, 0x48, 0xd4, 0xab, 0xdf, 0x03, 0x19, 0x07,
+	0x32, 0x28, 0xf7, 0xc1, 0xde, 0x31, 0xf4, 0x1c, 0x3a, 0x84, 0x23, 0x74, 0x82, 0x18, 0x8c, 0x40,
+	0xfe, 0x10, 0x3c, 0xf0, 0x03, 0x12, 0x62, 0x07, 0x05, 0xd6, 0x19, 0x42, 0x96, 0x4f, 0x88, 0x6b,
+	0x41, 0xc7, 0x59, 0x64, 0x21, 0x6b, 0xdc, 0x5f, 0x9e, 0x1e, 0x21, 0xd4, 0x26, 0xc4, 0xad, 0x39,
+	0x4e, 0xc0, 0x0b, 0xe0, 0xdd, 0x10, 0x05, 0x34, 0x1a, 0x59, 0x3a, 0xae, 0x5a, 0xfe, 0xca, 0x2f,
+	0xd3, 0xa0, 0xb8, 0xe9, 0x66, 0xaf, 0xf2, 0xaf, 0x4d, 0xe3, 0xd9, 0x6d, 0xd3, 0x78, 0x74, 0x87,
+	0x69, 0xf4, 0x2a, 0xff, 0x87, 0x79, 0xfc, 0xc1, 0x81, 0xd2, 0xb6, 0x55, 0xd7, 0xab, 0xbc, 0xbd,
+	0xcb, 0x4e, 0xfe, 0x93, 0x03, 0x7b, 0x1b, 0xae, 0xff, 0xc7, 0xcb, 0xe6, 0xdb, 0x84, 0x65, 0x73,
+	0xb0, 0x6d, 0xac, 0xab, 0x85, 0x13, 0x27, 0x70, 0x8d, 0x7d, 0xf0, 0x2b, 0x07, 0x1e, 0x24, 0x07,
+	0x95, 0xff, 0x0a, 0x48, 0x8d, 0xd3, 0x96, 0xd9, 0x3d, 0xd1, 0x0c, 0xab, 0x5d, 0x6b, 0x7c, 0xa7,
+	0x75, 0xac, 0xce, 0xb3, 0xb6, 0x66, 0x75, 0x5b, 0x66, 0x5b, 0x6b, 0xe8, 0x47, 0xba, 0xd6, 0x2c,
+	0xa4, 0xc4, 0x0f, 0x67, 0x73, 0x69, 0xaf, 0xeb, 0x51, 0x1f, 0xd9, 0xf8, 0x0c, 0x2f, 0x03, 0xc2,
+	0xab, 0x40, 0x4c, 0x24, 0x9b, 0x4f, 0x6a, 0xe6, 0x71, 0x81, 0x13, 0x77, 0x67, 0x73, 0x29, 0xb7,
+	0x66, 0x2c, 0x7f, 0x08, 0x3e, 0x4a, 0x24, 0x44, 0x91, 0x2c, 0xa4, 0xc5, 0xe2, 0x6c, 0x2e, 0x15,
+	0x7a, 0x37, 0x62, 0x28, 0x66, 0x7e, 0xf8, 0xb9, 0x94, 0x3a, 0x78, 0xc9, 0x81, 0xfc, 0xf5, 0x16,
+	0xf9, 0xc7, 0xe0, 0xa1, 0xde, 0x3a, 0x32, 0x6a, 0x8d, 0x8e, 0x7e, 0xda, 0x4a, 0xba, 0xf6, 0xfd,
+	0xd9, 0x5c, 0xda, 0x5d, 0x91, 0xb4, 0xb1, 0xcf, 0xa6, 0xbc, 0xba, 0xc9, 0x6a, 0x9e, 0x76, 0xeb,
+	0x4f, 0x34, 0xcb, 0xd4, 0xbf, 0x69, 0x15, 0x38, 0x31, 0x3f, 0x9b, 0x4b, 0xa0, 0x49, 0x26, 0x7d,
+	0x17, 0x99, 0x78, 0xe0, 0xf1, 0x07, 0x40, 0xd8, 0x24, 0x3c, 0x6d, 0x75, 0xf4, 0x13, 0xad, 0x90,
+	0x16, 0xdf, 0x9f, 0xcd, 0xa5, 0xf7, 0x9a, 0xe4, 0xb9, 0xc7, 0xf0, 0x18, 0x2d, 0xee, 0x5a, 0x6f,
+	0xbd, 0x3a, 0x2f, 0x71, 0xaf, 0xcf, 0x4b, 0xdc, 0x5f, 0xe7, 0x25, 0xee, 0xc7, 0x8b, 0x52, 0xea,
+	0xf5, 0x45, 0x29, 0xf5, 0xfb, 0x45, 0x29, 0xf5, 0xfd, 0xe3, 0x01, 0x66, 0xc3, 0x49, 0x5f, 0xb1,
+	0xc9, 0x58, 0x85, 0xae, 0x8b, 0xbd, 0x3e, 0x66, 0x54, 0x5d, 0x4d, 0xf5, 0xd1, 0xd5, 0x13, 0xe7,
+	0x45, 0xfc, 0xc8, 0x89, 0x5f, 0x1b, 0xfd, 0x7b, 0xf1, 0x73, 0xe3, 0xf0, 0x9f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x5d, 0x21, 0xfe, 0x0a, 0x0c, 0x09, 0x00, 0x00,
 }
 
 func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
@@ -671,6 +759,16 @@ func (m *ValidatorSetChangePacketData) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.TotalParts != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.TotalParts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PartIndex != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.PartIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.SlashAcks) > 0 {
 		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SlashAcks[iNdEx])
@@ -968,6 +1066,57 @@ func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ValidatorSetChangePacketDataV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChangePacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChangePacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashAcks) > 0 {
+		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashAcks[iNdEx])
+			copy(dAtA[i:], m.SlashAcks[iNdEx])
+			i = encodeVarintWire(dAtA, i, uint64(len(m.SlashAcks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWire(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SlashPacketDataV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1043,6 +1192,12 @@ func (m *ValidatorSetChangePacketData) Size() (n int) {
 			n += 1 + l + sovWire(uint64(l))
 		}
 	}
+	if m.PartIndex != 0 {
+		n += 1 + sovWire(uint64(m.PartIndex))
+	}
+	if m.TotalParts != 0 {
+		n += 1 + sovWire(uint64(m.TotalParts))
+	}
 	return n
 }
 
@@ -1170,6 +1325,30 @@ func (m *ConsumerPacketDataV1_VscMaturedPacketData) Size() (n int) {
 	}
 	return n
 }
+func (m *ValidatorSetChangePacketDataV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
+	}
+	if len(m.SlashAcks) > 0 {
+		for _, s := range m.SlashAcks {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SlashPacketDataV1) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1307,6 +1486,44 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartIndex", wireType)
+			}
+			m.PartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalParts", wireType)
+			}
+			m.TotalParts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalParts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -1910,6 +2127,141 @@ func (m *ConsumerPacketDataV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorSetChangePacketDataV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketDataV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketDataV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SlashPacketDataV1) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
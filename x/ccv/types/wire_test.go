@@ -54,6 +54,26 @@ func TestPacketDataValidateBasic(t *testing.T) {
 				nil,
 			),
 		},
+		{
+			"valid: last part of a split packet",
+			false,
+			types.ValidatorSetChangePacketData{ValidatorUpdates: []abci.ValidatorUpdate{}, ValsetUpdateId: 4, PartIndex: 2, TotalParts: 3},
+		},
+		{
+			"invalid: part index out of range",
+			true,
+			types.ValidatorSetChangePacketData{ValidatorUpdates: []abci.ValidatorUpdate{}, ValsetUpdateId: 5, PartIndex: 3, TotalParts: 3},
+		},
+		{
+			"invalid: part index of a packet that is not split",
+			true,
+			types.ValidatorSetChangePacketData{ValidatorUpdates: []abci.ValidatorUpdate{}, ValsetUpdateId: 6, PartIndex: 1, TotalParts: 1},
+		},
+		{
+			"invalid: part index without total parts",
+			true,
+			types.ValidatorSetChangePacketData{ValidatorUpdates: []abci.ValidatorUpdate{}, ValsetUpdateId: 7, PartIndex: 2},
+		},
 	}
 
 	for _, c := range cases {
@@ -148,6 +168,115 @@ func TestVSCPacketDataWireBytes(t *testing.T) {
 	require.Equal(t, expectedStr, str)
 }
 
+// TestSplitVSCPacketDataWireBytes checks that split VSC packets carry the split
+// metadata over the wire and can be decoded back.
+func TestSplitVSCPacketDataWireBytes(t *testing.T) {
+	cId1 := crypto.NewCryptoIdentityFromIntSeed(4732894)
+
+	pd := types.NewValidatorSetChangePacketData(
+		[]abci.ValidatorUpdate{
+			{
+				PubKey: cId1.TMProtoCryptoPublicKey(),
+				Power:  30,
+			},
+		},
+		73,
+		nil,
+	)
+	pd.PartIndex = 1
+	pd.TotalParts = 2
+
+	str := string(pd.GetBytes())
+
+	// Expected string formatted for human readability
+	expectedStr := `{
+		"validator_updates": [
+			{
+				"pub_key": {
+					"ed25519": "SMxP2pXAuxQC7FmBn4dh4Kt5eYdQFWC/wN7oWobZKds="
+				},
+				"power": "30"
+			}
+		],
+		"valset_update_id": "73",
+		"slash_acks": [],
+		"part_index": 1,
+		"total_parts": 2
+	}`
+
+	// Remove newlines, tabs, and spaces for comparison
+	expectedStr = strings.ReplaceAll(expectedStr, "\n", "")
+	expectedStr = strings.ReplaceAll(expectedStr, "\t", "")
+	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
+
+	require.Equal(t, expectedStr, str)
+
+	var recovered types.ValidatorSetChangePacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON([]byte(str), &recovered))
+	require.Equal(t, uint32(1), recovered.PartIndex)
+	require.Equal(t, uint32(2), recovered.TotalParts)
+	require.True(t, recovered.IsLastPart())
+}
+
+func TestSplitValidatorSetChangePacketData(t *testing.T) {
+	updates := []abci.ValidatorUpdate{}
+	for i := 0; i < 5; i++ {
+		updates = append(updates, abci.ValidatorUpdate{
+			PubKey: crypto.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey(),
+			Power:  int64(i + 1),
+		})
+	}
+	acks := []string{"ack1", "ack2", "ack3"}
+
+	testCases := []struct {
+		name          string
+		maxPacketSize int64
+		expUpdates    [][]abci.ValidatorUpdate
+		expAcks       [][]string
+	}{
+		{
+			"no limit",
+			0,
+			[][]abci.ValidatorUpdate{updates},
+			[][]string{acks},
+		},
+		{
+			"everything fits into one packet",
+			8,
+			[][]abci.ValidatorUpdate{updates},
+			[][]string{acks},
+		},
+		{
+			"acks split over two packets",
+			4,
+			[][]abci.ValidatorUpdate{updates[0:4], updates[4:5]},
+			[][]string{nil, acks[0:3]},
+		},
+		{
+			"packet with only acks",
+			3,
+			[][]abci.ValidatorUpdate{updates[0:3], updates[3:5], {}},
+			[][]string{nil, acks[0:1], acks[1:3]},
+		},
+	}
+
+	for _, tc := range testCases {
+		packets := types.SplitValidatorSetChangePacketData(updates, 7, acks, tc.maxPacketSize)
+		require.Len(t, packets, len(tc.expUpdates), tc.name)
+		for i, packet := range packets {
+			require.NoError(t, packet.Validate(), tc.name)
+			require.Equal(t, uint64(7), packet.ValsetUpdateId, tc.name)
+			require.Equal(t, tc.expUpdates[i], packet.ValidatorUpdates, tc.name)
+			require.Equal(t, tc.expAcks[i], packet.SlashAcks, tc.name)
+			require.Equal(t, i == len(packets)-1, packet.IsLastPart(), tc.name)
+			if len(packets) > 1 {
+				require.Equal(t, uint32(i), packet.PartIndex, tc.name)
+				require.Equal(t, uint32(len(packets)), packet.TotalParts, tc.name)
+			}
+		}
+	}
+}
+
 // TestSlashPacketDataWireBytes is a regression test that the JSON schema
 // for SlashPacketData (sent over the wire) does not change.
 func TestSlashPacketDataWireBytes(t *testing.T) {